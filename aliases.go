@@ -0,0 +1,226 @@
+package flags
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/reeflective/flags/internal/tag"
+)
+
+// Styles of the aliases generated from the names of commands, with their `alias-styles` tag.
+const (
+	AliasShort    = "short"    // The first letter of the name (eg. "i" for "install")
+	AliasInitials = "initials" // The first letters of the words of the name (eg. "gp" for "get-pods")
+)
+
+// AliasConflict is an alias generated for a command with its `alias-styles` tag, which has
+// been dropped because it is already used by a sibling command, or reserved (see IsReserved).
+type AliasConflict struct {
+	Command string // The name of the command the alias was generated for
+	Alias   string // The generated alias
+	Other   string // The name of the sibling command using the alias, empty if reserved
+}
+
+// String returns a description of the conflict.
+func (c AliasConflict) String() string {
+	if c.Other == "" {
+		return fmt.Sprintf("generated alias %q is a reserved name", c.Alias)
+	}
+
+	return fmt.Sprintf("generated alias %q is already used by command %q", c.Alias, c.Other)
+}
+
+// subcommand is a subcommand declared in a command struct, as seen by SubcommandAliases.
+type subcommand struct {
+	name      string
+	aliases   []string
+	generated []string
+}
+
+// SubcommandAliases returns the aliases of the subcommands declared in a command struct
+// (including those in groups of commands), by name: those declared with `alias` tags, followed
+// by those generated from their names with their `alias-styles` tag, like `alias-styles:"short,initials"`
+// (see AliasShort and AliasInitials). Generated aliases are only given to a subcommand if they
+// are not used by any of its siblings, either as a name or as an alias (declared or generated),
+// and are not reserved: the conflicts are also returned, in declaration order.
+func SubcommandAliases(data interface{}, optFuncs ...OptFunc) (map[string][]string, []AliasConflict, error) {
+	if data == nil {
+		return nil, nil, nil
+	}
+
+	typ := reflect.TypeOf(data)
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return nil, nil, nil
+	}
+
+	subcommands, err := declaredSubcommands(typ, optFuncs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Names and aliases used by each subcommand, and how many generate each alias.
+	used := map[string]string{}
+	generated := map[string]int{}
+
+	for _, subc := range subcommands {
+		for _, name := range append([]string{subc.name}, subc.aliases...) {
+			if _, isUsed := used[name]; !isUsed {
+				used[name] = subc.name
+			}
+		}
+
+		for _, alias := range subc.generated {
+			generated[alias]++
+		}
+	}
+
+	aliases := make(map[string][]string, len(subcommands))
+
+	var conflicts []AliasConflict
+
+	for _, subc := range subcommands {
+		aliases[subc.name] = subc.aliases
+
+		for _, alias := range subc.generated {
+			other, isUsed := used[alias]
+
+			switch {
+			case isUsed:
+				conflicts = append(conflicts, AliasConflict{Command: subc.name, Alias: alias, Other: other})
+			case generated[alias] > 1:
+				conflicts = append(conflicts, AliasConflict{Command: subc.name, Alias: alias, Other: generatedBy(subcommands, subc, alias)})
+			case IsReserved(alias, optFuncs...):
+				conflicts = append(conflicts, AliasConflict{Command: subc.name, Alias: alias})
+			default:
+				aliases[subc.name] = append(aliases[subc.name], alias)
+			}
+		}
+	}
+
+	return aliases, conflicts, nil
+}
+
+// declaredSubcommands returns the subcommands declared in the fields of a command struct type,
+// and in the struct fields which are not other commands, groups of options or positionals.
+func declaredSubcommands(typ reflect.Type, optFuncs []OptFunc) ([]subcommand, error) {
+	var subcommands []subcommand
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		// Invalid tags are reported when the field is actually scanned.
+		mtag, skip, err := ScanOptions(optFuncs...).FieldTag(field)
+		if err != nil || skip || !InMode(mtag, optFuncs...) {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if name, _ := mtag.Get("command"); name != "" {
+			subc := subcommand{name: name, aliases: mtag.GetMany("alias")}
+
+			if subc.generated, err = generateAliases(name, mtag); err != nil {
+				return nil, err
+			}
+
+			subcommands = append(subcommands, subc)
+
+			continue
+		}
+
+		_, isGroup := mtag.Get("group")
+		_, isArgs := mtag.Get("positional-args")
+
+		if fieldType.Kind() != reflect.Struct || isGroup || isArgs {
+			continue
+		}
+
+		nested, err := declaredSubcommands(fieldType, optFuncs)
+		if err != nil {
+			return nil, err
+		}
+
+		subcommands = append(subcommands, nested...)
+	}
+
+	return subcommands, nil
+}
+
+// generateAliases returns the aliases generated from the name of a command with its `alias-styles`
+// tag, without duplicates nor those equal to its name or to one of its declared aliases.
+func generateAliases(name string, mtag tag.MultiTag) ([]string, error) {
+	declared := append([]string{name}, mtag.GetMany("alias")...)
+
+	var aliases []string
+
+	for _, styles := range mtag.GetMany("alias-styles") {
+		for _, style := range strings.Split(styles, ",") {
+			var alias string
+
+			switch strings.TrimSpace(style) {
+			case AliasShort:
+				first, _ := utf8.DecodeRuneInString(name)
+				alias = string(unicode.ToLower(first))
+			case AliasInitials:
+				alias = initials(name)
+			case "":
+				continue
+			default:
+				return nil, fmt.Errorf("%w: unknown alias style %q on command %q", ErrInvalidTag, style, name)
+			}
+
+			if !contains(declared, alias) && !contains(aliases, alias) {
+				aliases = append(aliases, alias)
+			}
+		}
+	}
+
+	return aliases, nil
+}
+
+// initials returns the lowercase first letters of the words of a name, separated
+// by dashes, underscores or dots, or in camel case (eg. "gp" for "get-pods").
+func initials(name string) string {
+	var letters strings.Builder
+
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool { return r == '-' || r == '_' || r == '.' }) {
+		for _, part := range split(word) {
+			first, _ := utf8.DecodeRuneInString(part)
+			letters.WriteRune(unicode.ToLower(first))
+		}
+	}
+
+	return letters.String()
+}
+
+// generatedBy returns the name of the first sibling of a subcommand also generating an alias.
+func generatedBy(subcommands []subcommand, subc subcommand, alias string) string {
+	for _, other := range subcommands {
+		if other.name != subc.name && contains(other.generated, alias) {
+			return other.name
+		}
+	}
+
+	return ""
+}
+
+// contains returns true if a list of names contains a name.
+func contains(names []string, name string) bool {
+	for _, other := range names {
+		if other == name {
+			return true
+		}
+	}
+
+	return false
+}
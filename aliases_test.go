@@ -0,0 +1,65 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSubcommandAliases checks that aliases are generated with all
+// styles, and that those conflicting with siblings are dropped.
+func TestSubcommandAliases(t *testing.T) {
+	t.Parallel()
+
+	root := struct {
+		Install  lintCommand `command:"install" alias-styles:"short,initials"`
+		GetPods  lintCommand `command:"get-pods" alias:"pods" alias-styles:"initials"`
+		ListAll  lintCommand `command:"listAll" alias-styles:"initials"`
+		Remove   lintCommand `command:"remove" alias:"i" alias-styles:"short"`
+		Debug    lintCommand `command:"debug" alias-styles:"short"`
+		Download lintCommand `command:"download" alias-styles:"short"`
+	}{}
+
+	aliases, conflicts, err := SubcommandAliases(&root)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"install":  nil,
+		"get-pods": {"pods", "gp"},
+		"listAll":  {"la"},
+		"remove":   {"i", "r"},
+		"debug":    nil,
+		"download": nil,
+	}, aliases)
+
+	assert.Equal(t, []AliasConflict{
+		{Command: "install", Alias: "i", Other: "remove"},
+		{Command: "debug", Alias: "d", Other: "download"},
+		{Command: "download", Alias: "d", Other: "debug"},
+	}, conflicts)
+
+	_, _, err = SubcommandAliases(&struct {
+		Install lintCommand `command:"install" alias-styles:"first"`
+	}{})
+	assert.ErrorIs(t, err, ErrInvalidTag)
+}
+
+// TestLintAliasStyles checks that dropped generated aliases are reported.
+func TestLintAliasStyles(t *testing.T) {
+	t.Parallel()
+
+	var found []string
+	for _, issue := range Lint(&struct {
+		Parent struct {
+			Get lintCommand `command:"get" description:"get an item" alias-styles:"short"`
+			Gc  lintCommand `command:"g" description:"collect garbage"`
+		} `command:"parent" description:"parent"`
+	}{}) {
+		found = append(found, issue.String())
+	}
+
+	assert.Equal(t, []string{
+		`command parent get: generated alias "g" is already used by command "g"`,
+	}, found)
+}
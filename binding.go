@@ -0,0 +1,74 @@
+package flags
+
+// ConfigBinder is the part of a configuration registry (eg. a *viper.Viper) to which
+// BindConfig declares options: their environment variables, and their default values.
+type ConfigBinder interface {
+	BindEnv(input ...string) error
+	SetDefault(key string, value interface{})
+}
+
+// ConfigMap returns the values of the options scanned from a struct, by option name (which
+// includes its namespaces, if any): a flat map of keys which configuration libraries can load
+// as defaults (eg. koanf with confmap.Provider(values, "."), with namespace-delimiter set to
+// "." on groups, to have nested keys). Values are those of the struct fields, with their types
+// (eg. a []string for repeatable options): sources and environment variables are left to
+// configuration libraries (see BindConfig). Options with no Go value are given in their text
+// form, and options only set from the environment are left out.
+func ConfigMap(cfg interface{}, optFuncs ...OptFunc) (map[string]interface{}, error) {
+	flagSet, err := ParseStruct(cfg, optFuncs...)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{}, len(flagSet))
+
+	for _, flag := range flagSet {
+		if flag.EnvOnly {
+			continue
+		}
+
+		values[flag.Name] = configValue(flag.Value)
+	}
+
+	return values, nil
+}
+
+// BindConfig declares the options scanned from a struct to a configuration registry, so that
+// it resolves them from the same definitions: each option is a key named after it (as for
+// viper.BindPFlags with the flags of a generated command), with its value in the struct as
+// its default value (see ConfigMap), and bound to its environment variable if tagged with `env`.
+func BindConfig(binder ConfigBinder, cfg interface{}, optFuncs ...OptFunc) error {
+	flagSet, err := ParseStruct(cfg, optFuncs...)
+	if err != nil {
+		return err
+	}
+
+	for _, flag := range flagSet {
+		if flag.EnvOnly {
+			continue
+		}
+
+		binder.SetDefault(flag.Name, configValue(flag.Value))
+
+		if !flag.Env {
+			continue
+		}
+
+		if err := binder.BindEnv(flag.Name, flag.EnvName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// configValue returns the Go value of an option, or its text form if it has none.
+func configValue(value Value) interface{} {
+	if getter, isGetter := value.(Getter); isGetter {
+		if val := getter.Get(); val != nil {
+			return val
+		}
+	}
+
+	return value.String()
+}
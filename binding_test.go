@@ -0,0 +1,66 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// configRegistry is a configuration registry recording its bindings.
+type configRegistry struct {
+	defaults map[string]interface{}
+	envs     map[string][]string
+}
+
+func (r *configRegistry) BindEnv(input ...string) error {
+	r.envs[input[0]] = input[1:]
+
+	return nil
+}
+
+func (r *configRegistry) SetDefault(key string, value interface{}) {
+	r.defaults[key] = value
+}
+
+// bindingConfig is a struct of options bound to configurations.
+type bindingConfig struct {
+	Host   string   `long:"host" env:"HOST"`
+	Port   int      `long:"port"`
+	Tags   []string `long:"tags"`
+	Server struct {
+		Timeout int `long:"timeout"`
+	} `group:"server" namespace:"server" namespace-delimiter:"."`
+}
+
+func TestConfigMap(t *testing.T) {
+	t.Parallel()
+
+	cfg := &bindingConfig{Port: 80, Tags: []string{"a"}}
+	cfg.Server.Timeout = 10
+
+	values, err := ConfigMap(cfg, WithEnviron([]string{"HOST=env"}))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"host":           "",
+		"port":           80,
+		"tags":           []string{"a"},
+		"server.timeout": 10,
+	}, values)
+
+	_, err = ConfigMap(bindingConfig{})
+	assert.ErrorIs(t, err, ErrNotPointerToStruct)
+}
+
+func TestBindConfig(t *testing.T) {
+	t.Parallel()
+
+	registry := &configRegistry{defaults: map[string]interface{}{}, envs: map[string][]string{}}
+
+	require.NoError(t, BindConfig(registry, &bindingConfig{Port: 80}, EnvPrefix("APP_")))
+
+	assert.Equal(t, 80, registry.defaults["port"])
+	assert.Equal(t, "", registry.defaults["host"])
+	assert.Equal(t, map[string][]string{"host": {"APP_HOST"}}, registry.envs)
+}
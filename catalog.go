@@ -0,0 +1,241 @@
+package flags
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/reeflective/flags/internal/positional"
+	"github.com/reeflective/flags/internal/scan"
+)
+
+// Attributes of the elements of a command tree whose text is in catalogs.
+const (
+	CatalogDescription     = "description"         // Descriptions of commands, options and positionals
+	CatalogLongDescription = "long-description"    // Long descriptions of commands
+	CatalogPlaceholder     = "positional-arg-name" // Placeholders of positionals, in usages
+	CatalogTitle           = "title"               // Titles of groups of options, in usages
+)
+
+// Keys of the messages written by the library and its generators (help usages, errors and
+// completions), which are translated with the same catalogs as the command trees. They have
+// no command path, and their default texts (in English) are returned by Messages: some are
+// formats, whose verbs (eg. %s) must be kept by their translations.
+const (
+	MessageUsage              = "#usage"                  // Header of the usage line of help usages
+	MessageAliases            = "#aliases"                // Header of the aliases of a command
+	MessageExamples           = "#examples"               // Header of the examples of a command
+	MessageCommands           = "#available-commands"     // Header of the subcommands of a command
+	MessageAdditionalCommands = "#additional-commands"    // Header of the subcommands in no group
+	MessageFlags              = "#flags"                  // Header of the options of a command
+	MessageGroupFlags         = "#group-flags"            // Header of the options of a group (group name)
+	MessageArguments          = "#arguments"              // Header of the positionals of a command
+	MessageGlobalFlags        = "#global-flags"           // Header of the options inherited by a command
+	MessageHelpTopics         = "#additional-help-topics" // Header of the help topics of a command
+	MessageMoreInfo           = "#more-info"              // Hint for the help of subcommands (command path)
+	MessageHelpFlag           = "#help-flag"              // Description of the help flag (command name)
+	MessageHelpCommand        = "#help-command"           // Description of the help command
+	MessageRequired           = "#required"               // Detail of required options and positionals
+	MessageChoices            = "#choices"                // Detail of the choices of a value (choices)
+	MessageEnv                = "#env"                    // Detail of the env variable of an option (name)
+	MessageUnknownSubcommand  = "#unknown-subcommand"     // Error of unknown subcommands (name, command)
+	MessageSuggestions        = "#suggestions"            // Header of the suggested subcommands
+	MessageFilteredExtensions = "#filtered-extensions"    // Completion group of files with some extensions
+	MessageFilteredDirs       = "#filtered-directories"   // Completion group of some directories
+	MessageCharsets           = "#charsets"               // Completion group of charsets
+	MessageTimeZones          = "#time-zones"             // Completion group of time zones
+	MessageHosts              = "#hosts"                  // Completion group of host names
+	MessageUsers              = "#users"                  // Completion group of user names
+	MessageUserGroups         = "#user-groups"            // Completion group of user groups
+	MessageInterfaces         = "#interfaces"             // Completion group of network interfaces
+	MessageEnvVariables       = "#env-variables"          // Completion group of environment variables
+	MessageProcesses          = "#processes"              // Completion group of process IDs
+
+	MessageRequiredArgument  = positional.MessageRequired     // Error of a missing positional (name)
+	MessageRequiredArguments = positional.MessageRequiredMany // Error of missing positionals (names, last name)
+	MessageAtLeastArgument   = positional.MessageAtLeastOne   // Positional missing its word (name, minimum)
+	MessageAtLeastArguments  = positional.MessageAtLeast      // Positional missing words (name, minimum, count)
+	MessageAtMostArgument    = positional.MessageAtMostOne    // Positional given too many words (name, maximum)
+	MessageAtMostArguments   = positional.MessageAtMost       // Positional given too many words (name, maximum, count)
+	MessageZeroArguments     = positional.MessageZero         // Positional accepting no words (name)
+)
+
+// messages are the default texts of the messages of the library.
+var messages = map[string]string{
+	MessageUsage:              "Usage:",
+	MessageAliases:            "Aliases:",
+	MessageExamples:           "Examples:",
+	MessageCommands:           "Available Commands:",
+	MessageAdditionalCommands: "Additional Commands:",
+	MessageFlags:              "Flags:",
+	MessageGroupFlags:         "Flags (%s):",
+	MessageArguments:          "Arguments:",
+	MessageGlobalFlags:        "Global Flags:",
+	MessageHelpTopics:         "Additional help topics:",
+	MessageMoreInfo:           `Use "%s [command] --help" for more information about a command.`,
+	MessageHelpFlag:           "help for %s",
+	MessageHelpCommand:        "Help about any command",
+	MessageRequired:           "required",
+	MessageChoices:            "choices: %s",
+	MessageEnv:                "env: $%s",
+	MessageUnknownSubcommand:  "unknown subcommand %q for %q",
+	MessageSuggestions:        "Did you mean this?",
+	MessageFilteredExtensions: "filtered extensions",
+	MessageFilteredDirs:       "filtered directories",
+	MessageCharsets:           "charsets",
+	MessageTimeZones:          "time zones",
+	MessageHosts:              "hosts",
+	MessageUsers:              "users",
+	MessageUserGroups:         "groups",
+	MessageInterfaces:         "network interfaces",
+	MessageEnvVariables:       "environment variables",
+	MessageProcesses:          "processes",
+}
+
+// Catalog holds the user-visible strings declared in the struct tags of a command tree,
+// indexed by message keys. A catalog extracted with Extract holds the declared strings,
+// and can be written for translators: once translated, it can be read back and given
+// to Generate() or Walk() with WithCatalog, to localize the generated commands.
+//
+// Message keys are made of the path of a command (root excluded), followed by one of its
+// options (--name), positionals (<name>) or groups of options ([name]) if any, and of the
+// attribute translated, eg. "remote add#description", "remote add --force#description",
+// "get <key>#description" or "remote add [auth]#title".
+type Catalog map[string]string
+
+// CatalogKey returns the message key of an attribute of a command (element is empty), or
+// of one of its options (--name), positionals (<name>) or groups ([name]), as declared.
+func CatalogKey(path []string, element, attribute string) string {
+	if element != "" {
+		path = append(append([]string{}, path...), element)
+	}
+
+	return strings.Join(path, " ") + "#" + attribute
+}
+
+// Text returns the translation of a message (sanitized, since catalogs are often
+// loaded from files), or the text itself if there is none.
+func (c Catalog) Text(key, text string) string {
+	if translated, found := c[key]; found && translated != "" {
+		return Sanitize(translated)
+	}
+
+	return text
+}
+
+// Message returns the translation of a message of the library (see the Message keys),
+// or its default text if there is none, formatted with its arguments if it has some.
+func (c Catalog) Message(key string, args ...interface{}) string {
+	text, found := messages[key]
+	if !found {
+		text = positional.Messages[key]
+	}
+
+	if text = c.Text(key, text); len(args) == 0 {
+		return text
+	}
+
+	return fmt.Sprintf(text, args...)
+}
+
+// Messages returns a catalog of the messages of the library with their default texts:
+// it can be merged with one returned by Extract, so that translators translate both.
+func Messages() Catalog {
+	catalog := Catalog{}
+
+	for key, text := range messages {
+		catalog[key] = text
+	}
+
+	for key, text := range positional.Messages {
+		catalog[key] = text
+	}
+
+	return catalog
+}
+
+// Write writes the catalog as a JSON object, with its keys sorted.
+func (c Catalog) Write(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(c)
+}
+
+// ReadCatalog reads a catalog written with Catalog.Write, usually once translated.
+func ReadCatalog(reader io.Reader) (Catalog, error) {
+	catalog := Catalog{}
+
+	if err := json.NewDecoder(reader).Decode(&catalog); err != nil {
+		return nil, fmt.Errorf("%w: invalid catalog: %s", ErrParse, err.Error())
+	}
+
+	return catalog, nil
+}
+
+// WithCatalog makes the descriptions of commands, options and positionals (and the
+// placeholders of the latter) to be translated with a catalog: they are replaced by
+// their translation when there is one. This applies to the commands produced by
+// generators, and to the elements visited with Walk (eg. in man pages and docs).
+// The messages of the library (see Messages) are translated with the same catalog,
+// that is, the headers of help usages, the errors of positionals and subcommands,
+// and the groups of completions, whose descriptions are shown by all shells.
+func WithCatalog(catalog Catalog) OptFunc {
+	return func(opt *scan.Opts) { opt.Catalog = catalog }
+}
+
+// Extract returns a catalog of the user-visible strings declared in the struct tags of a
+// command tree: the descriptions and long descriptions of commands, the descriptions of
+// options and positionals, the placeholders of the latter and the titles of groups of options
+// (their names). Empty strings are omitted.
+func Extract(data interface{}, optFuncs ...OptFunc) (Catalog, error) {
+	catalog := Catalog{}
+
+	add := func(key, text string) {
+		if text != "" {
+			catalog[key] = text
+		}
+	}
+
+	visitor := VisitorFuncs{
+		Command: func(cmd *Command) error {
+			add(CatalogKey(cmd.Path, "", CatalogDescription), cmd.Description)
+			add(CatalogKey(cmd.Path, "", CatalogLongDescription), cmd.LongDescription)
+
+			return nil
+		},
+		Group: func(cmd *Command, grp *Group) error {
+			add(CatalogKey(cmd.Path, "["+grp.Name+"]", CatalogTitle), grp.Name)
+
+			return nil
+		},
+		Flag: func(cmd *Command, grp *Group, flag *Flag) error {
+			add(CatalogKey(cmd.Path, "--"+flag.Name, CatalogDescription), flag.Usage)
+
+			return nil
+		},
+		Positional: func(cmd *Command, arg *Positional) error {
+			element := "<" + arg.Name + ">"
+			add(CatalogKey(cmd.Path, element, CatalogDescription), arg.Usage)
+			add(CatalogKey(cmd.Path, element, CatalogPlaceholder), arg.Name)
+
+			return nil
+		},
+	}
+
+	// Strings are extracted as declared, not as translated by any catalog.
+	optFuncs = append(optFuncs[:len(optFuncs):len(optFuncs)], WithCatalog(nil))
+
+	if err := Walk(data, visitor, optFuncs...); err != nil {
+		return nil, err
+	}
+
+	return catalog, nil
+}
+
+// catalog returns the catalog set in options, if any.
+func catalog(optFuncs []OptFunc) Catalog {
+	return ScanOptions(optFuncs...).Catalog
+}
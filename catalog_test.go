@@ -0,0 +1,94 @@
+package flags
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCatalog checks that the strings of a command tree are extracted in a catalog,
+// which can be written, read back once translated, and applied when walking the tree.
+func TestCatalog(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := Extract(&walkedCommand{})
+	require.NoError(t, err)
+
+	assert.Equal(t, Catalog{
+		"add#description":                "add an item",
+		"add <Name>#description":         "name of the item",
+		"add <Name>#positional-arg-name": "Name",
+		"add <Tags>#positional-arg-name": "Tags",
+		"[remote]#title":                 "remote",
+	}, catalog)
+
+	var buf bytes.Buffer
+	require.NoError(t, catalog.Write(&buf))
+
+	translated, err := ReadCatalog(&buf)
+	require.NoError(t, err)
+	require.Equal(t, catalog, translated)
+
+	translated["add#description"] = "ajouter un élément"
+	translated["add <Name>#positional-arg-name"] = "NOM"
+	translated["--verbose#description"] = "sortie détaillée"
+	translated["[remote]#title"] = "distant"
+
+	var descriptions, groups []string
+
+	visitor := VisitorFuncs{
+		Command: func(cmd *Command) error {
+			descriptions = append(descriptions, cmd.Description)
+			return nil
+		},
+		Group: func(cmd *Command, grp *Group) error {
+			groups = append(groups, grp.Name)
+			return nil
+		},
+		Flag: func(cmd *Command, grp *Group, flag *Flag) error {
+			descriptions = append(descriptions, flag.Usage)
+			return nil
+		},
+		Positional: func(cmd *Command, arg *Positional) error {
+			descriptions = append(descriptions, arg.Name+": "+arg.Usage)
+			return nil
+		},
+	}
+
+	require.NoError(t, Walk(&walkedCommand{}, visitor, WithCatalog(translated)))
+	assert.Equal(t, []string{"distant"}, groups)
+	assert.Equal(t, []string{
+		"", "sortie détaillée", "", "", "ajouter un élément", "NOM: name of the item", "Tags: ", "",
+	}, descriptions)
+
+	// Extract does not write over the options of the caller.
+	opts := make([]OptFunc, 1, 2)
+	opts[0] = WithCatalog(translated)
+	_, err = Extract(&walkedCommand{}, opts...)
+	require.NoError(t, err)
+	assert.Nil(t, opts[:2][1])
+
+	_, err = ReadCatalog(bytes.NewBufferString("not json"))
+	assert.ErrorIs(t, err, ErrParse)
+}
+
+// TestCatalogMessages checks that the messages of the library
+// are translated, or written with their default texts.
+func TestCatalogMessages(t *testing.T) {
+	t.Parallel()
+
+	messages := Messages()
+	assert.Equal(t, "Usage:", messages[MessageUsage])
+	assert.Equal(t, "required argument: %s was not provided", messages[MessageRequiredArgument])
+
+	var catalog Catalog
+	assert.Equal(t, "Flags:", catalog.Message(MessageFlags))
+	assert.Equal(t, "help for run", catalog.Message(MessageHelpFlag, "run"))
+
+	catalog = Catalog{MessageHelpFlag: "aide pour %s", MessageFlags: "Options :"}
+	assert.Equal(t, "Options :", catalog.Message(MessageFlags))
+	assert.Equal(t, "aide pour run", catalog.Message(MessageHelpFlag, "run"))
+	assert.Equal(t, "Usage:", catalog.Message(MessageUsage))
+}
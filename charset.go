@@ -0,0 +1,96 @@
+package flags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Charset is the name of a character set, as registered by the IANA (eg. UTF-8 or ISO-8859-1).
+// Values are checked against the most common charsets and their aliases, and normalized to
+// the preferred MIME name of the charset (eg. `latin1` and `iso_8859-1` both give ISO-8859-1).
+type Charset string
+
+// charsets maps the preferred MIME names of common IANA charsets to their aliases.
+var charsets = map[string][]string{
+	"US-ASCII":     {"ascii", "us", "iso646-us", "iso_646.irv:1991", "ansi_x3.4-1968", "cp367", "ibm367", "csascii"},
+	"UTF-8":        {"utf8", "csutf8"},
+	"UTF-16":       {"utf16", "csutf16"},
+	"UTF-16BE":     {"utf16be", "csutf16be"},
+	"UTF-16LE":     {"utf16le", "csutf16le"},
+	"UTF-32":       {"utf32", "csutf32"},
+	"ISO-8859-1":   {"iso_8859-1", "iso8859-1", "latin1", "l1", "cp819", "ibm819", "csisolatin1"},
+	"ISO-8859-2":   {"iso_8859-2", "iso8859-2", "latin2", "l2", "csisolatin2"},
+	"ISO-8859-3":   {"iso_8859-3", "iso8859-3", "latin3", "l3", "csisolatin3"},
+	"ISO-8859-4":   {"iso_8859-4", "iso8859-4", "latin4", "l4", "csisolatin4"},
+	"ISO-8859-5":   {"iso_8859-5", "iso8859-5", "cyrillic", "csisolatincyrillic"},
+	"ISO-8859-6":   {"iso_8859-6", "iso8859-6", "arabic", "csisolatinarabic"},
+	"ISO-8859-7":   {"iso_8859-7", "iso8859-7", "greek", "greek8", "csisolatingreek"},
+	"ISO-8859-8":   {"iso_8859-8", "iso8859-8", "hebrew", "csisolatinhebrew"},
+	"ISO-8859-9":   {"iso_8859-9", "iso8859-9", "latin5", "l5", "csisolatin5"},
+	"ISO-8859-10":  {"iso_8859-10", "iso8859-10", "latin6", "l6", "csisolatin6"},
+	"ISO-8859-13":  {"iso_8859-13", "iso8859-13", "csiso885913"},
+	"ISO-8859-14":  {"iso_8859-14", "iso8859-14", "latin8", "l8", "csiso885914"},
+	"ISO-8859-15":  {"iso_8859-15", "iso8859-15", "latin-9", "csiso885915"},
+	"ISO-8859-16":  {"iso_8859-16", "iso8859-16", "latin10", "l10", "csiso885916"},
+	"KOI8-R":       {"koi8r", "cskoi8r"},
+	"KOI8-U":       {"koi8u", "cskoi8u"},
+	"windows-1250": {"cp1250", "cswindows1250"},
+	"windows-1251": {"cp1251", "cswindows1251"},
+	"windows-1252": {"cp1252", "cswindows1252"},
+	"windows-1253": {"cp1253", "cswindows1253"},
+	"windows-1254": {"cp1254", "cswindows1254"},
+	"windows-1255": {"cp1255", "cswindows1255"},
+	"windows-1256": {"cp1256", "cswindows1256"},
+	"windows-1257": {"cp1257", "cswindows1257"},
+	"windows-1258": {"cp1258", "cswindows1258"},
+	"IBM437":       {"cp437", "437", "cspc8codepage437"},
+	"IBM850":       {"cp850", "850", "cspc850multilingual"},
+	"IBM866":       {"cp866", "866", "csibm866"},
+	"macintosh":    {"mac", "csmacintosh"},
+	"Shift_JIS":    {"ms_kanji", "sjis", "csshiftjis"},
+	"EUC-JP":       {"eucjp", "cseucpkdfmtjapanese"},
+	"ISO-2022-JP":  {"csiso2022jp"},
+	"EUC-KR":       {"euckr", "cseuckr"},
+	"GBK":          {"cp936", "ms936", "windows-936", "csgbk"},
+	"GB18030":      {"csgb18030"},
+	"GB2312":       {"csgb2312"},
+	"Big5":         {"big-5", "csbig5"},
+	"TIS-620":      {"cstis620"},
+}
+
+// charsetNames indexes the preferred names of charsets by all their lowercase names.
+var charsetNames = func() map[string]string {
+	names := make(map[string]string)
+
+	for name, aliases := range charsets {
+		names[strings.ToLower(name)] = name
+
+		for _, alias := range aliases {
+			names[alias] = name
+		}
+	}
+
+	return names
+}()
+
+// Charsets returns the preferred MIME names of all known charsets, sorted.
+func Charsets() []string {
+	names := make([]string, 0, len(charsets))
+	for name := range charsets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func parseCharset(s string) (Charset, error) {
+	name, found := charsetNames[strings.ToLower(strings.TrimSpace(s))]
+	if !found {
+		return "", fmt.Errorf("unknown charset: %q", s)
+	}
+
+	return Charset(name), nil
+}
@@ -77,14 +77,16 @@ func parseGeneratedMap(value interface{}) Value {
 {{if not .NoValueParser}}
 // -- {{.Type}} Value
 type {{.|ValueName}} struct {
-	value *{{.Type}}
+	value *{{.Type}}{{if .Layout}}
+	layout string{{end}}
 }
 
 var _ Value = (*{{.|ValueName}})(nil)
-var _ Getter = (*{{.|ValueName}})(nil)
+var _ Getter = (*{{.|ValueName}})(nil){{if .Layout}}
+var _ layoutValue = (*{{.|ValueName}})(nil){{end}}
 
 func new{{.|Name}}Value(p *{{.Type}}) *{{.|ValueName}} {
-	return &{{.|ValueName}}{value: p}
+	return &{{.|ValueName}}{value: p{{if .Layout}}, layout: {{.Layout}}{{end}}}
 }
 
 func (v *{{.|ValueName}}) Set(s string) error {
@@ -122,34 +124,46 @@ func (v *{{.|ValueName}}) String() string {
 }
 
 func (v *{{.|ValueName}}) Type() string { return "{{.|Type}}" }
+{{if .Layout}}
+func (v *{{.|ValueName}}) setLayout(layout string) { v.layout = layout }
+{{end}}
 
 {{ if not .NoSlice }}
 // -- {{.Type}}Slice Value
 
 type {{.|SliceValueName}} struct{
-	value   *[]{{.Type}}
+	value   *[]{{.Type}}{{if .Layout}}
+	layout  string{{end}}
 	changed bool
 }
 
 var _ RepeatableFlag = (*{{.|SliceValueName}})(nil)
 var _ Value = (*{{.|SliceValueName}})(nil)
 var _ Getter = (*{{.|SliceValueName}})(nil)
+var _ Resetter = (*{{.|SliceValueName}})(nil){{if .Layout}}
+var _ layoutValue = (*{{.|SliceValueName}})(nil){{end}}
 
 
 func new{{.|Name}}SliceValue(slice *[]{{.Type}}) *{{.|SliceValueName}}  {
 	return &{{.|SliceValueName}}{
-		value: slice,
+		value: slice,{{if .Layout}}
+		layout: {{.Layout}},{{end}}
 	}
 }
 
 func (v *{{.|SliceValueName}}) Set(raw string) error {
+	{{if .Layout}}\nn
+	// Layouts might contain commas: each value is a single element.
+	ss := []string{raw}
+	{{else}}\nn
 	ss := strings.Split(raw, ",")
+	{{end}}\nn
 	{{if .Parser }}
 	out := make([]{{.Type}}, len(ss))
 	for i, s := range ss {
 		parsed, err := {{.Parser}}
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		{{if .Convert}}\nn
 		out[i] = ({{.Type}})(parsed)
@@ -182,7 +196,7 @@ func (v *{{.|SliceValueName}}) String() string {
 	}
 	out := make([]string, 0, len(*v.value))
 	for _, elem := range *v.value {
-		out = append(out, new{{.|Name}}Value(&elem).String())
+		out = append(out, {{.|ElemFormat}})
 	}
 	return "[" + strings.Join(out, ",") + "]"
 }
@@ -193,6 +207,11 @@ func (v *{{.|SliceValueName}}) IsCumulative() bool {
 	return true
 }
 
+func (v *{{.|SliceValueName}}) Reset() { v.changed = false }
+{{if .Layout}}
+func (v *{{.|SliceValueName}}) setLayout(layout string) { v.layout = layout }
+{{end}}
+
 {{end}}
 
 {{ if not .NoMap }}
@@ -200,37 +219,52 @@ func (v *{{.|SliceValueName}}) IsCumulative() bool {
 {{range $mapKeyTypes}}
 // -- {{ MapValueName $value . }}
 type {{ MapValueName $value . }} struct {
-	value *map[{{.}}]{{$value.Type}}
+	value *map[{{.}}]{{$value.Type}}{{if $value.Layout}}
+	layout string{{end}}
 }
 
 var _ RepeatableFlag = (*{{MapValueName $value .}})(nil)
 var _ Value = (*{{MapValueName $value .}})(nil)
-var _ Getter = (*{{MapValueName $value .}})(nil)
+var _ Getter = (*{{MapValueName $value .}})(nil){{if $value.Layout}}
+var _ layoutValue = (*{{MapValueName $value .}})(nil){{end}}
 
 
 func new{{MapValueName $value . | Title}}(m *map[{{.}}]{{$value.Type}}) *{{MapValueName $value .}}  {
 	return &{{MapValueName $value .}}{
-		value: m,
+		value: m,{{if $value.Layout}}
+		layout: {{$value.Layout}},{{end}}
 	}
 }
 
+{{if $value.Layout}}\nn
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+{{end}}\nn
 func (v *{{MapValueName $value .}}) Set(val string) error {
+	{{if $value.Layout}}\nn
+	values := []string{val}
+	{{else}}\nn
 	values := strings.Split(val, ",")
+	{{end}}\nn
 
-	for _, s := range values {
-        ss := strings.Split(s, ":")
+	for i, entry := range values {
+        {{if $value.Layout}}\nn
+        ss := strings.SplitN(entry, ":", 2)
+        {{else}}\nn
+        ss := strings.Split(entry, ":")
+        {{end}}\nn
         if len(ss) < 2 {
-            return errors.New("invalid map flag syntax, use -map=key1:val1")
+            return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
         }
 
         {{ $kindVal := KindValue . }}
 
-        s = ss[0]
+        s := ss[0]
 
         {{if $kindVal.Parser }}\nn
         parsedKey, err := {{$kindVal.Parser}}
         if err != nil {
-            return err
+            return newElementError(i, entry, err)
         }
 
         {{if $kindVal.Convert}}\nn
@@ -249,7 +283,7 @@ func (v *{{MapValueName $value .}}) Set(val string) error {
         {{if $value.Parser }}\nn
         parsedVal, err := {{$value.Parser}}
         if err != nil {
-            return err
+            return newElementError(i, entry, err)
         }
 
         {{if $value.Convert}}\nn
@@ -279,7 +313,15 @@ func (v *{{MapValueName $value .}}) Get() interface{} {
 func (v *{{MapValueName $value .}}) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
 {{/* flag package create zero Value and compares it to actual Value */}}\nn
+		{{if $value.Layout}}\nn
+		out := make(map[{{.}}]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = {{$value|ElemFormat}}
+		}
+		return fmt.Sprintf("%v", out)
+		{{else}}\nn
 		return fmt.Sprintf("%v", *v.value)
+		{{end}}\nn
 	}
 	return ""
 }
@@ -289,6 +331,9 @@ func (v *{{MapValueName $value .}}) Type() string { return "map[{{.}}]{{$value.T
 func (v *{{MapValueName $value .}}) IsCumulative() bool {
 	return true
 }
+{{if $value.Layout}}
+func (v *{{MapValueName $value .}}) setLayout(layout string) { v.layout = layout }
+{{end}}
 {{end}}
 {{end}}
 
@@ -426,15 +471,17 @@ func Test{{MapValueName $value $keyType | Title}}(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		{{range .In}}\nn
-		err = v.Set("{{$keyType | KindTest}}{{.}}")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		{{ $invalid := printf "%v%s" ($keyType | KindTest) . }}\nn
+		err = v.Set("{{$invalid}}")
+		assert.EqualError(t, err, "element 1 \"{{$invalid}}\": invalid map flag syntax, use -map=key1:val1")
 		{{if ne $keyType "string"}}\nn
 		err = v.Set(":{{.}}")
 		assert.NotNil(t, err)
 		{{end}}\nn
-		err = v.Set("{{$keyType | KindTest}}:{{.}}")
+		{{ $entry := printf "%v:%s" ($keyType | KindTest) . }}\nn
+		err = v.Set("{{$entry}}")
 		{{if $test.Err}}\nn
-		assert.EqualError(t, err, "{{$test.Err}}")
+		assert.EqualError(t, err, "element 1 \"{{$entry}}\": {{$test.Err}}")
 		{{ else }}\nn
 		assert.Nil(t, err)
 		{{end}}\nn
@@ -507,6 +554,7 @@ type value struct {
 	Type          string      `json:"type"`
 	Parser        string      `json:"parser"`
 	Format        string      `json:"format"`
+	Layout        string      `json:"layout"`
 	Plural        string      `json:"plural"`
 	Help          string      `json:"help"`
 	Import        []string    `json:"import"`
@@ -562,6 +610,15 @@ func main() {
 
 			return `fmt.Sprintf("%v", *v.value)`
 		},
+		"ElemFormat": func(v *value) string {
+			name := valueName(v)
+
+			if v.Layout != "" {
+				return fmt.Sprintf("(&%sValue{value: &elem, layout: v.layout}).String()", camelToLower(name))
+			}
+
+			return fmt.Sprintf("new%sValue(&elem).String()", name)
+		},
 		"ValueName": func(v *value) string {
 			if v.Name == v.Type {
 				return v.Type // that's package type
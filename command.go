@@ -1,6 +1,7 @@
 package flags
 
 import (
+	"context"
 	"reflect"
 )
 
@@ -27,6 +28,16 @@ type Commander interface {
 	Execute(args []string) (err error)
 }
 
+// CommanderContext is the equivalent of Commander for commands needing the context
+// of their execution, to respect the cancellation and deadlines set by the program
+// running them (eg. with cobra cmd.ExecuteContext()): it is the context of the cobra
+// command, which is never nil. It takes precedence over Commander if both are satisfied.
+type CommanderContext interface {
+	// ExecuteContext runs the command implementation, with the
+	// same args parameter as the one of `Commander.Execute()`.
+	ExecuteContext(ctx context.Context, args []string) (err error)
+}
+
 // Runner is the equivalent of cobra cmd.Run(cmd *cobra.Command, args []string)
 // It will be ignored if the `flags.Commander` interface is satisfied.
 // The args parameter is populated following the same rules as `Commander.Execute()`.
@@ -52,8 +63,45 @@ type PostRunnerE interface {
 	PostRunE(args []string) error
 }
 
+// PersistentPreRunner is the equivalent of cobra cmd.PersistentPreRun(cmd *cobra.Command, args []string),
+// for command structs with subcommands: as with cobra, it runs before any command of the tree of
+// the command, unless one of them implements its own (in which case only the latter runs).
+// The args parameter is populated following the same rules as `Commander.Execute()`.
+type PersistentPreRunner interface {
+	PersistentPreRun(args []string)
+}
+
+// PersistentPreRunnerE is the equivalent of cobra cmd.PersistentPreRunE(cmd *cobra.Command, args []string) error
+// The args parameter is populated following the same rules as `Commander.Execute()`.
+type PersistentPreRunnerE interface {
+	PersistentPreRunE(args []string) error
+}
+
+// PersistentPostRunner is the equivalent of cobra cmd.PersistentPostRun(cmd *cobra.Command, args []string),
+// running after any command of the tree of the command, like PersistentPreRunner runs before them.
+// The args parameter is populated following the same rules as `Commander.Execute()`.
+type PersistentPostRunner interface {
+	PersistentPostRun(args []string)
+}
+
+// PersistentPostRunnerE is the equivalent of cobra cmd.PersistentPostRunE(cmd *cobra.Command, args []string) error
+// The args parameter is populated following the same rules as `Commander.Execute()`.
+type PersistentPostRunnerE interface {
+	PersistentPostRunE(args []string) error
+}
+
+// Validater is implemented by command structs, option groups and positional structs needing
+// to check their values together (eg. constraints between several fields). Validate is called
+// once all options and positionals of the command have been parsed, and before the command
+// runs (and its pre-runners): its error is returned like parse errors, and the command does
+// not run. Persistent groups are validated for the subcommands they are inherited by.
+type Validater interface {
+	Validate() error
+}
+
 // IsCommand checks both tags and implementations on a pointer to a struct,
-// initializing the value itself if it's nil (useful for callers).
+// initializing the value itself if it's nil (useful for callers). Commands
+// only implementing CommanderContext are commands, with a nil Commander.
 func IsCommand(val reflect.Value) (reflect.Value, bool, Commander) {
 	// Initialize if needed
 	var ptrval reflect.Value
@@ -67,7 +115,7 @@ func IsCommand(val reflect.Value) (reflect.Value, bool, Commander) {
 
 	// Assert implementation
 	cmd, implements := ptrval.Interface().(Commander)
-	if !implements {
+	if _, withContext := ptrval.Interface().(CommanderContext); !implements && !withContext {
 		return ptrval, false, nil
 	}
 
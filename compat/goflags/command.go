@@ -0,0 +1,254 @@
+package flags
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/reeflective/flags"
+	genflags "github.com/reeflective/flags/gen/flags"
+	"github.com/spf13/cobra"
+)
+
+// Command is a command of a parser (the parser itself being the root one),
+// with the options, positionals and subcommands found in its data, and the
+// groups and commands added to it.
+type Command struct {
+	// The name of the command.
+	Name string
+
+	// The short description (shown in the list of commands of its parent).
+	ShortDescription string
+
+	// The long description (shown in its help message).
+	LongDescription string
+
+	// Aliases for the command.
+	Aliases []string
+
+	// Whether the command is hidden in the list of commands of its parent.
+	Hidden bool
+
+	// Whether subcommands are optional.
+	SubcommandsOptional bool
+
+	data     interface{}
+	groups   []*Group
+	commands []*Command
+}
+
+// Group is a group of options added to a command.
+type Group struct {
+	// A short description of the group, used as its title in help messages.
+	ShortDescription string
+
+	// A long description of the group, not shown in help messages.
+	LongDescription string
+
+	// The namespace of the group, prefixing the long names of its options.
+	Namespace string
+
+	// The environment namespace of the group, prefixing its env keys.
+	EnvNamespace string
+
+	data interface{}
+}
+
+// AddGroup adds a group of options (data being a pointer to a struct) to the command.
+func (c *Command) AddGroup(shortDescription, longDescription string, data interface{}) (*Group, error) {
+	if !isStructPointer(data) {
+		return nil, newError(flags.ErrNotPointerToStruct)
+	}
+
+	group := &Group{
+		ShortDescription: shortDescription,
+		LongDescription:  longDescription,
+		data:             data,
+	}
+
+	c.groups = append(c.groups, group)
+
+	return group, nil
+}
+
+// AddCommand adds a subcommand to the command, with the options, positionals and
+// subcommands found in data (a pointer to a struct), and its Execute(args) method.
+func (c *Command) AddCommand(command, shortDescription, longDescription string, data interface{}) (*Command, error) {
+	if !isStructPointer(data) {
+		return nil, newError(flags.ErrNotPointerToStruct)
+	}
+
+	subc := &Command{
+		Name:             command,
+		ShortDescription: shortDescription,
+		LongDescription:  longDescription,
+		data:             data,
+	}
+
+	c.commands = append(c.commands, subc)
+
+	return subc, nil
+}
+
+// Groups returns the groups added to the command.
+func (c *Command) Groups() []*Group {
+	return c.groups
+}
+
+// Commands returns the commands added to the command.
+func (c *Command) Commands() []*Command {
+	return c.commands
+}
+
+// Find returns the command added to this one with a given name, or nil.
+func (c *Command) Find(name string) *Command {
+	for _, subc := range c.commands {
+		if subc.Name == name {
+			return subc
+		}
+	}
+
+	return nil
+}
+
+// addCommands generates the commands added to this one onto its cobra command.
+func (c *Command) addCommands(cmd *cobra.Command) error {
+	for _, added := range c.commands {
+		data := added.scanned(nil)
+
+		subc, err := genflags.AddCommand(cmd, added.tag(), data)
+		if err != nil {
+			return err
+		}
+
+		added.bindRuns(subc, data)
+
+		if err := added.addCommands(subc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tag returns the struct tag declaring the command in a struct.
+func (c *Command) tag() string {
+	tag := fmt.Sprintf("command:%q description:%q long-description:%q", c.Name, c.ShortDescription, c.LongDescription)
+
+	for _, alias := range c.Aliases {
+		tag += fmt.Sprintf(" alias:%q", alias)
+	}
+
+	if c.Hidden {
+		tag += ` hidden:"yes"`
+	}
+
+	if c.SubcommandsOptional {
+		tag += ` subcommands-optional:"yes"`
+	}
+
+	return tag
+}
+
+// scanned returns the struct to generate the command from: its data if no groups have been
+// added to it, or a struct holding its data (as a group of commands and options) and groups.
+// The namespace delimiters of the groups are those of the parser, or the go-flags ones.
+func (c *Command) scanned(parser *Parser) interface{} {
+	if len(c.groups) == 0 && c.data != nil {
+		return c.data
+	}
+
+	fields := []reflect.StructField{}
+	values := []reflect.Value{}
+
+	if c.data != nil {
+		fields = append(fields, reflect.StructField{Name: "Data", Type: reflect.TypeOf(c.data), Tag: `commands:""`})
+		values = append(values, reflect.ValueOf(c.data))
+	}
+
+	delim, envDelim := ".", "_"
+	if parser != nil {
+		delim, envDelim = parser.NamespaceDelimiter, parser.EnvNamespaceDelimiter
+	}
+
+	for i, group := range c.groups {
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("Group%d", i),
+			Type: reflect.TypeOf(group.data),
+			Tag:  reflect.StructTag(group.tag(delim, envDelim)),
+		})
+		values = append(values, reflect.ValueOf(group.data))
+	}
+
+	wrapper := reflect.New(reflect.StructOf(fields)).Elem()
+	for i, value := range values {
+		wrapper.Field(i).Set(value)
+	}
+
+	return wrapper.Addr().Interface()
+}
+
+// bindRuns makes a command generated from a struct holding its data and groups run the
+// Execute(args) method of its data, which the struct does not implement.
+func (c *Command) bindRuns(cmd *cobra.Command, scanned interface{}) {
+	if scanned == c.data {
+		return
+	}
+
+	if commander, ok := c.data.(flags.Commander); ok {
+		cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+			return commander.Execute(genflags.RemainingArgs(cmd))
+		}
+	}
+}
+
+// tag returns the struct tag declaring the group in a struct: groups without
+// description have their options shown with those of their command.
+func (g *Group) tag(delim, envDelim string) string {
+	tag := `commands:"" persistent:"yes"`
+	if g.ShortDescription != "" {
+		tag = fmt.Sprintf(`group:%q persistent:"yes"`, g.ShortDescription)
+	}
+
+	if g.Namespace != "" {
+		tag += fmt.Sprintf(" namespace:%q namespace-delimiter:%q", g.Namespace, delim)
+	}
+
+	if g.EnvNamespace != "" {
+		tag += fmt.Sprintf(" env-namespace:%q env-namespace-delimiter:%q", g.EnvNamespace, envDelim)
+	}
+
+	return tag
+}
+
+// commandRequired returns the go-flags error of a command requiring a subcommand,
+// or nil if it has none (excluding the help and completion commands of cobra).
+func commandRequired(cmd *cobra.Command) error {
+	var names []string
+
+	for _, subc := range cmd.Commands() {
+		if subc.IsAvailableCommand() && subc.Name() != "completion" {
+			names = append(names, subc.Name())
+		}
+	}
+
+	switch len(names) {
+	case 0:
+		return nil
+	case 1:
+		return &Error{Type: ErrCommandRequired, Message: fmt.Sprintf("Please specify the %s command", names[0])}
+	}
+
+	last := len(names) - 1
+
+	return &Error{
+		Type:    ErrCommandRequired,
+		Message: fmt.Sprintf("Please specify one command of: %s or %s", strings.Join(names[:last], ", "), names[last]),
+	}
+}
+
+func isStructPointer(data interface{}) bool {
+	val := reflect.ValueOf(data)
+
+	return val.Kind() == reflect.Ptr && !val.IsNil() && val.Elem().Kind() == reflect.Struct
+}
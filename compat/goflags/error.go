@@ -0,0 +1,174 @@
+package flags
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/reeflective/flags"
+)
+
+// ErrorType is the type of an error returned by a parser.
+type ErrorType uint
+
+const (
+	// ErrUnknown indicates a generic error.
+	ErrUnknown ErrorType = iota
+
+	// ErrExpectedArgument indicates that an argument was expected.
+	ErrExpectedArgument
+
+	// ErrUnknownFlag indicates an unknown flag.
+	ErrUnknownFlag
+
+	// ErrUnknownGroup indicates an unknown group.
+	ErrUnknownGroup
+
+	// ErrMarshal indicates a marshalling error while converting values.
+	ErrMarshal
+
+	// ErrHelp indicates that the built-in help was shown (the error
+	// contains the help message).
+	ErrHelp
+
+	// ErrNoArgumentForBool indicates that an argument was given for a
+	// boolean flag (which don't not take any arguments).
+	ErrNoArgumentForBool
+
+	// ErrRequired indicates that a required flag was not provided.
+	ErrRequired
+
+	// ErrShortNameTooLong indicates that a short flag name was specified,
+	// longer than one character.
+	ErrShortNameTooLong
+
+	// ErrDuplicatedFlag indicates that a short or long flag has been
+	// defined more than once.
+	ErrDuplicatedFlag
+
+	// ErrTag indicates an error while parsing flag tags.
+	ErrTag
+
+	// ErrCommandRequired indicates that a command was required but not
+	// specified.
+	ErrCommandRequired
+
+	// ErrUnknownCommand indicates that an unknown command was specified.
+	ErrUnknownCommand
+
+	// ErrInvalidChoice indicates an invalid option value which only allows
+	// a certain number of choices.
+	ErrInvalidChoice
+
+	// ErrInvalidTag indicates an invalid tag or invalid use of an existing tag.
+	ErrInvalidTag
+)
+
+func (e ErrorType) String() string {
+	switch e {
+	case ErrUnknown:
+		return "unknown"
+	case ErrExpectedArgument:
+		return "expected argument"
+	case ErrUnknownFlag:
+		return "unknown flag"
+	case ErrUnknownGroup:
+		return "unknown group"
+	case ErrMarshal:
+		return "marshal"
+	case ErrHelp:
+		return "help"
+	case ErrNoArgumentForBool:
+		return "no argument for bool"
+	case ErrRequired:
+		return "required"
+	case ErrShortNameTooLong:
+		return "short name too long"
+	case ErrDuplicatedFlag:
+		return "duplicated flag"
+	case ErrTag:
+		return "tag"
+	case ErrCommandRequired:
+		return "command required"
+	case ErrUnknownCommand:
+		return "unknown command"
+	case ErrInvalidChoice:
+		return "invalid choice"
+	case ErrInvalidTag:
+		return "invalid tag"
+	}
+
+	return "unrecognized error type"
+}
+
+// Error returns the name of the error type, so that it can be used as an error.
+func (e ErrorType) Error() string {
+	return e.String()
+}
+
+// Error is an error returned by a parser, with its go-flags type. Errors of the generator
+// are wrapped: they can still be inspected with errors.Is and errors.As (eg. for the
+// *flags.Error of the root package, with the option or positional they concern).
+type Error struct {
+	// The type of error.
+	Type ErrorType
+
+	// The error message.
+	Message string
+
+	err error
+}
+
+// Error returns the error's message.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the error of the generator, if any.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// WroteHelp returns true if the error of a parser is the one returned when
+// the help of a command has been printed, instead of parsing its arguments.
+func WroteHelp(err error) bool {
+	var typed *Error
+
+	return errors.As(err, &typed) && typed.Type == ErrHelp
+}
+
+// newError wraps an error of the generator in an *Error, with its go-flags type.
+func newError(err error) error {
+	var typed *Error
+	if err == nil || errors.As(err, &typed) {
+		return err
+	}
+
+	return &Error{Type: errorType(err), Message: err.Error(), err: err}
+}
+
+// errorType returns the go-flags type of an error of the generator.
+func errorType(err error) ErrorType {
+	switch {
+	case errors.Is(err, flags.ErrUnknownFlag), strings.HasPrefix(err.Error(), "unknown shorthand flag"):
+		return ErrUnknownFlag
+	case errors.Is(err, flags.ErrInvalidValue):
+		return ErrMarshal
+	case errors.Is(err, flags.ErrRequiredFlag), errors.Is(err, flags.ErrRequiredGroup),
+		errors.Is(err, flags.ErrRequiredArgument):
+		return ErrRequired
+	case errors.Is(err, flags.ErrShortNameTooLong):
+		return ErrShortNameTooLong
+	case errors.Is(err, flags.ErrDuplicatedFlag):
+		return ErrDuplicatedFlag
+	case errors.Is(err, flags.ErrInvalidTag):
+		return ErrInvalidTag
+	case errors.Is(err, flags.ErrTag):
+		return ErrTag
+	case strings.HasPrefix(err.Error(), "unknown command"):
+		return ErrUnknownCommand
+	case strings.HasPrefix(err.Error(), "flag needs an argument"):
+		return ErrExpectedArgument
+	}
+
+	return ErrUnknown
+}
@@ -0,0 +1,291 @@
+package flags
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type appOptions struct {
+	Verbose bool   `short:"v" long:"verbose" description:"verbose output"`
+	Name    string `long:"name" choice:"alice" choice:"bob"`
+}
+
+type serverOptions struct {
+	Host string `long:"host"`
+	Port int    `long:"port" env:"PORT"`
+}
+
+type addCommand struct {
+	Force bool `short:"f" long:"force"`
+
+	Args struct {
+		File string `positional-arg-name:"file" required:"yes"`
+	} `positional-args:"yes"`
+
+	executed []string
+}
+
+func (c *addCommand) Execute(args []string) error {
+	c.executed = args
+
+	return nil
+}
+
+// TestParseArgs checks the parsing of options and positionals of a parser, and its commands.
+func TestParseArgs(t *testing.T) {
+	t.Parallel()
+
+	opts := &appOptions{}
+	add := &addCommand{}
+
+	parser := NewParser(opts, None)
+	_, err := parser.AddCommand("add", "add a file", "", add)
+	require.NoError(t, err)
+
+	args, err := parser.ParseArgs([]string{"-v", "add", "--force", "file.txt", "extra"})
+	require.NoError(t, err)
+
+	assert.True(t, opts.Verbose)
+	assert.True(t, add.Force)
+	assert.Equal(t, "file.txt", add.Args.File)
+	assert.Equal(t, []string{"extra"}, add.executed)
+	assert.Equal(t, []string{"extra"}, args)
+
+	opts = &appOptions{}
+	args, err = ParseArgs(opts, []string{"--name", "bob", "rest"})
+	require.NoError(t, err)
+	assert.Equal(t, "bob", opts.Name)
+	assert.Equal(t, []string{"rest"}, args)
+}
+
+// TestAddGroup checks that groups added to a parser have their namespace,
+// and that their options can be given to commands.
+func TestAddGroup(t *testing.T) {
+	t.Parallel()
+
+	opts := &appOptions{}
+	server := &serverOptions{}
+	add := &addCommand{}
+
+	parser := NewParser(opts, None)
+	group, err := parser.AddGroup("Server Options", "", server)
+	require.NoError(t, err)
+
+	group.Namespace = "server"
+
+	_, err = parser.AddCommand("add", "add a file", "", add)
+	require.NoError(t, err)
+
+	_, err = parser.ParseArgs([]string{"--server.host", "localhost", "add", "--server.port", "80", "file.txt"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "localhost", server.Host)
+	assert.Equal(t, 80, server.Port)
+	assert.Equal(t, "file.txt", add.Args.File)
+
+	_, err = parser.AddGroup("invalid", "", serverOptions{})
+	assert.ErrorIs(t, err, flags.ErrNotPointerToStruct)
+}
+
+// TestCommandGroups checks that commands with groups still run their implementation.
+func TestCommandGroups(t *testing.T) {
+	t.Parallel()
+
+	add := &addCommand{}
+	server := &serverOptions{}
+
+	parser := NewNamedParser("app", None)
+	cmd, err := parser.AddCommand("add", "add a file", "", add)
+	require.NoError(t, err)
+
+	_, err = cmd.AddGroup("Server Options", "", server)
+	require.NoError(t, err)
+
+	args, err := parser.ParseArgs([]string{"add", "--host", "remote", "file.txt", "extra"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "remote", server.Host)
+	assert.Equal(t, "file.txt", add.Args.File)
+	assert.Equal(t, []string{"extra"}, add.executed)
+	assert.Equal(t, []string{"extra"}, args)
+}
+
+// TestErrors checks the go-flags types of errors.
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args    []string
+		errType ErrorType
+	}{
+		{[]string{"--unknown"}, ErrUnknownFlag},
+		{[]string{"--name", "eve"}, ErrMarshal},
+		{[]string{"add"}, ErrRequired},
+		{[]string{"--name"}, ErrExpectedArgument},
+	}
+
+	for _, test := range tests {
+		parser := NewParser(&appOptions{}, None)
+		_, err := parser.AddCommand("add", "add a file", "", &addCommand{})
+		require.NoError(t, err)
+
+		_, err = parser.ParseArgs(test.args)
+
+		var typed *Error
+		require.True(t, errors.As(err, &typed), "%v: %v", test.args, err)
+		assert.Equal(t, test.errType, typed.Type, "%v: %v", test.args, err)
+	}
+
+	parser := NewNamedParser("app", None)
+	cmd, err := parser.AddCommand("remote", "", "", &struct{}{})
+	require.NoError(t, err)
+
+	_, err = cmd.AddCommand("add", "", "", &addCommand{})
+	require.NoError(t, err)
+	_, err = cmd.AddCommand("remove", "", "", &addCommand{})
+	require.NoError(t, err)
+
+	_, err = parser.ParseArgs([]string{"remote"})
+	require.Error(t, err)
+	assert.Equal(t, "Please specify one command of: add or remove", err.Error())
+}
+
+// TestRequiredOptions checks that required options not set
+// (on the command-line, or from their environment variables)
+// return an ErrRequired error, as go-flags does.
+func TestRequiredOptions(t *testing.T) {
+	t.Parallel()
+
+	var opts struct {
+		Name  string `long:"name" required:"true"`
+		Token string `long:"token" required:"true" env:"TOKEN"`
+	}
+
+	_, err := NewParser(&opts, None).ParseArgs(nil)
+
+	var typed *Error
+	require.True(t, errors.As(err, &typed), "%v", err)
+	assert.Equal(t, ErrRequired, typed.Type)
+	assert.Equal(t, "the required flags `--name' and `--token' were not specified", err.Error())
+	assert.ErrorIs(t, err, flags.ErrRequiredFlag)
+
+	parser := NewParser(&opts, None)
+	parser.ParseOptions = []flags.OptFunc{flags.WithEnviron([]string{"TOKEN=secret"})}
+
+	_, err = parser.ParseArgs([]string{"--name", "eve"})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", opts.Token)
+}
+
+// TestRepeatedBools checks that repeated short names of []bool options append true.
+func TestRepeatedBools(t *testing.T) {
+	t.Parallel()
+
+	var opts struct {
+		Verbose []bool `short:"v" long:"verbose"`
+	}
+
+	_, err := NewParser(&opts, None).ParseArgs([]string{"-vv", "-v"})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, true, true}, opts.Verbose)
+}
+
+// TestWroteHelp checks that printing the help of a command returns an ErrHelp error.
+func TestWroteHelp(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewParser(&appOptions{}, None).ParseArgs([]string{"--help"})
+	require.Error(t, err)
+	assert.True(t, WroteHelp(err))
+	assert.Contains(t, err.Error(), "--verbose")
+
+	assert.False(t, WroteHelp(nil))
+	assert.False(t, WroteHelp(errors.New("help")))
+}
+
+// TestOptions checks the options of a parser.
+func TestOptions(t *testing.T) {
+	t.Parallel()
+
+	opts := &appOptions{}
+
+	args, err := NewParser(opts, IgnoreUnknown).ParseArgs([]string{"--unknown", "-v"})
+	require.NoError(t, err)
+	assert.True(t, opts.Verbose)
+	assert.Empty(t, args)
+
+	opts = &appOptions{}
+
+	args, err = NewParser(opts, PassAfterNonOption).ParseArgs([]string{"word", "-v"})
+	require.NoError(t, err)
+	assert.False(t, opts.Verbose)
+	assert.Equal(t, []string{"word", "-v"}, args)
+}
+
+type defaultOptions struct {
+	Name  string   `long:"name" default:"alice"`
+	Port  int      `long:"port" default:"8080"`
+	Tags  []string `long:"tag" default:"a" default:"b"`
+	Level string   `long:"level"`
+}
+
+// TestDefaults checks that the default tags of options set their fields, as go-flags does,
+// and that the values given on the command-line replace those of repeated defaults.
+func TestDefaults(t *testing.T) {
+	t.Parallel()
+
+	opts := &defaultOptions{}
+
+	_, err := ParseArgs(opts, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", opts.Name)
+	assert.Equal(t, 8080, opts.Port)
+	assert.Equal(t, []string{"a", "b"}, opts.Tags)
+	assert.Empty(t, opts.Level)
+
+	opts = &defaultOptions{}
+
+	_, err = ParseArgs(opts, []string{"--name", "bob", "--tag", "c", "--tag", "d"})
+	require.NoError(t, err)
+	assert.Equal(t, "bob", opts.Name)
+	assert.Equal(t, 8080, opts.Port)
+	assert.Equal(t, []string{"c", "d"}, opts.Tags)
+
+	opts = &defaultOptions{Port: 9090}
+
+	_, err = ParseArgs(opts, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 9090, opts.Port)
+}
+
+// TestIniParser checks that INI configurations are set on the options of a parser.
+func TestIniParser(t *testing.T) {
+	t.Parallel()
+
+	opts := &appOptions{}
+	server := &serverOptions{}
+
+	parser := NewParser(opts, None)
+	_, err := parser.AddGroup("Server Options", "", server)
+	require.NoError(t, err)
+
+	config := "name = alice\n\n[Server Options]\nhost = localhost\n"
+	require.NoError(t, NewIniParser(parser).Parse(strings.NewReader(config)))
+
+	assert.Equal(t, "alice", opts.Name)
+	assert.Equal(t, "localhost", server.Host)
+
+	_, err = parser.ParseArgs([]string{"--name", "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, "bob", opts.Name)
+	assert.Equal(t, "localhost", server.Host)
+
+	err = NewIniParser(parser).Parse(strings.NewReader("unknown = yes\n"))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, flags.ErrConfig)
+}
@@ -0,0 +1,50 @@
+package flags
+
+import (
+	"io"
+
+	genflags "github.com/reeflective/flags/gen/flags"
+)
+
+// IniParser sets the values of an INI (or TOML) configuration on the options of a parser.
+// Sections are the paths of commands, or the names of groups (eg. [Application Options]),
+// as described by the ParseConfig function of the cobra generator (gen/flags).
+type IniParser struct {
+	parser *Parser
+}
+
+// NewIniParser creates a new INI parser for the options of a parser.
+func NewIniParser(p *Parser) *IniParser {
+	return &IniParser{parser: p}
+}
+
+// IniParse is a convenience function to parse the INI configuration file of a
+// path into data, as the options of a parser with the Default options.
+func IniParse(filename string, data interface{}) error {
+	return NewIniParser(NewParser(data, Default)).ParseFile(filename)
+}
+
+// ParseFile parses the INI configuration file of a path. See Parse.
+func (i *IniParser) ParseFile(filename string) error {
+	root, err := i.parser.generate()
+	if err != nil {
+		return newError(err)
+	}
+
+	defer genflags.Forget(root)
+
+	return newError(genflags.ParseConfigFile(root, filename))
+}
+
+// Parse parses an INI configuration, and sets its values on the options of the parser.
+// This should be called before parsing the command-line, whose values override them.
+func (i *IniParser) Parse(reader io.Reader) error {
+	root, err := i.parser.generate()
+	if err != nil {
+		return newError(err)
+	}
+
+	defer genflags.Forget(root)
+
+	return newError(genflags.ParseConfig(root, reader))
+}
@@ -0,0 +1,275 @@
+// Package flags (github.com/reeflective/flags/compat/goflags) exposes the parser API of
+// jessevdk/go-flags on top of the command trees generated by this library, so that its
+// users can switch their imports with minimal code changes:
+//
+//	import flags "github.com/reeflective/flags/compat/goflags"
+//
+//	parser := flags.NewParser(&opts, flags.Default)
+//	parser.AddCommand("add", "Add a file", "", &add)
+//
+//	args, err := parser.Parse()
+//
+// The structs keep their go-flags tags, and the commands run their Execute(args) methods.
+// Parsing is done by the cobra generator (gen/flags), thus with its semantics where they
+// differ from those of go-flags: the help flag is always available, values are checked
+// by their validators, and errors are those of the generator (wrapped in an *Error, so
+// that both their go-flags ErrorType and the errors of this library can be inspected).
+// Groups added with AddGroup are persistent: their options can be given to subcommands.
+package flags
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/reeflective/flags"
+	genflags "github.com/reeflective/flags/gen/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Options are the parsing behaviors of a Parser, which can be combined.
+type Options uint
+
+const (
+	// None indicates no options.
+	None Options = 0
+
+	// HelpFlag adds a default help flag (-h, --help). Since cobra
+	// adds one to all commands, the flag is always available.
+	HelpFlag Options = 1 << iota
+
+	// PassDoubleDash passes all arguments after a double dash (--) as
+	// remaining arguments, which cobra always does.
+	PassDoubleDash
+
+	// IgnoreUnknown ignores unknown flags, instead of returning an error.
+	IgnoreUnknown
+
+	// PrintErrors prints errors on stderr, with the usage of their command.
+	PrintErrors
+
+	// PassAfterNonOption passes all arguments after the first non-option
+	// argument as remaining arguments, instead of parsing them as options.
+	PassAfterNonOption
+
+	// AllowBoolValues allows values for boolean options (eg. --verbose=false),
+	// which pflag always does.
+	AllowBoolValues
+
+	// Default is a convenient default set of options, which should
+	// cover most use cases: HelpFlag | PrintErrors | PassDoubleDash.
+	Default = HelpFlag | PrintErrors | PassDoubleDash
+)
+
+// Parser parses the command-line arguments into the options, positionals and commands of
+// its data, groups and commands. The tree of commands is generated again for each parse.
+type Parser struct {
+	// Embedded, see Command for more information.
+	*Command
+
+	// A usage string to be displayed in the help message.
+	Usage string
+
+	// Option flags changing the behavior of the parser.
+	Options Options
+
+	// NamespaceDelimiter separates group namespaces and option long names.
+	NamespaceDelimiter string
+
+	// EnvNamespaceDelimiter separates group env namespaces and env keys.
+	EnvNamespaceDelimiter string
+
+	// Parsing options of this library, used to generate the command tree.
+	ParseOptions []flags.OptFunc
+}
+
+// NewParser creates a new parser, named after the program, for the options, positionals
+// and commands found in data (a pointer to a struct, or nil if there are none).
+func NewParser(data interface{}, options Options) *Parser {
+	parser := NewNamedParser(filepath.Base(os.Args[0]), options)
+
+	if data != nil {
+		parser.data = data
+	}
+
+	return parser
+}
+
+// NewNamedParser creates a new parser with an application name, and without
+// any options: they are added with AddGroup, and commands with AddCommand.
+func NewNamedParser(appname string, options Options) *Parser {
+	return &Parser{
+		Command:               &Command{Name: appname},
+		Options:               options,
+		NamespaceDelimiter:    ".",
+		EnvNamespaceDelimiter: "_",
+	}
+}
+
+// Parse is a convenience function to parse the command-line arguments of the program
+// into data, with the Default options. See Parser.ParseArgs for the return values.
+func Parse(data interface{}) ([]string, error) {
+	return NewParser(data, Default).Parse()
+}
+
+// ParseArgs is a convenience function to parse the given arguments
+// into data, with the Default options. See Parser.ParseArgs.
+func ParseArgs(data interface{}, args []string) ([]string, error) {
+	return NewParser(data, Default).ParseArgs(args)
+}
+
+// Parse parses the command-line arguments of the program (os.Args[1:]). See ParseArgs.
+func (p *Parser) Parse() ([]string, error) {
+	return p.ParseArgs(os.Args[1:])
+}
+
+// ParseArgs parses the arguments into the options and positionals of the parser, and runs
+// the Execute(args) method of the command they target, if it implements flags.Commander.
+// It returns the arguments not parsed into options or positionals, or an *Error, whose
+// type is ErrHelp if the help of a command has been printed (see WroteHelp).
+func (p *Parser) ParseArgs(args []string) ([]string, error) {
+	root, err := p.generate()
+	if err != nil {
+		return nil, newError(err)
+	}
+
+	// The tree is only used for this command-line.
+	defer genflags.Forget(root)
+
+	root.SetArgs(args)
+
+	target, err := root.ExecuteC()
+	if err != nil {
+		return nil, newError(err)
+	}
+
+	if help := target.Flags().Lookup("help"); help != nil && help.Changed {
+		return nil, &Error{Type: ErrHelp, Message: target.UsageString()}
+	}
+
+	return genflags.RemainingArgs(target), nil
+}
+
+// generate returns the command tree of the parser, with the behaviors of its options.
+// Trees which could not be generated are forgotten, and others should be once used.
+func (p *Parser) generate() (root *cobra.Command, err error) {
+	root = &cobra.Command{
+		Use:              p.Name,
+		Annotations:      map[string]string{},
+		TraverseChildren: true,
+		SilenceUsage:     p.Options&PrintErrors == 0,
+		SilenceErrors:    p.Options&PrintErrors == 0,
+	}
+
+	if p.Usage != "" {
+		root.Use += " " + p.Usage
+	}
+
+	defer func() {
+		if err != nil {
+			genflags.Forget(root)
+		}
+	}()
+
+	data := p.scanned(p)
+
+	// Options are set to their default values, as go-flags does.
+	opts := append([]flags.OptFunc{flags.WithTagDefaults()}, p.ParseOptions...)
+
+	if err := genflags.Bind(root, data, opts...); err != nil {
+		return nil, err
+	}
+
+	p.bindRuns(root, data)
+
+	if err := p.addCommands(root); err != nil {
+		return nil, err
+	}
+
+	p.setOptions(root)
+
+	return root, nil
+}
+
+// setOptions applies the options of the parser to all the commands of a tree,
+// and makes the commands without implementation runnable, as go-flags does.
+func (p *Parser) setOptions(cmd *cobra.Command) {
+	for _, subc := range cmd.Commands() {
+		p.setOptions(subc)
+	}
+
+	cmd.FParseErrWhitelist.UnknownFlags = p.Options&IgnoreUnknown != 0
+	cmd.Flags().SetInterspersed(p.Options&PassAfterNonOption == 0)
+
+	repeatBools(cmd)
+	checkRequired(cmd)
+
+	if cmd.Runnable() {
+		return
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return commandRequired(cmd)
+	}
+}
+
+// repeatBools makes the short names of the []bool options of a command
+// take no value, so that each of them repeated (eg. -vv) appends true.
+func repeatBools(cmd *cobra.Command) {
+	for _, flagSet := range []*pflag.FlagSet{cmd.Flags(), cmd.PersistentFlags()} {
+		flagSet.VisitAll(func(flag *pflag.Flag) {
+			if flag.Value.Type() == "boolSlice" {
+				flag.NoOptDefVal = "true"
+			}
+		})
+	}
+}
+
+// checkRequired makes a command check, once its options are set (from the command-line
+// or their environment variables), that its required options (and inherited ones) are.
+func checkRequired(cmd *cobra.Command) {
+	preRunE, preRun := cmd.PreRunE, cmd.PreRun
+
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if preRunE != nil {
+			if err := preRunE(cmd, args); err != nil {
+				return err
+			}
+		} else if preRun != nil {
+			preRun(cmd, args)
+		}
+
+		return requiredError(cmd)
+	}
+}
+
+// requiredError returns the go-flags error of the required options of a command
+// which have not been set, or nil if there are none.
+func requiredError(cmd *cobra.Command) error {
+	var names []string
+
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if required := flag.Annotations["flags"]; len(required) == 0 || required[0] != "required" {
+			return
+		}
+
+		if genflags.FlagOrigin(cmd, flag.Name) == genflags.OriginDefault {
+			names = append(names, "`--"+flag.Name+"'")
+		}
+	})
+
+	var message string
+
+	switch last := len(names) - 1; last {
+	case -1:
+		return nil
+	case 0:
+		message = fmt.Sprintf("the required flag %s was not specified", names[0])
+	default:
+		message = fmt.Sprintf("the required flags %s and %s were not specified", strings.Join(names[:last], ", "), names[last])
+	}
+
+	return &Error{Type: ErrRequired, Message: message, err: fmt.Errorf("%w: %s", flags.ErrRequiredFlag, message)}
+}
@@ -0,0 +1,81 @@
+package flags
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/reeflective/flags/internal/tag"
+)
+
+// withCounterTags wraps a Counter value when its field has a `max` and/or a
+// `step` tag, so that it is increased by this step and does not exceed this max.
+func withCounterTags(val Value, mtag tag.MultiTag) (Value, error) {
+	counter, isCounter := val.(*Counter)
+	if !isCounter {
+		return val, nil
+	}
+
+	maxTag, hasMax := mtag.Get("max")
+	stepTag, hasStep := mtag.Get("step")
+
+	if !hasMax && !hasStep {
+		return val, nil
+	}
+
+	bounded := &boundedCounter{Counter: counter, step: 1, max: -1}
+
+	if hasMax {
+		maxCount, err := strconv.Atoi(maxTag)
+		if err != nil || maxCount < 0 {
+			return val, fmt.Errorf("%w: max: %q is not a positive count", ErrInvalidTag, maxTag)
+		}
+
+		bounded.max = maxCount
+	}
+
+	if hasStep {
+		step, err := strconv.Atoi(stepTag)
+		if err != nil || step < 1 {
+			return val, fmt.Errorf("%w: step: %q is not a strictly positive count", ErrInvalidTag, stepTag)
+		}
+
+		bounded.step = step
+	}
+
+	return bounded, nil
+}
+
+// boundedCounter is a Counter increased by a given step, up to an optional maximum.
+type boundedCounter struct {
+	*Counter
+	step int
+	max  int // Negative when the count is not bounded
+}
+
+var _ RepeatableFlag = (*boundedCounter)(nil)
+
+// Set increases the counter by its step when no specific count is given,
+// and returns ErrCounterMax if the new count would exceed its maximum.
+func (v *boundedCounter) Set(s string) error {
+	count := int(*v.Counter) + v.step
+
+	// Like for Counter, -1 means that no specific value was passed.
+	if s != "" && s != "true" {
+		parsed, err := strconv.ParseInt(s, 0, 0)
+		if err != nil {
+			return err
+		}
+
+		if parsed != -1 {
+			count = int(parsed)
+		}
+	}
+
+	if v.max >= 0 && count > v.max {
+		return fmt.Errorf("%w: %d (max %d)", ErrCounterMax, count, v.max)
+	}
+
+	*v.Counter = Counter(count)
+
+	return nil
+}
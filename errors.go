@@ -1,8 +1,12 @@
 package flags
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+
+	"github.com/reeflective/flags/internal/positional"
+	"github.com/reeflective/flags/internal/validation"
 )
 
 var (
@@ -16,6 +20,9 @@ var (
 
 	// ErrNotCommander is returned when an embedded struct is tagged as a command,
 	// but does not implement even the most simple interface, Commander.
+	//
+	// Deprecated: commands not implementing Commander are pure parent commands,
+	// grouping their subcommands, and this error is not returned anymore.
 	ErrNotCommander = errors.New("provided data does not implement Commander")
 
 	// ErrObjectIsNil is returned when the struct/object/pointer is nil.
@@ -37,8 +44,131 @@ var (
 	// ErrNotValue indicates that a struct field type does not implement the
 	// Value interface. This only happens when the said type is a user-defined one.
 	ErrNotValue = errors.New("invalid field marked as flag")
+
+	// ErrRequiredGroup indicates that a group of options requiring a minimum
+	// number of them to be set on the command-line has not had enough of them.
+	ErrRequiredGroup = errors.New("required group options")
+
+	// ErrRequiredFlag indicates that an option has been set on the command-line
+	// without the other options it requires (`requires` tag).
+	ErrRequiredFlag = errors.New("required options")
+
+	// ErrConflictingFlags indicates that an option has been set on the command-line
+	// along with other options it conflicts with (`conflicts-with` tag).
+	ErrConflictingFlags = errors.New("conflicting options")
+
+	// ErrCheck indicates that a value given to an option or positional
+	// does not pass one of the checks of its `check` tag.
+	ErrCheck = validation.ErrCheck
+
+	// ErrConfig indicates that a configuration file is malformed,
+	// or that it refers to unknown commands or options.
+	ErrConfig = errors.New("configuration error")
+
+	// ErrReservedName indicates that a command uses a name (or an alias)
+	// reserved for the internal commands of the library or its generators.
+	ErrReservedName = errors.New("reserved command name")
+
+	// ErrDuplicatedFlag indicates that a short or long flag has been
+	// defined more than once on a command, eg. in two option groups.
+	ErrDuplicatedFlag = errors.New("duplicated flag")
+
+	// ErrCounterMax indicates that a Counter option has been given more
+	// times than allowed by the `max` tag of its struct field.
+	ErrCounterMax = errors.New("counter maximum exceeded")
+
+	// ErrLimit indicates that a command-line exceeds one of the limits set with
+	// the MaxArgs, MaxArgLength or MaxElements options. It is wrapped by the more
+	// specific errors below, one for each limit.
+	ErrLimit = errors.New("command-line limit exceeded")
+
+	// ErrTooManyArgs indicates that a command-line has more words than allowed.
+	ErrTooManyArgs = fmt.Errorf("%w: too many arguments", ErrLimit)
+
+	// ErrArgTooLong indicates that a word or flag value is longer than allowed.
+	ErrArgTooLong = fmt.Errorf("%w: argument too long", ErrLimit)
+
+	// ErrTooManyElements indicates that a list flag or positional has more elements than allowed.
+	ErrTooManyElements = fmt.Errorf("%w: too many elements", ErrLimit)
+
+	// ErrInvalidValue indicates that a value given to an option or positional
+	// could not be set on its field, because it could not be converted to its
+	// type or because it has been rejected by one of its validators.
+	ErrInvalidValue = errors.New("invalid argument")
+
+	// ErrRequiredArgument indicates that a positional argument has
+	// not been given its minimum amount of words on the command-line.
+	ErrRequiredArgument = positional.ErrRequired
+
+	// ErrUnknownFlag indicates that a flag given on the command-line
+	// is not one of the options of the command, nor of its parents.
+	ErrUnknownFlag = errors.New("unknown flag")
 )
 
+// Error is a failure of the parsing or validation of a command-line, along with the
+// command, option, group or positional it concerns. Generators return such errors for
+// invalid values, missing positionals and unsatisfied groups or relations of options,
+// so that callers can inspect them with errors.As, and render them themselves (eg. as
+// JSON, with its MarshalJSON method), instead of matching the text of their message.
+type Error struct {
+	Kind       error  // The error category (ex: ErrInvalidValue), matched by errors.Is
+	Command    string // The path of the command being parsed, if known
+	Flag       string // The long name of the option concerned, if any
+	Group      string // The name of the group of options concerned, if any
+	Positional string // The name of the positional argument concerned, if any
+	Value      string // The value given to the option or positional, if any
+	Err        error  // The underlying error, whose message is the one of the Error
+}
+
+// Error returns the message of the underlying error, sanitized since
+// it may contain values given on the command-line (see Sanitize).
+func (e *Error) Error() string {
+	return Sanitize(e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if the target is the kind of the error.
+func (e *Error) Is(target error) bool {
+	return e.Kind != nil && target == e.Kind
+}
+
+// MarshalJSON renders the error as a JSON object, with its kind and message.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	var kind string
+	if e.Kind != nil {
+		kind = e.Kind.Error()
+	}
+
+	return json.Marshal(struct {
+		Kind       string `json:"kind"`
+		Message    string `json:"message"`
+		Command    string `json:"command,omitempty"`
+		Flag       string `json:"flag,omitempty"`
+		Group      string `json:"group,omitempty"`
+		Positional string `json:"positional,omitempty"`
+		Value      string `json:"value,omitempty"`
+	}{kind, e.Error(), e.Command, e.Flag, e.Group, e.Positional, e.Value})
+}
+
+// ValueError returns the error of the last failed attempt to set a value of an option
+// scanned by this package, or nil if it has been successfully set since then. pflag only
+// keeps the message of these errors in its own ones: generators use this function to
+// return errors that can still be inspected.
+func ValueError(value Value) *Error {
+	switch errored := value.(type) {
+	case *erroredValue:
+		return errored.err
+	case *erroredBoolValue:
+		return errored.err
+	default:
+		return nil
+	}
+}
+
 // simple wrapper for errors.
 func newError(err error, msg string) error {
 	return fmt.Errorf("%s: %w", msg, err)
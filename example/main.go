@@ -45,7 +45,8 @@ func main() {
 	// scan handler: it will generate completers if it finds tags
 	// specifying what to complete, or completer implementations
 	// by the positional arguments / command flags' types themselves.
-	comps, _ := completions.Generate(rootCmd, rootData, nil)
+	// It accepts the same options as the command generator.
+	comps, _ := completions.Generate(rootCmd, rootData, nil, opts...)
 
 	// (Needed by carapace library to mute some cobra commands)
 	comps.Standalone()
@@ -5,6 +5,7 @@ package flags
 type Flag struct {
 	Name       string // name as it appears on command line
 	Short      string // optional short name
+	ShortOnly  bool   // only the short name is shown, the long one (derived from the field name) is hidden
 	EnvName    string
 	Usage      string   // help message
 	Value      Value    // value as set
@@ -26,4 +27,59 @@ type Flag struct {
 	// the value of the field this option represents will be set to
 	// OptionalValue. This is only valid for non-boolean options.
 	OptionalValue []string
+
+	// If true, the option is explicitly tagged with `env`, and its value is read from
+	// its environment variable (EnvName) once the command-line is parsed (see SetEnv),
+	// split with EnvDelim if not empty. Options only set from their environment variable
+	// (EnvOnly, with a `no-flag` tag) have no flag: generators must not add them to
+	// command-lines, and only set them with SetEnv.
+	Env      bool
+	EnvDelim string
+	EnvOnly  bool
+
+	// If non empty, the name of another option whose value this option takes
+	// when it is not set by any other means (its own default value excepted).
+	DefaultFrom string
+
+	// The message shown when a deprecated option is used (eg. its replacement),
+	// and the previous names of the option, still accepted but hidden.
+	Deprecation string
+	RenamedFrom []string
+
+	// Other long names of the option, sharing its value, and whether they
+	// are shown in help usages (with the ShowFlagAliases() option).
+	Aliases     []string
+	ShowAliases bool
+
+	// For negatable boolean options, the name of the flag setting them
+	// to false, made of the negation prefix and of the option name (after
+	// the prefix of its group, if any: eg. "ns.no-cache").
+	Negation string
+
+	// The names of the options that must (or must not) be set on the command-line
+	// along with this one, and the name of a group of options (possibly declared on
+	// several commands) of which at least one must be set on the command-line.
+	Requires      []string
+	ConflictsWith []string
+	OneRequired   string
+
+	// Conditions making the option required on the command-line: other options
+	// being set (name) or having a given value (name=value) for RequiredIf,
+	// and other options not being set for RequiredUnless.
+	RequiredIf     []string
+	RequiredUnless []string
+
+	// If true, the value of the (string) option is a template, executed once
+	// the command-line is parsed with the values of the other options.
+	Interpolate bool
+
+	// The completion directives of the option (eg. `Files` or `FilterExt,json`),
+	// as declared in its `complete` tags, for completion backends.
+	Completers []string
+
+	// The source and key of its default value, in the name:key format (see WithSource).
+	Source string
+
+	// A pointer to the struct field of the option (nil if not addressable).
+	Field interface{}
 }
@@ -31,9 +31,13 @@
 //	    rootData := &commands.Root{}
 //	    rootCmd := genflags.Generate(rootData, opts...)
 //
-//	    comps, _ := completions.Generate(rootCmd, rootData, nil)
+//	    comps, _ := completions.Generate(rootCmd, rootData, nil, opts...)
 //	}
 //
+// The same list of options should be given to all generators (commands, completions,
+// or any other), so that they all scan and name the commands/flags identically. There
+// is a single set of options (OptFunc) for all of them, listed below: see section 4.
+//
 // 2) Global parsing options (base) ------------------------------------------------------
 //
 // Most of the options below are inherited from github.com/octago/sflags, with some added.
@@ -88,4 +92,53 @@
 // operations on the value of the flag identified by the <flag> name parameter of FlagFunc.
 //
 // func FlagHandler(val FlagFunc)
+//
+// OnSet registers a hook to be called each time the struct field pointed
+// to by field is set while parsing the command-line, with its old/new values.
+//
+// func OnSet[T any](field *T, hook func(old, new T)) OptFunc
+//
+// WithLocale makes number and duration flags/positionals to also accept values
+// written in the conventions of a language, like `1.234,56` or `1,5 Stunden`.
+//
+// func WithLocale(lang language.Tag) OptFunc
+//
+// PlusToggles makes words like `+x` or `+xv` to set the boolean flags with
+// these short names to false, as the opposite of `-x`/`-xv`.
+//
+// func PlusToggles() OptFunc
+//
+// WithMode sets the execution frontend (ModeCLI or ModeREPL) for which the
+// commands and options are generated: those tagged with another mode are ignored.
+//
+// func WithMode(mode string) OptFunc
+//
+// CollectUnknownFlags makes unknown flags given to a command to be collected in
+// its `map[string]string` field tagged with `unknown:""`, instead of being errors.
+//
+// func CollectUnknownFlags() OptFunc
+//
+// 4) Options shared by all generators ----------------------------------------------------
+//
+// All entrypoints of this library take the same OptFunc list, and there are no options
+// specific to one of them: those of no use to a generator are simply ignored by it.
+// These entrypoints are:
+//
+//   - gen/flags:       Generate, Bind, AddCommand, ParseArgs, Parse, ExecuteArgs
+//   - gen/completions: Generate, AddCommand (using the options of the tree if not given)
+//   - gen/urfave:      Generate, Commands
+//   - gen/docs, gen/man, gen/proto: Generate, Write (and proto.Invocation)
+//   - compat/goflags:  the ParseOptions of a Parser
+//   - this package:    Extract, Lint, ConfigMap, BindConfig, SubcommandAliases, CheckArgs
+//
+// The options are, by concern:
+//
+//   - Naming:      DescTag, FlagTag, Prefix, FlagDivider, Flatten, ParseAll, WithKongTags,
+//     WithReservedNames, WithMode
+//   - Values:      Validator, FlagHandler, OnSet, ChoiceCaseInsensitive, WithLocale,
+//     WithTagDefaults, PlusToggles, CollectUnknownFlags, MaxArgs, MaxArgLength, MaxElements
+//   - Environment: EnvPrefix, EnvDivider, WithEnviron, WithEnvLookup, WithSource
+//   - Completions: WithCompletionInstall, WithPlugins, and completions.WithCompleter
+//   - Help:        WithCatalog, WithColors, WithHelpWidth, ShowFlagAliases
+//   - Execution:   WithSignalCancel, WithShutdown
 package flags
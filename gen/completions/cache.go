@@ -0,0 +1,57 @@
+package completions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/tag"
+	comp "github.com/rsteube/carapace"
+	"github.com/rsteube/carapace/pkg/cache"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// cacheTagName is the tag of options and positionals whose completions are cached
+// for some time, like `complete-cache:"30s"` (see time.ParseDuration).
+const cacheTagName = "complete-cache"
+
+// cachedCompleter returns a completer whose completions are cached for the duration of the
+// `complete-cache` tag of an option or positional (an element of a command, eg. --name or
+// <name>), if any. They are persisted in the user cache directory (XDG_CACHE_HOME), by path of
+// the command, element and prefix completed, and by the arguments and other options already
+// on the command-line (which completers might use), so that they are shared between completions
+// of the shell. Completions with messages (eg. errors) are not cached.
+func cachedCompleter(cmd *cobra.Command, element string, mtag tag.MultiTag, completer comp.CompletionCallback) (comp.CompletionCallback, error) {
+	ttl, isSet := mtag.Get(cacheTagName)
+	if !isSet || completer == nil {
+		return completer, nil
+	}
+
+	timeout, err := time.ParseDuration(ttl)
+	if err != nil || timeout <= 0 {
+		return nil, fmt.Errorf("%w: invalid completion cache duration %q on %s", flags.ErrInvalidTag, ttl, element)
+	}
+
+	path := strings.Join(append([]string{cmd.Root().Name()}, commandPath(cmd)...), " ")
+
+	return func(ctx comp.Context) comp.Action {
+		key := cache.String(path, element, strings.Join(ctx.Parts, "\x00"), ctx.Value,
+			strings.Join(ctx.Args, "\x00"), changedOptions(cmd))
+
+		return comp.ActionCallback(completer).Cache(timeout, key)
+	}, nil
+}
+
+// changedOptions returns the options of a command (and inherited ones)
+// set on the command-line being completed, with their values.
+func changedOptions(cmd *cobra.Command) string {
+	var options []string
+
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		options = append(options, flag.Name+"="+flag.Value.String())
+	})
+
+	return strings.Join(options, "\x00")
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/reeflective/flags"
+	genflags "github.com/reeflective/flags/gen/flags"
 	"github.com/reeflective/flags/internal/scan"
 	"github.com/reeflective/flags/internal/tag"
 	comp "github.com/rsteube/carapace"
@@ -17,20 +18,40 @@ import (
 // @cmd   - The application root cobra command, or an arbitrary one.
 // @data  - The struct containing commands/flags/positionals to scan for.
 // @comps - An optional, preexisting carapace engine. Most of the time, this can be nil.
+// @opts  - The same parsing options as those given to the flags.Generate() call,
+//          so that flags are named/scanned identically for completions. If none
+//          are given, those the tree of the command was generated with are used.
 //
 // Returns the carapace, so you can further work with/register completions should you like to.
-func Generate(cmd *cobra.Command, data interface{}, comps *comp.Carapace) (*comp.Carapace, error) {
+func Generate(cmd *cobra.Command, data interface{}, comps *comp.Carapace, opts ...flags.OptFunc) (*comp.Carapace, error) {
+	if len(opts) == 0 {
+		opts = genflags.Options(cmd)
+	}
+
 	// Generate the completions a first time.
-	completions, err := generate(cmd.Root(), data, comps)
+	completions, err := generate(cmd.Root(), data, comps, opts)
 	if err != nil {
 		return completions, err
 	}
 
+	// Negatable options are exclusive with their negative flags.
+	completeNames(cmd.Root())
+
+	// Plugin commands are completed by their executables, if enabled.
+	if flags.ScanOptions(opts...).Plugins {
+		pluginCompletions(cmd.Root())
+	}
+
+	// Completion scripts can be installed by users, if enabled.
+	if flags.ScanOptions(opts...).CompletionInstall {
+		installCommand(cmd.Root(), completions, opts)
+	}
+
 	return completions, nil
 }
 
 // generate wraps all main steps' invocations, to be reused in various cases.
-func generate(cmd *cobra.Command, data interface{}, comps *comp.Carapace) (*comp.Carapace, error) {
+func generate(cmd *cobra.Command, data interface{}, comps *comp.Carapace, opts []flags.OptFunc) (*comp.Carapace, error) {
 	if comps == nil {
 		comps = comp.Gen(cmd)
 	}
@@ -39,63 +60,79 @@ func generate(cmd *cobra.Command, data interface{}, comps *comp.Carapace) (*comp
 	// which is used for flags that are not contained in a struct group.
 	defaultFlagComps := flagSetComps{}
 
+	// Words like +x are completed with the short names of boolean flags,
+	// unless the command has positionals, which handle them on their own.
+	if flags.ScanOptions(opts...).PlusToggles {
+		comps.PositionalAnyCompletion(styled(comp.ActionCallback(toggleCompletions(cmd)), opts))
+	}
+
 	// A command always accepts embedded subcommand struct fields, so scan them.
-	compScanner := completionScanner(cmd, comps, &defaultFlagComps)
+	compScanner := completionScanner(cmd, comps, &defaultFlagComps, opts)
 
 	// Scan the struct recursively, for both arg/option groups and subcommands
 	if err := scan.Type(data, compScanner); err != nil {
 		return comps, fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
 	}
 
+	if err := fieldPositionals(cmd, comps, data, opts); err != nil {
+		return comps, err
+	}
+
 	return comps, nil
 }
 
 // completionScanner is in charge of building a recursive scanner, working on a given
 // struct field at a time, checking for arguments, subcommands and option groups.
-func completionScanner(cmd *cobra.Command, comps *comp.Carapace, flags *flagSetComps) scan.Handler {
+func completionScanner(cmd *cobra.Command, comps *comp.Carapace, flagSet *flagSetComps, opts []flags.OptFunc) scan.Handler {
 	handler := func(val reflect.Value, sfield *reflect.StructField) (bool, error) {
-		mtag, none, err := tag.GetFieldTag(*sfield)
+		mtag, none, err := flags.ScanOptions(opts...).FieldTag(*sfield)
 		if none || err != nil {
 			return true, fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
 		}
 
+		// Fields used in another execution mode have not been generated.
+		if !flags.InMode(mtag, opts...) {
+			return true, nil
+		}
+
 		// If the field is marked as -one or more- positional arguments, we
 		// return either on a successful scan of them, or with an error doing so.
-		if found, err := positionals(comps, mtag, val); found || err != nil {
+		if found, err := positionals(cmd, comps, mtag, val, opts); found || err != nil {
 			return found, err
 		}
 
 		// Else, if the field is marked as a subcommand, we either return on
 		// a successful scan of the subcommand, or with an error doing so.
-		if found, err := command(cmd, mtag, val); found || err != nil {
+		if found, err := command(cmd, mtag, val, opts); found || err != nil {
 			return found, err
 		}
 
 		// Else, try scanning the field as a group of commands/options,
 		// and only use the completion stuff we find on them.
-		if found, err := groupComps(comps, cmd, val, sfield); found || err != nil {
+		if found, err := groupComps(comps, cmd, val, sfield, opts); found || err != nil {
 			return found, err
 		}
 
 		// Else, try scanning the field as a simple option flag
-		return flagComps(comps, flags)(val, sfield)
+		return flagComps(comps, cmd, flagSet, opts)(val, sfield)
 	}
 
 	return handler
 }
 
 // command finds if a field is marked as a command, and if yes, scans it.
-func command(cmd *cobra.Command, tag tag.MultiTag, val reflect.Value) (bool, error) {
+func command(cmd *cobra.Command, tag tag.MultiTag, val reflect.Value, opts []flags.OptFunc) (bool, error) {
 	// Parse the command name on struct tag...
 	name, _ := tag.Get("command")
 	if len(name) == 0 {
 		return false, nil
 	}
 
-	// ... and check the field implements at least the Commander interface
-	_, implements, commander := flags.IsCommand(val)
-	if !implements {
-		return false, nil
+	// Commands do not have to implement Commander: those which do
+	// not are pure parents, only used to group their subcommands.
+	ptrval, _, _ := flags.IsCommand(val)
+	if ptrval.IsNil() {
+		ptrval.Set(reflect.New(ptrval.Type().Elem()))
 	}
 
 	var subc *cobra.Command
@@ -114,9 +151,31 @@ func command(cmd *cobra.Command, tag tag.MultiTag, val reflect.Value) (bool, err
 	// Simply generate a new carapace around this command,
 	// so that we can register different positional arguments
 	// without overwriting those of our root command.
-	if _, err := generate(subc, commander, nil); err != nil {
+	if _, err := generate(subc, ptrval.Interface(), nil, flags.CommandOptions(tag, opts...)); err != nil {
 		return true, err
 	}
 
 	return true, nil
 }
+
+// commandPath returns the names of a command and of its parents, without the root one.
+func commandPath(cmd *cobra.Command) []string {
+	if !cmd.HasParent() {
+		return nil
+	}
+
+	return append(commandPath(cmd.Parent()), cmd.Name())
+}
+
+// AddCommand generates the completions of a subcommand added to a tree once generated
+// (see genflags.AddCommand), from its command struct. The options should be the same as
+// those given to Generate(): if none are given, those of the tree of the command are used.
+func AddCommand(cmd *cobra.Command, data interface{}, opts ...flags.OptFunc) error {
+	if len(opts) == 0 {
+		opts = genflags.Options(cmd)
+	}
+
+	_, err := generate(cmd, data, nil, opts)
+
+	return err
+}
@@ -0,0 +1,124 @@
+// Package completest provides helpers for testing the completions of command trees
+// generated with the completions package, without spawning shells: command lines are
+// completed by the completion engine of the tree (as shells do), and the results are
+// returned as structured candidates, with their descriptions and groups.
+package completest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// ErrComplete indicates that a command line could not be completed.
+var ErrComplete = errors.New("completion failed")
+
+// Candidate is a value completed for a command line.
+type Candidate struct {
+	Value       string `json:"value"`                 // The value inserted in the command line
+	Display     string `json:"display"`               // The value as shown to the user
+	Description string `json:"description,omitempty"` // The description of the value, if any
+	Style       string `json:"style,omitempty"`       // The style of the value, if any
+	Group       string `json:"tag,omitempty"`         // The group (tag) of the value, if any
+}
+
+// Result holds the completions of a command line.
+type Result struct {
+	Candidates []Candidate `json:"values"`   // The completed values, sorted
+	Messages   []string    `json:"messages"` // The messages shown to the user (eg. errors)
+	NoSpace    string      `json:"nospace"`  // The suffixes after which no space is added ("*" for all)
+	Usage      string      `json:"usage"`    // The usage of the option or positional completed
+}
+
+// Complete returns the completions of a command line by the tree of a command, as a shell
+// would get them: the last word is the one being completed (possibly empty), and the
+// others are those before it, without the program name. The flags of the commands of the
+// tree are parsed along the way, so each test should complete with a new tree.
+func Complete(cmd *cobra.Command, words ...string) (Result, error) {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+
+	root := cmd.Root()
+	out := &bytes.Buffer{}
+
+	root.SetOut(out)
+	root.SetArgs(append([]string{"_carapace", "export", root.Name()}, words...))
+
+	defer root.SetOut(nil)
+	defer root.SetArgs(nil)
+
+	if err := root.Execute(); err != nil {
+		return Result{}, fmt.Errorf("%w: %s", ErrComplete, err.Error())
+	}
+
+	var result Result
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
+		return Result{}, fmt.Errorf("%w: invalid output %q: %s", ErrComplete, out.String(), err.Error())
+	}
+
+	return result, nil
+}
+
+// Values returns the values of the candidates.
+func (r Result) Values() []string {
+	values := make([]string, 0, len(r.Candidates))
+	for _, candidate := range r.Candidates {
+		values = append(values, candidate.Value)
+	}
+
+	return values
+}
+
+// Groups returns the names of the groups of the candidates, in order of appearance.
+func (r Result) Groups() []string {
+	var groups []string
+
+	seen := map[string]bool{}
+
+	for _, candidate := range r.Candidates {
+		if !seen[candidate.Group] {
+			seen[candidate.Group] = true
+			groups = append(groups, candidate.Group)
+		}
+	}
+
+	return groups
+}
+
+// Group returns the candidates of a group.
+func (r Result) Group(name string) []Candidate {
+	var candidates []Candidate
+
+	for _, candidate := range r.Candidates {
+		if candidate.Group == name {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates
+}
+
+// Description returns the description of a candidate, and whether the candidate is found.
+func (r Result) Description(value string) (string, bool) {
+	for _, candidate := range r.Candidates {
+		if candidate.Value == value {
+			return candidate.Description, true
+		}
+	}
+
+	return "", false
+}
+
+// NoSpaceAfter returns true if no space is added after a completed value ending with a suffix.
+func (r Result) NoSpaceAfter(value string) bool {
+	if strings.Contains(r.NoSpace, "*") {
+		return true
+	}
+
+	return value != "" && strings.ContainsRune(r.NoSpace, []rune(value)[len([]rune(value))-1])
+}
@@ -0,0 +1,52 @@
+package completest
+
+import (
+	"testing"
+
+	"github.com/reeflective/flags/gen/completions"
+	genflags "github.com/reeflective/flags/gen/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// addCommand is a command whose options are completed.
+type addCommand struct {
+	Mode string `long:"mode" choice:"fetch" choice:"push" choice-desc:"push:push only"`
+}
+
+func (c *addCommand) Execute(args []string) error { return nil }
+
+// TestComplete checks that command lines are completed with structured results.
+func TestComplete(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Remote struct {
+			Add addCommand `command:"add" description:"add a remote"`
+		} `command:"remote"`
+	}{}
+
+	newTree := func() *cobra.Command {
+		root := genflags.Generate(&data)
+		_, err := completions.Generate(root, &data, nil)
+		assert.NoError(t, err)
+
+		return root
+	}
+
+	test := assert.New(t)
+
+	result, err := Complete(newTree(), "remote", "add", "--mode", "")
+	test.NoError(err)
+	test.Equal([]string{"fetch", "push"}, result.Values())
+
+	description, found := result.Description("push")
+	test.True(found)
+	test.Equal("push only", description)
+
+	result, err = Complete(newTree(), "remote", "")
+	test.NoError(err)
+	test.Equal([]string{"add"}, result.Values())
+	test.Equal([]string{"commands"}, result.Groups())
+	test.Equal("add a remote", result.Group("commands")[0].Description)
+}
@@ -1,21 +1,110 @@
 package completions
 
 import (
+	"context"
 	"errors"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
 	"reflect"
 	"strings"
+	"syscall"
+	"time"
+	"unicode"
 
+	"github.com/reeflective/flags"
+	genflags "github.com/reeflective/flags/gen/flags"
+	"github.com/reeflective/flags/internal/scan"
 	"github.com/reeflective/flags/internal/tag"
+	"github.com/reeflective/flags/internal/validation"
 	comp "github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // Completer represents a type that is able to return some completions based on the current carapace Context.
 // Please see https://rsteube.github.io/carapace/carapace.html for using the carapace library completers,
 // or https://github.com/reeflective/flags/wiki/Completions for an overview of completion features/use.
+//
+// Completers may be called concurrently: carapace invokes batched actions in parallel, and
+// consoles may complete several command-lines at once. The state used by the generated
+// completions is either read-only once generated, or local to each completion, so that
+// completers only need to synchronize the state they share themselves (eg. a cache).
+//
+// The candidates and descriptions built from struct tags are sanitized by the generator,
+// but those of completers are written as is: completers using untrusted data (eg. from a
+// remote API) should pass it through flags.SanitizeLine, so that it cannot inject terminal
+// escape sequences or break the completion scripts of shells.
 type Completer interface {
 	Complete(ctx comp.Context) comp.Action
 }
 
+// CompleterContext is a Completer that is also given a context.Context, which is
+// canceled when its deadline (CompleterTimeout) is exceeded, or when the shell
+// interrupts the completion process. Completers querying remote services should
+// implement it and honor the context, rather than leaking their goroutines.
+// The context also gives access to the options already given on the command line,
+// with OptionValues, so that completions can depend on other options.
+type CompleterContext interface {
+	CompleteContext(ctx context.Context, cctx comp.Context) comp.Action
+}
+
+// WithCompleter registers a completion function under a name, which the options and positionals
+// of the command tree can use with their `complete` tag (eg. `complete:"regions"`), instead of
+// having their types implement Completer. The names of the builtin directives (eg. "files") have
+// priority. The option is given to Generate (or AddCommand) with the other parsing options, and
+// the completers of a tree are not available to others: a nil completer completes nothing.
+func WithCompleter(name string, completer comp.CompletionCallback) flags.OptFunc {
+	return func(opt *scan.Opts) {
+		if opt.Completers == nil {
+			opt.Completers = map[string]interface{}{}
+		}
+
+		opt.Completers[name] = completer
+	}
+}
+
+// optionsKey is the key of the command whose options are parsed, in the contexts of completers.
+type optionsKey struct{}
+
+// OptionValues returns the values of an option of the command being completed, as given on the
+// command line before the word being completed, or nil if it is not given (its default value is
+// not returned). This is meant for CompleterContext implementations completing some values
+// depending on other options (eg. --bucket depending on --profile), with the context they are
+// given. Options are looked up by their long or short name, and inherited options of parent
+// commands are found as well. Values of list options (repeatable ones, or pflag lists) are
+// returned as a list of their elements, and those of other options as a single element.
+func OptionValues(ctx context.Context, name string) []string {
+	cmd, isCmd := ctx.Value(optionsKey{}).(*cobra.Command)
+	if !isCmd {
+		return nil
+	}
+
+	flag := cmd.Flags().Lookup(name)
+	if flag == nil {
+		flag = cmd.InheritedFlags().Lookup(name)
+	}
+
+	if flag == nil && len(name) == 1 {
+		flag = cmd.Flags().ShorthandLookup(name)
+	}
+
+	if flag == nil || !flag.Changed {
+		return nil
+	}
+
+	// Repeatable values of this library are not pflag lists themselves.
+	if list, isList := genflags.ToPflagValue(flag.Value).(pflag.SliceValue); isList {
+		return list.GetSlice()
+	}
+
+	return []string{flag.Value.String()}
+}
+
+// CompleterTimeout is the deadline of the contexts given to CompleterContext implementations.
+var CompleterTimeout = 5 * time.Second
+
 // compDirective identifies one of reflags' builtin completer functions.
 type compDirective int
 
@@ -59,36 +148,116 @@ const (
 	completeTagMaxParts = 2
 )
 
-func getCompletionAction(name, value, desc string) comp.Action {
-	var action comp.Action
+// getCompletionAction returns the action of a completion directive, whose group of completions
+// (if any) is named with the catalog of the options, and which might be registered in them.
+func getCompletionAction(name, value string, opts scan.Opts) comp.Action {
+	var (
+		action  comp.Action
+		catalog = flags.Catalog(opts.Catalog)
+	)
 
 	switch strings.ToLower(name) {
 	case "nospace":
 		return action.NoSpace()
 	case "nofiles":
 	case "filterext":
-		filterExts := strings.Split(value, ",")
-		action = comp.ActionFiles(filterExts...).Tag("filtered extensions").NoSpace('/')
+		action = pathCompletions(false, value, "ext").Tag(catalog.Message(flags.MessageFilteredExtensions))
 	case "filterdirs":
-		action = comp.ActionDirectories().NoSpace('/').Tag("filtered directories") // TODO change this
+		action = pathCompletions(true, value, "root").Tag(catalog.Message(flags.MessageFilteredDirs))
 	case "files":
-		files := strings.Split(value, ",")
-		action = comp.ActionFiles(files...).NoSpace('/')
+		action = pathCompletions(false, value, "ext")
 	case "dirs":
-		action = comp.ActionDirectories().NoSpace('/')
+		action = pathCompletions(true, value, "root")
+	case "hosts", "users", "groups", "interfaces", "env", "processes":
+		action = systemCompletions(strings.ToLower(name), catalog)
+	case "multipart":
+		separator, parts, _ := strings.Cut(value, ",")
+		first, second, _ := strings.Cut(parts, ",")
+		action = MultiPart(separator, getCompletionAction(first, "", opts), getCompletionAction(second, "", opts))
 
 	// Should normally not be used often
 	case "default":
 		return action
+	default:
+		action = registeredCompletions(name, opts.Completers)
 	}
 
 	return action
 }
 
+// pathCompletions returns the completions of files (or directories only) with the options
+// of a directive, separated by commas: `ext=EXT;EXT` only completes files with these
+// extensions, and `root=DIR` completes paths relative to a directory instead of the
+// working one (several roots can be given). Options given without a key are the ones
+// of the fallback key (eg. `complete:"FilterExt,json,yaml"`).
+func pathCompletions(dirsOnly bool, value, fallback string) comp.Action {
+	var exts, roots []string
+
+	for _, option := range strings.Split(value, ",") {
+		key, values, found := strings.Cut(strings.TrimSpace(option), "=")
+		if !found {
+			key, values = fallback, key
+		}
+
+		for _, val := range strings.Split(values, ";") {
+			if val == "" {
+				continue
+			}
+
+			switch key {
+			case "ext":
+				exts = append(exts, val)
+			case "root":
+				roots = append(roots, val)
+			}
+		}
+	}
+
+	action := comp.ActionFiles(exts...)
+	if dirsOnly {
+		action = comp.ActionDirectories()
+	}
+
+	if len(roots) > 0 {
+		rooted := make([]comp.Action, 0, len(roots))
+		for _, root := range roots {
+			rooted = append(rooted, action.Chdir(root))
+		}
+
+		action = comp.Batch(rooted...).ToA()
+	}
+
+	return action.NoSpace('/')
+}
+
+// MultiPart returns the completions of values made of two parts joined by a separator, like
+// `user@host`, `key=value` or `https://host`: the first part is completed with an action and
+// followed by the separator, and the second part with another one, once the separator is typed.
+// Shells are given the whole values, with the part already typed as prefix.
+func MultiPart(separator string, first, second comp.Action) comp.Action {
+	return comp.ActionMultiParts(separator, func(ctx comp.Context) comp.Action {
+		if len(ctx.Parts) == 0 {
+			return first.Invoke(ctx).Suffix(separator).ToA()
+		}
+
+		return second
+	})
+}
+
+// registeredCompletions returns the completions of the completer registered under a name, if any.
+func registeredCompletions(name string, completers map[string]interface{}) comp.Action {
+	completer, _ := completers[name].(comp.CompletionCallback)
+	if completer == nil {
+		return comp.ActionValues()
+	}
+
+	return comp.ActionCallback(completer)
+}
+
 // typeCompleterAlt checksw for completer implementations on the type, checks
 // if the implementations are on the type of its elements (if slice/map), and
 // returns the results.
-func typeCompleter(val reflect.Value) (comp.CompletionCallback, bool, bool) {
+func typeCompleter(cmd *cobra.Command, val reflect.Value, catalog flags.Catalog) (comp.CompletionCallback, bool, bool) {
 	isRepeatable := false
 	itemsImplement := false
 
@@ -97,16 +266,14 @@ func typeCompleter(val reflect.Value) (comp.CompletionCallback, bool, bool) {
 	// Always check that the type itself does implement, even if
 	// it's a list of type X that implements the completer as well.
 	// If yes, we return this implementation, since it has priority.
-	if val.Type().Kind() == reflect.Slice {
+	isSlice := val.Type().Kind() == reflect.Slice
+
+	if isSlice {
 		isRepeatable = true
 
-		i := val.Interface()
-		if impl, ok := i.(Completer); ok {
-			completer = impl.Complete
-		} else if val.CanAddr() {
-			if impl, ok := val.Addr().Interface().(Completer); ok {
-				completer = impl.Complete
-			}
+		completer = implCompleter(cmd, val.Interface())
+		if completer == nil && val.CanAddr() {
+			completer = implCompleter(cmd, val.Addr().Interface())
 		}
 
 		// Else we reassign the value to the list type.
@@ -116,32 +283,120 @@ func typeCompleter(val reflect.Value) (comp.CompletionCallback, bool, bool) {
 	// If we did NOT find an implementation on the compound type,
 	// check for one on the items.
 	if completer == nil {
-		i := val.Interface()
-		if impl, ok := i.(Completer); ok && impl != nil {
+		if completer = implCompleter(cmd, val.Interface()); completer != nil {
 			itemsImplement = true
-			completer = impl.Complete
 		} else if val.CanAddr() {
 			isRepeatable = true
-			if impl, ok := val.Addr().Interface().(Completer); ok && impl != nil {
+			if completer = implCompleter(cmd, val.Addr().Interface()); completer != nil {
 				itemsImplement = true
-				completer = impl.Complete
 			}
 		}
 	}
 
+	// Some builtin value types have builtin completions.
+	if completer == nil {
+		completer = builtinCompleter(val.Type(), catalog)
+		itemsImplement = completer != nil && isSlice
+	}
+
 	return completer, isRepeatable, itemsImplement
 }
 
-// taggedCompletions builds a list of completion actions with struct tag specs.
-func taggedCompletions(tag tag.MultiTag) (comp.CompletionCallback, bool) {
-	compTag := tag.GetMany(completeTagName)
-	description, _ := tag.Get("description")
-	desc, _ := tag.Get("desc")
+// implCompleter returns the completion callback implemented by a value,
+// either as a Completer or a CompleterContext, or nil if none is found.
+func implCompleter(cmd *cobra.Command, i interface{}) comp.CompletionCallback {
+	switch impl := i.(type) {
+	case CompleterContext:
+		return contextCompleter(cmd, impl)
+	case Completer:
+		return impl.Complete
+	}
 
-	if description == "" {
-		description = desc
+	return nil
+}
+
+// contextCompleter wraps a CompleterContext into a completion callback, giving it a context
+// canceled after CompleterTimeout, or when the process is interrupted/terminated by the shell,
+// and holding the options of the command, parsed by the engine (see OptionValues).
+func contextCompleter(cmd *cobra.Command, impl CompleterContext) comp.CompletionCallback {
+	return func(cctx comp.Context) comp.Action {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(ctx, CompleterTimeout)
+		defer cancel()
+
+		if cmd != nil {
+			ctx = context.WithValue(ctx, optionsKey{}, cmd)
+		}
+
+		return impl.CompleteContext(ctx, cctx)
+	}
+}
+
+// builtinCompleter returns the completions of builtin value types with a known set of values.
+func builtinCompleter(typ reflect.Type, catalog flags.Catalog) comp.CompletionCallback {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch typ {
+	case reflect.TypeOf(time.Location{}):
+		return func(ctx comp.Context) comp.Action {
+			return timezoneCompletions(ctx).Tag(catalog.Message(flags.MessageTimeZones))
+		}
+	case reflect.TypeOf(flags.Charset("")):
+		return func(comp.Context) comp.Action {
+			return comp.ActionValues(flags.Charsets()...).Tag(catalog.Message(flags.MessageCharsets))
+		}
+	default:
+		return nil
+	}
+}
+
+// zoneinfoDirs are the usual locations of the IANA time zone database.
+var zoneinfoDirs = []string{"/usr/share/zoneinfo", "/usr/share/lib/zoneinfo", "/usr/lib/locale/TZ"}
+
+// timezoneCompletions completes the names of the time zones found in the system database.
+func timezoneCompletions(comp.Context) comp.Action {
+	zones := []string{"UTC", "Local"}
+
+	for _, dir := range zoneinfoDirs {
+		_ = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || path == dir {
+				return nil
+			}
+
+			// Zone names are capitalized, unlike other files
+			// and directories (eg. posix/, right/, zone.tab).
+			if !unicode.IsUpper([]rune(entry.Name())[0]) {
+				if entry.IsDir() {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+
+			if !entry.IsDir() {
+				name, _ := filepath.Rel(dir, path)
+				zones = append(zones, filepath.ToSlash(name))
+			}
+
+			return nil
+		})
+
+		if len(zones) > 2 {
+			break
+		}
 	}
 
+	return comp.ActionValues(zones...).MultiParts("/")
+}
+
+// taggedCompletions builds a list of completion actions with struct tag specs.
+func taggedCompletions(tag tag.MultiTag, opts scan.Opts) (comp.CompletionCallback, bool) {
+	compTag := tag.GetMany(completeTagName)
+
 	if len(compTag) == 0 {
 		return nil, false
 	}
@@ -155,12 +410,22 @@ func taggedCompletions(tag tag.MultiTag) (comp.CompletionCallback, bool) {
 	//     Remote string complete:"files"
 	//     Delete []string complete:"FilterExt,json,go,yaml"
 	//     Local []string complete:"FilterDirs,/home/user"
+	//     Profile string complete:"files,ext=yaml;yml,root=./profiles"
+	//     Owner string complete:"users"
+	//     Target string complete:"multipart,@,users,hosts"
 	// }
 	for _, tag := range compTag {
 		if tag == "" || strings.TrimSpace(tag) == "" {
 			continue
 		}
 
+		// Values remembered in the session might contain commas.
+		if key, isSession := isSessionTag(tag); isSession {
+			actions = append(actions, sessionCompletions(key))
+
+			continue
+		}
+
 		items := strings.SplitAfterN(tag, ",", completeTagMaxParts)
 
 		name, value := strings.TrimSuffix(items[0], ","), ""
@@ -170,7 +435,7 @@ func taggedCompletions(tag tag.MultiTag) (comp.CompletionCallback, bool) {
 		}
 
 		// build the completion action
-		tagAction := getCompletionAction(name, value, description)
+		tagAction := getCompletionAction(name, value, opts)
 		actions = append(actions, tagAction)
 	}
 
@@ -182,7 +447,9 @@ func taggedCompletions(tag tag.MultiTag) (comp.CompletionCallback, bool) {
 	return callback, true
 }
 
-func hintCompletions(tag tag.MultiTag) (comp.CompletionCallback, bool) {
+// hintCompletions returns a completion showing the description of a positional as usage,
+// translated with the message key of its description in the catalog of the options.
+func hintCompletions(tag tag.MultiTag, catalog flags.Catalog, key string) (comp.CompletionCallback, bool) {
 	description, _ := tag.Get("description")
 	desc, _ := tag.Get("desc")
 
@@ -194,15 +461,19 @@ func hintCompletions(tag tag.MultiTag) (comp.CompletionCallback, bool) {
 		return nil, false
 	}
 
+	description = flags.SanitizeLine(catalog.Text(key, description))
+
 	callback := func(comp.Context) comp.Action {
-		return comp.Action{}.Usage(desc)
+		return comp.Action{}.Usage(description)
 	}
 
 	return callback, true
 }
 
-// choiceCompletions builds completions from field tag choices.
-func choiceCompletions(tag tag.MultiTag, val reflect.Value) comp.CompletionCallback {
+// choiceCompletions builds completions from field tag choices, described with their
+// `choice-desc` tags (eg. `choice-desc:"json:machine-readable output"`), if any. The
+// choices of lists (and positionals) can be declared several in a tag, separated by spaces.
+func choiceCompletions(tag tag.MultiTag, isList bool, opts []flags.OptFunc) comp.CompletionCallback {
 	choices := tag.GetMany("choice")
 
 	if len(choices) == 0 {
@@ -211,9 +482,7 @@ func choiceCompletions(tag tag.MultiTag, val reflect.Value) comp.CompletionCallb
 
 	var allChoices []string
 
-	flagIsList := val.Kind() == reflect.Slice || val.Kind() == reflect.Map
-
-	if flagIsList {
+	if isList {
 		for _, choice := range choices {
 			allChoices = append(allChoices, strings.Split(choice, " ")...)
 		}
@@ -221,9 +490,79 @@ func choiceCompletions(tag tag.MultiTag, val reflect.Value) comp.CompletionCallb
 		allChoices = choices
 	}
 
+	for i, choice := range allChoices {
+		allChoices[i] = flags.SanitizeLine(choice)
+	}
+
+	descriptions := make(map[string]string)
+
+	for _, desc := range tag.GetMany("choice-desc") {
+		if choice, description, found := strings.Cut(desc, ":"); found {
+			descriptions[flags.SanitizeLine(choice)] = flags.SanitizeLine(strings.TrimSpace(description))
+		}
+	}
+
+	// Choices are completed with their description (if any).
+	described := func(values []string, choices []string) comp.Action {
+		if len(descriptions) == 0 {
+			return comp.ActionValues(values...)
+		}
+
+		pairs := make([]string, 0, len(values)*2)
+		for i, value := range values {
+			pairs = append(pairs, value, descriptions[choices[i]])
+		}
+
+		return comp.ActionValuesDescribed(pairs...)
+	}
+
+	insensitive := choiceInsensitive(tag, opts)
+	allChoices = validation.NormalizedChoices(allChoices, insensitive)
+
 	callback := func(ctx comp.Context) comp.Action {
-		return comp.ActionValues(allChoices...)
+		if !insensitive {
+			return described(allChoices, allChoices)
+		}
+
+		// Choices matching the current word regardless of case are completed
+		// with its spelling (shells only keep those starting with it), and
+		// normalized once parsed: the rest of the choice keeps its own.
+		matching := make([]string, 0, len(allChoices))
+		matched := make([]string, 0, len(allChoices))
+
+		for _, choice := range allChoices {
+			if len(choice) >= len(ctx.Value) && strings.EqualFold(choice[:len(ctx.Value)], ctx.Value) {
+				matching = append(matching, ctx.Value+choice[len(ctx.Value):])
+				matched = append(matched, choice)
+			}
+		}
+
+		return described(matching, matched)
 	}
 
 	return callback
 }
+
+// choiceInsensitive returns true if the choices of a field are matched regardless of case.
+func choiceInsensitive(tag tag.MultiTag, opts []flags.OptFunc) bool {
+	switch choiceCase, _ := tag.Get("choice-case"); choiceCase {
+	case "insensitive":
+		return true
+	case "sensitive":
+		return false
+	}
+
+	return flags.ScanOptions(opts...).ChoiceCaseInsensitive
+}
+
+// styled removes the styles of completions when colors are disabled, which
+// is decided when completing (eg. with NO_COLOR set in the user shell).
+func styled(action comp.Action, opts []flags.OptFunc) comp.Action {
+	return comp.ActionCallback(func(comp.Context) comp.Action {
+		if flags.ColorsEnabled(opts...) {
+			return action
+		}
+
+		return action.Style("")
+	})
+}
@@ -1,10 +1,25 @@
 package completions
 
 import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/reeflective/flags"
+	genflags "github.com/reeflective/flags/gen/flags"
+	"github.com/reeflective/flags/internal/positional"
+	"github.com/reeflective/flags/internal/scan"
+	"github.com/reeflective/flags/internal/tag"
 	"github.com/rsteube/carapace"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 )
 
 // TestCompletions just calls the carapace engine test routine
@@ -27,3 +42,672 @@ func TestCompletions(t *testing.T) {
 
 	carapace.Test(t)
 }
+
+// TestCompletionsOptions checks that the completions generator
+// names flags with the same options as the command generator.
+func TestCompletionsOptions(t *testing.T) {
+	t.Parallel()
+
+	optsCmd := struct {
+		Opts struct {
+			Config string `long:"config" complete:"Files"`
+		} `group:"options" namespace:"app" namespace-delimiter:"."`
+	}{}
+
+	opts := []flags.OptFunc{flags.Prefix("global.")}
+
+	rootCmd := genflags.Generate(&optsCmd, opts...)
+	_, err := Generate(rootCmd, &optsCmd, nil, opts...)
+
+	test := assert.New(t)
+	test.Nil(err, "Completions should have been generated")
+
+	out := &bytes.Buffer{}
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"_carapace", "export", "", "--global.app."})
+	test.Nil(rootCmd.Execute())
+	test.Contains(out.String(), `"value":"--global.app.config"`, "Options should be named with the same options")
+
+	out.Reset()
+	rootCmd.SetArgs([]string{"_carapace", "export", "", "--global.app.config", "completion_t"})
+	test.Nil(rootCmd.Execute())
+	test.Contains(out.String(), `"value":"completion_test.go"`, "Options should be completed with their completers")
+
+	// Without options, completions use those of the generated tree.
+	rootCmd = genflags.Generate(&optsCmd, opts...)
+	_, err = Generate(rootCmd, &optsCmd, nil)
+	test.Nil(err)
+
+	out.Reset()
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"_carapace", "export", "", "--global.app.config", "completion_t"})
+	test.Nil(rootCmd.Execute())
+	test.Contains(out.String(), `"value":"completion_test.go"`, "Options of the tree should be used by default")
+}
+
+// TestRegisteredCompleters checks that options and positionals are
+// completed by the completers registered under the name in their tag.
+func TestRegisteredCompleters(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Region string `long:"region" complete:"regions"`
+
+		Args struct {
+			Zone string `complete:"regions"`
+		} `positional-args:"yes"`
+	}{}
+
+	regions := WithCompleter("regions", func(carapace.Context) carapace.Action {
+		return carapace.ActionValues("eu-west-1", "us-east-1")
+	})
+
+	rootCmd := genflags.Generate(&data)
+	_, err := Generate(rootCmd, &data, nil, regions)
+
+	test := assert.New(t)
+	test.Nil(err, "Completions should have been generated")
+
+	for _, args := range [][]string{{"--region", ""}, {""}} {
+		out := &bytes.Buffer{}
+		rootCmd.SetOut(out)
+		rootCmd.SetArgs(append([]string{"_carapace", "export", ""}, args...))
+		test.Nil(rootCmd.Execute())
+		test.Contains(out.String(), `"value":"us-east-1"`, "Registered completers should be used")
+	}
+
+	// Completers are only registered for the tree generated with them.
+	otherCmd := genflags.Generate(&data)
+	_, err = Generate(otherCmd, &data, nil)
+	test.Nil(err, "Completions should have been generated")
+
+	out := &bytes.Buffer{}
+	otherCmd.SetOut(out)
+	otherCmd.SetArgs([]string{"_carapace", "export", "", "--region", ""})
+	test.Nil(otherCmd.Execute())
+	test.NotContains(out.String(), "us-east-1", "Completers of other trees should not be used")
+}
+
+// TestMultiPart checks that multipart values are completed part by part.
+func TestMultiPart(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Labels []string `long:"label" complete:"multipart,=,label-keys,label-values"`
+	}{}
+
+	keys := WithCompleter("label-keys", func(carapace.Context) carapace.Action {
+		return carapace.ActionValues("env", "team")
+	})
+	values := WithCompleter("label-values", func(carapace.Context) carapace.Action {
+		return carapace.ActionValues("prod", "dev")
+	})
+
+	rootCmd := genflags.Generate(&data)
+	_, err := Generate(rootCmd, &data, nil, keys, values)
+
+	test := assert.New(t)
+	test.Nil(err, "Completions should have been generated")
+
+	for value, expected := range map[string]string{"": `"value":"env="`, "env=": `"value":"env=prod"`, "team=d": `"value":"team=dev"`} {
+		out := &bytes.Buffer{}
+		rootCmd.SetOut(out)
+		rootCmd.SetArgs([]string{"_carapace", "export", "", "--label", value})
+		test.Nil(rootCmd.Execute())
+		test.Contains(out.String(), expected, "Parts should be completed after their separator")
+	}
+}
+
+// TestCompletionCache checks that the completions of options are cached
+// for the duration of their tag, in the user cache directory.
+func TestCompletionCache(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	var calls int32
+
+	regions := WithCompleter("cached-regions", func(carapace.Context) carapace.Action {
+		atomic.AddInt32(&calls, 1)
+
+		return carapace.ActionValues("eu-west-1", "us-east-1")
+	})
+
+	data := struct {
+		Region string `long:"region" complete:"cached-regions" complete-cache:"1m"`
+		Zone   string `long:"zone"`
+	}{}
+
+	rootCmd := genflags.Generate(&data)
+	_, err := Generate(rootCmd, &data, nil, regions)
+
+	test := assert.New(t)
+	test.Nil(err, "Completions should have been generated")
+
+	for i := 0; i < 2; i++ {
+		out := &bytes.Buffer{}
+		rootCmd.SetOut(out)
+		rootCmd.SetArgs([]string{"_carapace", "export", "", "--region", ""})
+		test.Nil(rootCmd.Execute())
+		test.Contains(out.String(), `"value":"us-east-1"`)
+	}
+
+	test.Equal(int32(1), atomic.LoadInt32(&calls), "Completions should have been cached")
+
+	// Completions are cached by the options and arguments of the command-line.
+	out := &bytes.Buffer{}
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"_carapace", "export", "", "--zone", "b", "--region", ""})
+	test.Nil(rootCmd.Execute())
+	test.Equal(int32(2), atomic.LoadInt32(&calls), "Completions should depend on other options")
+
+	invalid := struct {
+		Region string `long:"region" complete:"cached-regions" complete-cache:"soon"`
+	}{}
+
+	_, err = Generate(genflags.Generate(&invalid), &invalid, nil, regions)
+	test.ErrorContains(err, "invalid completion cache duration")
+}
+
+// TestChoiceCompletions checks that the choices of options and positionals
+// are completed, with their descriptions, and validated with helpful errors.
+func TestChoiceCompletions(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Format string `long:"format" choice:"json" choice:"yaml" choice-desc:"json:machine-readable output"`
+
+		Args struct {
+			Proto string `choice:"ssh https" choice-desc:"ssh:secure shell"`
+		} `positional-args:"yes"`
+	}{}
+
+	rootCmd := genflags.Generate(&data)
+	_, err := Generate(rootCmd, &data, nil)
+
+	test := assert.New(t)
+	test.Nil(err, "Completions should have been generated")
+
+	for expected, args := range map[string][]string{
+		`{"value":"json","display":"json","description":"machine-readable output"}`: {"--format", ""},
+		`{"value":"ssh","display":"ssh","description":"secure shell"}`:              {""},
+		`{"value":"https","display":"https"}`:                                       {""},
+	} {
+		out := &bytes.Buffer{}
+		rootCmd.SetOut(out)
+		rootCmd.SetArgs(append([]string{"_carapace", "export", ""}, args...))
+		test.Nil(rootCmd.Execute())
+		test.Contains(out.String(), expected, "Choices should be completed with their descriptions")
+	}
+
+	_, err = genflags.ParseArgs(&data, []string{"--format", "xml"})
+	test.ErrorContains(err, `invalid choice: "xml" (valid choices: json, yaml)`)
+}
+
+// TestChoiceCompletionsNormalized checks that choices matched regardless of case are
+// completed once, with the spelling to which values are normalized once parsed.
+func TestChoiceCompletionsNormalized(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Level string `long:"level" choice:"Debug" choice:"debug" choice:"Info" choice-case:"insensitive"`
+	}{}
+
+	rootCmd := genflags.Generate(&data)
+	_, err := Generate(rootCmd, &data, nil)
+
+	test := assert.New(t)
+	test.Nil(err, "Completions should have been generated")
+
+	complete := func(word string) string {
+		out := &bytes.Buffer{}
+		rootCmd.SetOut(out)
+		rootCmd.SetArgs([]string{"_carapace", "export", "", "--level", word})
+		test.Nil(rootCmd.Execute())
+
+		return out.String()
+	}
+
+	values := complete("")
+	test.Contains(values, `"value":"Debug"`, "Choices should be completed with their normalized spelling")
+	test.Contains(values, `"value":"Info"`)
+	test.NotContains(values, `"value":"debug"`, "Choices differing by case should be completed once")
+
+	test.Contains(complete("D"), `"value":"Debug"`)
+	test.Contains(complete("i"), `"value":"info"`, "Choices should be completed from the spelling of the word")
+}
+
+// TestPathCompletions checks that file and directory completions
+// are restricted to their extensions and root directories.
+func TestPathCompletions(t *testing.T) {
+	t.Parallel()
+
+	root := filepath.Join(t.TempDir(), "profiles")
+	test := assert.New(t)
+
+	test.NoError(os.MkdirAll(filepath.Join(root, "nested"), 0o755))
+
+	for _, name := range []string{"dev.yaml", "prod.yml", "notes.txt"} {
+		test.NoError(os.WriteFile(filepath.Join(root, name), nil, 0o600))
+	}
+
+	complete := func(action carapace.Action) string {
+		rootCmd := &cobra.Command{Use: "app", Run: func(*cobra.Command, []string) {}}
+		carapace.Gen(rootCmd).PositionalCompletion(action)
+
+		out := &bytes.Buffer{}
+		rootCmd.SetOut(out)
+		rootCmd.SetArgs([]string{"_carapace", "export", "", ""})
+		test.Nil(rootCmd.Execute())
+
+		return out.String()
+	}
+
+	files := complete(getCompletionAction("files", "ext=yaml;yml,root="+root, scan.Opts{}))
+	test.Contains(files, `"value":"dev.yaml"`)
+	test.Contains(files, `"value":"prod.yml"`)
+	test.Contains(files, `"value":"nested/"`)
+	test.NotContains(files, "notes.txt", "Files should be filtered by extension")
+
+	dirs := complete(getCompletionAction("dirs", "root="+root, scan.Opts{}))
+	test.Contains(dirs, `"value":"nested/"`)
+	test.NotContains(dirs, "dev.yaml", "Only directories should be completed")
+}
+
+// bucket is a value completed depending on the value of another option.
+type bucket string
+
+func (b *bucket) Set(value string) error { *b = bucket(value); return nil }
+func (b *bucket) String() string         { return string(*b) }
+func (b *bucket) Type() string           { return "bucket" }
+
+func (b *bucket) CompleteContext(ctx context.Context, _ carapace.Context) carapace.Action {
+	if profiles := OptionValues(ctx, "profile"); len(profiles) > 0 {
+		return carapace.ActionValues(profiles[0] + "-bucket")
+	}
+
+	return carapace.ActionValues("default-bucket")
+}
+
+// TestOptionValues checks that completers are given the
+// values of the options already given on the command line.
+func TestOptionValues(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+
+	for expected, args := range map[string][]string{
+		"prod-bucket":    {"--profile", "prod", "--bucket", ""},
+		"dev-bucket":     {"-p", "dev", "--bucket", ""},
+		"default-bucket": {"--bucket", ""},
+	} {
+		data := struct {
+			Profile string `long:"profile" short:"p"`
+			Bucket  bucket `long:"bucket"`
+		}{}
+
+		// Flags are parsed once per completion process.
+		rootCmd := genflags.Generate(&data)
+		_, err := Generate(rootCmd, &data, nil)
+		test.Nil(err, "Completions should have been generated")
+
+		out := &bytes.Buffer{}
+		rootCmd.SetOut(out)
+		rootCmd.SetArgs(append([]string{"_carapace", "export", ""}, args...))
+		test.Nil(rootCmd.Execute())
+		test.Contains(out.String(), `"value":"`+expected+`"`, "Completers should see the parsed options")
+	}
+}
+
+// label is a value completed with the values of a repeatable option.
+type label string
+
+func (l *label) Set(value string) error { *l = label(value); return nil }
+func (l *label) String() string         { return string(*l) }
+func (l *label) Type() string           { return "label" }
+
+func (l *label) CompleteContext(ctx context.Context, _ carapace.Context) carapace.Action {
+	return carapace.ActionValues(strings.Join(OptionValues(ctx, "labels"), "+"))
+}
+
+// TestOptionValuesRepeatable checks that completers are given the elements of
+// repeatable options given several times, rather than their text form.
+func TestOptionValuesRepeatable(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Labels []string `long:"labels"`
+		Label  label    `long:"label"`
+	}{}
+
+	rootCmd := genflags.Generate(&data)
+	_, err := Generate(rootCmd, &data, nil)
+	assert.Nil(t, err, "Completions should have been generated")
+
+	out := &bytes.Buffer{}
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"_carapace", "export", "", "--labels", "a", "--labels", "b,c", "--label", ""})
+	assert.Nil(t, rootCmd.Execute())
+	assert.Contains(t, out.String(), `"value":"a+b+c"`, "Completers should see the elements of lists")
+}
+
+// TestCompletionsParentCommand checks that the subcommands of commands
+// not implementing Commander (pure parents) are completed as well.
+func TestCompletionsParentCommand(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Remote struct {
+			Add struct {
+				Mode string `long:"mode" choice:"fetch" choice:"push"`
+			} `command:"add"`
+		} `command:"remote"`
+	}{}
+
+	rootCmd := genflags.Generate(&data)
+	_, err := Generate(rootCmd, &data, nil)
+
+	test := assert.New(t)
+	test.Nil(err, "Completions should have been generated")
+
+	out := &bytes.Buffer{}
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"_carapace", "export", "", "remote", "add", "--mode", ""})
+	test.Nil(rootCmd.Execute())
+	test.Contains(out.String(), `"value":"push"`, "Options of subcommands should be completed")
+}
+
+// TestPositionalCompletionsConcurrent checks that positional completions run
+// concurrently each stage the completers of their own command-line words.
+func TestPositionalCompletionsConcurrent(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Args struct {
+			Host  string   `description:"target host"`
+			Files []string `description:"files to copy"`
+		} `positional-args:"yes" required:"yes"`
+	}{}
+
+	field, _ := reflect.TypeOf(data).FieldByName("Args")
+	mtag, _, _ := tag.GetFieldTag(field)
+
+	args, err := positional.ScanArgs(reflect.ValueOf(&data).Elem().Field(0), mtag)
+	assert.NoError(t, err)
+
+	cache, err := getCompleters(&cobra.Command{}, args, nil)
+	assert.NoError(t, err)
+	words := [][]string{{}, {"host"}, {"host", "file"}}
+	stages := make([]*compStage, 30)
+
+	var workers sync.WaitGroup
+
+	for i := range stages {
+		stages[i] = newCompletionStage()
+		workers.Add(1)
+
+		go func(stage *compStage, words []string) {
+			defer workers.Done()
+
+			args.ParseConcurrentWith(words, consumeWith(stage))
+			cache.flush(carapace.Context{Args: words}, stage)
+		}(stages[i], words[i%len(words)])
+	}
+
+	workers.Wait()
+
+	for i, stage := range stages {
+		if len(words[i%len(words)]) == 0 {
+			assert.True(t, stage.used[0], "The first positional should be completed")
+		} else {
+			assert.Equal(t, map[int]bool{1: true}, stage.used, "Only the list should be completed")
+		}
+	}
+}
+
+// remoteHost is a type completed from a (slow) remote source.
+type remoteHost string
+
+func (h *remoteHost) CompleteContext(ctx context.Context, _ carapace.Context) carapace.Action {
+	if _, hasDeadline := ctx.Deadline(); hasDeadline {
+		*h = "deadline"
+	}
+
+	return carapace.ActionValues("host1", "host2")
+}
+
+// TestCompleterContext checks that types implementing CompleterContext
+// are used as completers, and are given a context with a deadline.
+func TestCompleterContext(t *testing.T) {
+	t.Parallel()
+
+	var host remoteHost
+
+	completer, _, _ := typeCompleter(nil, reflect.ValueOf(&host).Elem(), nil)
+
+	test := assert.New(t)
+	test.NotNil(completer, "A completer should have been found on the type")
+
+	completer(carapace.Context{})
+	test.Equal(remoteHost("deadline"), host, "The completer context should have a deadline")
+}
+
+// TestBuiltinCompleters checks that builtin value types with
+// a known set of values are completed without implementations.
+func TestBuiltinCompleters(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Zone     *time.Location  `long:"zone"`
+		Charsets []flags.Charset `long:"charsets"`
+	}{}
+
+	test := assert.New(t)
+
+	completer, _, itemsImplement := typeCompleter(nil, reflect.ValueOf(&data).Elem().Field(0), nil)
+	test.NotNil(completer, "Time zones should be completed")
+	test.False(itemsImplement, "A single time zone should not be completed as a list")
+
+	completer, isRepeatable, itemsImplement := typeCompleter(nil, reflect.ValueOf(&data).Elem().Field(1), nil)
+	test.NotNil(completer, "Charsets should be completed")
+	test.True(isRepeatable && itemsImplement, "A list of charsets should be completed as a list")
+}
+
+// TestSessionCompletions checks that values remembered in the session
+// are only stored when enabled, and are completed by session tags.
+func TestSessionCompletions(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+
+	Remember("targets", []string{"host1"})
+	test.Nil(Recall("targets"), "Values should not be remembered with the session disabled")
+
+	EnableSession()
+	defer DisableSession()
+
+	Remember("targets", []string{"host1", "host2"})
+	Remember("targets", []string{"host1"})
+	test.Equal([]string{"host2", "host1"}, Recall("targets"), "Values should be deduplicated, latest last")
+
+	data := struct {
+		Target string `long:"target" complete:"session:targets"`
+	}{}
+
+	mtag, _, _ := tag.GetFieldTag(reflect.TypeOf(data).Field(0))
+	completer, found := taggedCompletions(mtag, scan.Opts{})
+	test.True(found, "Session tags should be completed")
+	test.NotNil(completer)
+
+	Forget("targets")
+	test.Nil(Recall("targets"), "Forgotten values should not be recalled")
+}
+
+// TestExec checks that processes run by completers have a scrubbed
+// environment, and are killed when their output is too large.
+func TestExec(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+	cctx := carapace.Context{Env: []string{"PATH=/usr/bin:/bin", "LC_ALL=C", "API_TOKEN=secret"}}
+
+	output, err := Exec(context.Background(), cctx, "sh", "-c", "env")
+	test.Nil(err)
+	test.Contains(string(output), "LC_ALL=C")
+	test.NotContains(string(output), "API_TOKEN", "Unlisted variables should be removed")
+
+	_, err = Exec(context.Background(), cctx, "sh", "-c", "echo failed >&2; exit 1")
+	test.ErrorContains(err, "failed")
+
+	_, err = Exec(context.Background(), cctx, "sh", "-c", "cat /dev/zero")
+	test.ErrorIs(err, ErrExecOutput)
+
+	WaitExec()
+}
+
+// TestCompletionInstall checks that the hidden install command writes
+// completion scripts in the directories of the user, for each shell.
+func TestCompletionInstall(t *testing.T) {
+	t.Parallel()
+
+	home := t.TempDir()
+	opts := []flags.OptFunc{
+		flags.WithCompletionInstall(),
+		flags.WithEnviron([]string{"HOME=" + home, "XDG_CONFIG_HOME=" + filepath.Join(home, "config")}),
+	}
+
+	data := &struct {
+		Run struct{} `command:"run"`
+	}{}
+
+	root := genflags.Generate(data, opts...)
+	root.Use = "app"
+
+	_, err := Generate(root, data, nil, opts...)
+
+	test := assert.New(t)
+	test.NoError(err)
+
+	install, _, err := root.Find([]string{"completion", InstallName})
+	test.NoError(err)
+	test.Equal(InstallName, install.Name())
+	test.True(install.Hidden)
+
+	scripts := map[string]string{
+		"bash": filepath.Join(home, ".local", "share", "bash-completion", "completions", "app"),
+		"zsh":  filepath.Join(home, ".zsh", "completions", "_app"),
+		"fish": filepath.Join(home, "config", "fish", "completions", "app.fish"),
+	}
+
+	for shell, path := range scripts {
+		out := &bytes.Buffer{}
+		install.SetOut(out)
+		test.NoError(install.RunE(install, []string{shell}))
+		test.Contains(out.String(), "Installed "+shell+" completions in "+path)
+
+		script, err := os.ReadFile(path)
+		test.NoError(err)
+		test.Contains(string(script), "_carapace "+shell, "The %s script should have been written", shell)
+	}
+
+	test.ErrorIs(install.RunE(install, []string{"tcsh"}), ErrUnsupportedShell)
+}
+
+// TestSystemCompletions checks that the completions of system entities
+// are read from the system files, and described with their details.
+func TestSystemCompletions(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[*string]string{
+		&hostsFile:  "127.0.0.1 localhost local # loopback\n# comment\n\n10.0.0.2 db\n",
+		&passwdFile: "root:x:0:0:root:/root:/bin/bash\nalice:x:1000:1000:Alice Liddell,,,:/home/alice:/bin/zsh\n",
+		&groupFile:  "wheel:x:10:alice\n",
+	}
+
+	for path, content := range files {
+		path, original := path, *path
+		t.Cleanup(func() { *path = original })
+
+		*path = filepath.Join(dir, filepath.Base(original))
+		assert.NoError(t, os.WriteFile(*path, []byte(content), 0o600))
+	}
+
+	test := assert.New(t)
+	test.Equal([]string{"localhost", "127.0.0.1", "local", "127.0.0.1", "db", "10.0.0.2"}, hostCompletions()[:6])
+	test.Equal([]string{"root", "root", "alice", "Alice Liddell"}, userCompletions())
+	test.Equal([]string{"wheel", "gid 10"}, groupCompletions())
+
+	env := envCompletions()
+	for i := 0; i+1 < len(env); i += 2 {
+		test.Empty(env[i+1], "the value of %s should not be shown", env[i])
+	}
+
+	test.Contains(env, "PATH")
+}
+
+// TestAddCommandCompletions checks that the completions of commands
+// added to a tree once generated are generated with AddCommand.
+func TestAddCommandCompletions(t *testing.T) {
+	t.Parallel()
+
+	data := struct{}{}
+	rootCmd := genflags.Generate(&data)
+
+	_, err := Generate(rootCmd, &data, nil)
+
+	test := assert.New(t)
+	test.Nil(err, "Completions should have been generated")
+
+	module := struct {
+		Mode string `long:"mode" choice:"fetch" choice:"push"`
+	}{}
+
+	subc, err := genflags.AddCommand(rootCmd, `command:"module"`, &module)
+	test.Nil(err, "The command should have been added")
+	test.Nil(AddCommand(subc, &module), "Completions of the command should have been generated")
+
+	out := &bytes.Buffer{}
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"_carapace", "export", "", "module", "--mode", ""})
+	test.Nil(rootCmd.Execute())
+	test.Contains(out.String(), `"value":"push"`, "Options of added commands should be completed")
+}
+
+// TestCompleteNames checks that the long names of short-only options are not completed,
+// and that the negative flags of negatable options are, unless the option is set.
+func TestCompleteNames(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Verbose bool `short:"v" description:"verbose"`
+		Cache   bool `long:"cache" negatable:"" description:"use the cache"`
+	}{}
+
+	rootCmd := genflags.Generate(&data)
+	_, err := Generate(rootCmd, &data, nil)
+
+	test := assert.New(t)
+	test.Nil(err, "Completions should have been generated")
+
+	complete := func(args ...string) string {
+		out := &bytes.Buffer{}
+		rootCmd.SetOut(out)
+		rootCmd.SetArgs(append([]string{"_carapace", "export", ""}, args...))
+		test.Nil(rootCmd.Execute())
+
+		return out.String()
+	}
+
+	names := complete("-")
+	test.Contains(names, `"value":"-v"`)
+	test.Contains(names, `"value":"--cache"`)
+	test.Contains(names, `"value":"--no-cache"`, "Negative flags should be completed")
+
+	names = complete("--cache", "--")
+	test.NotContains(names, `"value":"--no-cache"`, "Negative flags of set options should not be completed")
+
+	names = complete("--no-cache", "--")
+	test.NotContains(names, `"value":"--cache"`, "Options with their negative flag set should not be completed")
+
+	rootCmd.SetArgs([]string{"--cache", "--no-cache"})
+	test.Nil(rootCmd.Execute(), "Options should only be exclusive with their negative flag while completing")
+	test.NotContains(rootCmd.Flags().Lookup("cache").Annotations, exclusiveAnnotation)
+}
@@ -0,0 +1,171 @@
+package completions
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/reeflective/flags"
+	comp "github.com/rsteube/carapace"
+)
+
+// ExecMaxOutput is the maximum number of bytes read from the output of the processes run by
+// completers with Exec or ActionExec: processes writing more are killed, and return ErrExecOutput.
+var ExecMaxOutput = 1 << 20
+
+// ExecEnv are the names of the environment variables passed to the processes run by completers
+// with Exec or ActionExec: others (eg. secrets exported in the shell) are removed from their
+// environment. Variables ending with a `*` pass all variables with this prefix.
+var ExecEnv = []string{"PATH", "HOME", "USER", "LOGNAME", "SHELL", "TERM", "TMPDIR", "LANG", "LC_*", "XDG_*"}
+
+// ErrExecOutput indicates that a process run by a completer has written more than ExecMaxOutput.
+var ErrExecOutput = errors.New("process output too large")
+
+// processes are the processes run by completers and not exited yet.
+var processes sync.WaitGroup
+
+// Exec runs a process for a completer, and returns its output. The process (and its children)
+// is killed when the context is done, when CompleterTimeout is exceeded or when its output
+// exceeds ExecMaxOutput. It runs in its own process group (in the background of the terminal),
+// in the directory of the completion context, with its environment limited to ExecEnv. On
+// failure, the error includes the first line written by the process on its standard error
+// (if any).
+func Exec(ctx context.Context, cctx comp.Context, name string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, CompleterTimeout)
+	defer cancel()
+
+	stdout := &limitedBuffer{max: ExecMaxOutput, cancel: cancel}
+	stderr := &limitedBuffer{max: ExecMaxOutput}
+
+	cmd := exec.Command(name, args...)
+	cmd.Env = scrubEnv(cctx.Env)
+	cmd.Dir = cctx.Dir
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+
+	// The process and its children are killed together, and never
+	// take the terminal from the shell (or console) completing.
+	setProcessGroup(cmd)
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	processes.Add(1)
+	defer processes.Done()
+
+	exited := make(chan struct{})
+	defer close(exited)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			// The context is also canceled once the process has exited.
+			select {
+			case <-exited:
+			default:
+				_ = killProcessGroup(cmd)
+			}
+		case <-exited:
+		}
+	}()
+
+	err := cmd.Wait()
+
+	switch {
+	case stdout.exceeded:
+		return stdout.buf.Bytes(), fmt.Errorf("%w: %s wrote more than %d bytes", ErrExecOutput, name, ExecMaxOutput)
+	case err != nil && ctx.Err() != nil:
+		return stdout.buf.Bytes(), fmt.Errorf("%s: %w", name, ctx.Err())
+	case err != nil:
+		if line, _, _ := strings.Cut(stderr.buf.String(), "\n"); strings.TrimSpace(line) != "" {
+			return stdout.buf.Bytes(), fmt.Errorf("%s: %w: %s", name, err, strings.TrimSpace(line))
+		}
+
+		return stdout.buf.Bytes(), fmt.Errorf("%s: %w", name, err)
+	}
+
+	return stdout.buf.Bytes(), nil
+}
+
+// ActionExec returns an action running a process with Exec, and completing with the
+// action built from its output. Like with CompleterContext implementations, the process
+// is also killed when the completion is interrupted by the shell. Errors are shown as
+// a completion message.
+func ActionExec(name string, args ...string) func(f func(output []byte) comp.Action) comp.Action {
+	return func(f func(output []byte) comp.Action) comp.Action {
+		return comp.ActionCallback(func(cctx comp.Context) comp.Action {
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			output, err := Exec(ctx, cctx, name, args...)
+			if err != nil {
+				return comp.ActionMessage(flags.SanitizeLine(err.Error()))
+			}
+
+			return f(output)
+		})
+	}
+}
+
+// WaitExec waits for the processes run by completers (eg. in goroutines) to exit. Applications
+// doing some work after completing (eg. consoles) can call it to not leave any behind.
+func WaitExec() {
+	processes.Wait()
+}
+
+// scrubEnv returns the variables of an environment passed to processes run by completers.
+func scrubEnv(environ []string) []string {
+	if environ == nil {
+		environ = os.Environ()
+	}
+
+	scrubbed := make([]string, 0, len(ExecEnv))
+
+	for _, entry := range environ {
+		key, _, _ := strings.Cut(entry, "=")
+
+		for _, allowed := range ExecEnv {
+			if key == allowed || (strings.HasSuffix(allowed, "*") && strings.HasPrefix(key, strings.TrimSuffix(allowed, "*"))) {
+				scrubbed = append(scrubbed, entry)
+
+				break
+			}
+		}
+	}
+
+	return scrubbed
+}
+
+// limitedBuffer is a buffer for the output of a process, discarding what exceeds its maximum
+// size: when it does, the process is canceled if the buffer has a cancel function.
+type limitedBuffer struct {
+	buf      bytes.Buffer // Not embedded, so that its ReadFrom is not used by io.Copy
+	max      int
+	exceeded bool
+	cancel   context.CancelFunc
+}
+
+func (b *limitedBuffer) Write(data []byte) (int, error) {
+	if room := b.max - b.buf.Len(); len(data) > room {
+		if room > 0 {
+			b.buf.Write(data[:room])
+		}
+
+		b.exceeded = true
+
+		if b.cancel != nil {
+			b.cancel()
+		}
+
+		return len(data), nil
+	}
+
+	return b.buf.Write(data)
+}
@@ -0,0 +1,19 @@
+//go:build !windows
+
+package completions
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup makes a process the leader of a new process group, in the
+// background of the terminal: it is stopped if it tries to read from it.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessGroup kills a process started with setProcessGroup, and its children.
+func killProcessGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
@@ -0,0 +1,14 @@
+//go:build windows
+
+package completions
+
+import "os/exec"
+
+// setProcessGroup does nothing on Windows, where processes
+// do not share the console input of the completing shell.
+func setProcessGroup(*exec.Cmd) {}
+
+// killProcessGroup kills a process (but not its children, on Windows).
+func killProcessGroup(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
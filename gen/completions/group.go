@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/reeflective/flags"
 	genflags "github.com/reeflective/flags/gen/flags"
@@ -21,12 +22,22 @@ var errShortNameTooLong = errors.New("short names can only be 1 character long")
 type flagSetComps map[string]comp.Action
 
 // flagsGroup finds if a field is marked as a subgroup of options, and if yes, scans it recursively.
-func groupComps(comps *comp.Carapace, cmd *cobra.Command, val reflect.Value, fld *reflect.StructField) (bool, error) {
-	mtag, none, err := tag.GetFieldTag(*fld)
+func groupComps(comps *comp.Carapace, cmd *cobra.Command, val reflect.Value, fld *reflect.StructField, opts []flags.OptFunc) (bool, error) {
+	mtag, none, err := flags.ScanOptions(opts...).FieldTag(*fld)
 	if none || err != nil {
 		return true, fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
 	}
 
+	// Options provided at runtime by an interface value.
+	if flags.IsProvided(mtag) {
+		data, err := flags.ProvidedGroup(mtag, val)
+		if err != nil || data == nil {
+			return true, err
+		}
+
+		return true, addFlagComps(comps, cmd, mtag, data, opts)
+	}
+
 	// If not tagged as group, skip it.
 	if _, isGroup := mtag.Get("group"); !isGroup {
 		return false, nil
@@ -52,7 +63,7 @@ func groupComps(comps *comp.Carapace, cmd *cobra.Command, val reflect.Value, fld
 
 	// Parse the options for completions
 	if isSet && optionsGroup != "" {
-		err := addFlagComps(comps, mtag, ptrval.Interface())
+		err := addFlagComps(comps, cmd, mtag, ptrval.Interface(), opts)
 
 		return true, err
 	}
@@ -66,7 +77,7 @@ func groupComps(comps *comp.Carapace, cmd *cobra.Command, val reflect.Value, fld
 	if isSet {
 		defaultFlagComps := flagSetComps{}
 
-		scannerCommand := completionScanner(cmd, comps, &defaultFlagComps)
+		scannerCommand := completionScanner(cmd, comps, &defaultFlagComps, opts)
 		err := scan.Type(ptrval.Interface(), scannerCommand)
 
 		return true, fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
@@ -77,27 +88,15 @@ func groupComps(comps *comp.Carapace, cmd *cobra.Command, val reflect.Value, fld
 
 // addFlagComps scans a struct (potentially nested), for a set of flags, and without
 // binding them to the command, parses them for any completions specified/implemented.
-func addFlagComps(comps *comp.Carapace, mtag tag.MultiTag, data interface{}) error {
-	var flagOpts []flags.OptFunc
-
-	// New change, in order to easily propagate parent namespaces
-	// in heavily/specially nested option groups at bind time.
-	delim, _ := mtag.Get("namespace-delimiter")
-
-	namespace, _ := mtag.Get("namespace")
-	if namespace != "" {
-		flagOpts = append(flagOpts, flags.Prefix(namespace+delim))
-	}
-
-	envNamespace, _ := mtag.Get("env-namespace")
-	if envNamespace != "" {
-		flagOpts = append(flagOpts, flags.EnvPrefix(envNamespace))
-	}
+func addFlagComps(comps *comp.Carapace, cmd *cobra.Command, mtag tag.MultiTag, data interface{}, opts []flags.OptFunc) error {
+	// Namespaces (flags and env) are composed with the parent ones,
+	// so that they propagate in heavily/specially nested option groups.
+	flagOpts := flags.GroupOptions(mtag, opts...)
 
 	// All completions for this flag set only.
 	// The handler will append to the completions map as each flag is parsed
 	flagCompletions := flagSetComps{}
-	compScanner := flagCompsScanner(&flagCompletions)
+	compScanner := flagCompsScanner(cmd, &flagCompletions, opts)
 	flagOpts = append(flagOpts, flags.FlagHandler(compScanner))
 
 	// Parse the group into a flag set, but don't keep them,
@@ -118,12 +117,15 @@ func addFlagComps(comps *comp.Carapace, mtag tag.MultiTag, data interface{}) err
 
 // flagScan builds a small struct field handler so that we can scan
 // it as an option and add it to our current command flags.
-func flagComps(comps *comp.Carapace, flagComps *flagSetComps) scan.Handler {
+func flagComps(comps *comp.Carapace, cmd *cobra.Command, flagComps *flagSetComps, opts []flags.OptFunc) scan.Handler {
 	flagScanner := func(val reflect.Value, sfield *reflect.StructField) (bool, error) {
-		compScanner := flagCompsScanner(flagComps)
+		compScanner := flagCompsScanner(cmd, flagComps, opts)
 
 		// Parse a single field, returning one or more generic Flags
-		_, found, err := flags.ParseField(val, *sfield, flags.FlagHandler(compScanner))
+		flagOpts := append([]flags.OptFunc{}, opts...)
+		flagOpts = append(flagOpts, flags.FlagHandler(compScanner))
+
+		_, found, err := flags.ParseField(val, *sfield, flagOpts...)
 		if err != nil {
 			return found, err
 		}
@@ -145,22 +147,24 @@ func flagComps(comps *comp.Carapace, flagComps *flagSetComps) scan.Handler {
 }
 
 // flagCompsScanner builds a scanner that will register some completers for an option flag.
-func flagCompsScanner(actions *flagSetComps) flags.FlagFunc {
+func flagCompsScanner(cmd *cobra.Command, actions *flagSetComps, opts []flags.OptFunc) flags.FlagFunc {
 	handler := func(flag string, tag tag.MultiTag, val reflect.Value) error {
 		// First get any completer implementation, and identifies if
 		// type is an array, and if yes, where the completer is implemented.
-		completer, isRepeatable, itemsImplement := typeCompleter(val)
+		catalog := flags.ScanOptions(opts...).Catalog
+		completer, isRepeatable, itemsImplement := typeCompleter(cmd, val, catalog)
 
 		// Check if the flag has some choices: if yes, we simply overwrite
 		// the completer implementation with a builtin one.
-		if choices := choiceCompletions(tag, val); choices != nil {
+		isList := val.Kind() == reflect.Slice || val.Kind() == reflect.Map
+		if choices := choiceCompletions(tag, isList, opts); choices != nil {
 			completer = choices
 			itemsImplement = true
 		}
 
 		// Or we might find struct tags specifying some completions,
 		// in which case we also override the completer implementation
-		if tagged, found := taggedCompletions(tag); found {
+		if tagged, found := taggedCompletions(tag, flags.ScanOptions(opts...)); found {
 			completer = tagged
 			itemsImplement = true
 		}
@@ -170,7 +174,13 @@ func flagCompsScanner(actions *flagSetComps) flags.FlagFunc {
 			return nil
 		}
 
-		action := comp.ActionCallback(completer)
+		// Expensive completions might be cached for some time.
+		completer, err := cachedCompleter(cmd, "--"+flag, tag, completer)
+		if err != nil {
+			return err
+		}
+
+		action := styled(comp.ActionCallback(completer), opts)
 
 		// Then, and irrespectively of where the completer comes from,
 		// we adapt it considering the kind of type we're dealing with.
@@ -180,8 +190,40 @@ func flagCompsScanner(actions *flagSetComps) flags.FlagFunc {
 
 		(*actions)[flag] = action
 
+		// The other long names of the option complete the same values.
+		for _, alias := range aliasNames(flag, tag) {
+			(*actions)[alias] = action
+		}
+
 		return nil
 	}
 
 	return handler
 }
+
+// aliasNames returns the other long names of an option (aliases and previous names),
+// with the same prefix (namespace) as the name of its flag, if it has a long tag.
+func aliasNames(flag string, mtag tag.MultiTag) []string {
+	long, _ := mtag.Get("long")
+	if long == "" || !strings.HasSuffix(flag, long) {
+		return nil
+	}
+
+	prefix := strings.TrimSuffix(flag, long)
+
+	var names []string
+
+	for _, aliases := range mtag.GetMany("alias") {
+		for _, alias := range strings.Split(aliases, ",") {
+			if alias = strings.TrimSpace(alias); alias != "" {
+				names = append(names, prefix+alias)
+			}
+		}
+	}
+
+	for _, renamed := range mtag.GetMany("renamed-from") {
+		names = append(names, prefix+renamed)
+	}
+
+	return names
+}
@@ -0,0 +1,130 @@
+package completions
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/reeflective/flags"
+	comp "github.com/rsteube/carapace"
+	"github.com/rsteube/carapace/pkg/ps"
+	"github.com/spf13/cobra"
+)
+
+// InstallName is the name of the hidden subcommand of the completion command of
+// cobra, which installs completion scripts (see flags.WithCompletionInstall).
+const InstallName = "install"
+
+// ErrUnsupportedShell indicates that completion scripts cannot be installed for a shell.
+var ErrUnsupportedShell = errors.New("unsupported shell")
+
+// installCommand adds the hidden install subcommand to the completion command of cobra,
+// which is added to the root command beforehand. Nothing is added if the root has no
+// subcommands, or if its default completion command is disabled (eg. in REPL mode).
+func installCommand(root *cobra.Command, comps *comp.Carapace, opts []flags.OptFunc) {
+	root.InitDefaultCompletionCmd()
+
+	for _, subc := range root.Commands() {
+		if subc.Name() != "completion" {
+			continue
+		}
+
+		for _, installer := range subc.Commands() {
+			if installer.Name() == InstallName {
+				return
+			}
+		}
+
+		subc.AddCommand(&cobra.Command{
+			Use:       InstallName + " [shell]",
+			Short:     "Install the autocompletion script for the current shell",
+			Hidden:    true,
+			Args:      cobra.MaximumNArgs(1),
+			ValidArgs: []string{"bash", "zsh", "fish"},
+			RunE: func(cmd *cobra.Command, args []string) error {
+				shell := ps.DetermineShell()
+				if len(args) > 0 {
+					shell = args[0]
+				}
+
+				return installScript(cmd, comps, shell, opts)
+			},
+		})
+	}
+}
+
+// installScript writes the completion script of a shell where it loads it from,
+// and prints where it has been written, and how to activate it if needed.
+func installScript(cmd *cobra.Command, comps *comp.Carapace, shell string, opts []flags.OptFunc) error {
+	path, activation, err := scriptPath(shell, filepath.Base(cmd.Root().Name()), opts)
+	if err != nil {
+		return err
+	}
+
+	script, err := comps.Snippet(shell)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, []byte(script), 0o644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Installed %s completions in %s\n", shell, path)
+
+	if activation != "" {
+		fmt.Fprintln(cmd.OutOrStdout(), activation)
+	}
+
+	return nil
+}
+
+// scriptPath returns the path of the completion script of a program for a shell, in the
+// directories of the user (read with the environment of the options), and the instructions
+// to activate it, if the shell does not load scripts from this directory by default.
+func scriptPath(shell, name string, opts []flags.OptFunc) (string, string, error) {
+	lookupEnv := flags.ScanOptions(opts...).LookupEnv
+
+	home, found := lookupEnv("HOME")
+	if !found || home == "" {
+		var err error
+		if home, err = os.UserHomeDir(); err != nil {
+			return "", "", err
+		}
+	}
+
+	xdgDir := func(key, fallback string) string {
+		if dir, found := lookupEnv(key); found && filepath.IsAbs(dir) {
+			return dir
+		}
+
+		return filepath.Join(home, fallback)
+	}
+
+	switch shell {
+	case "bash":
+		dir := xdgDir("XDG_DATA_HOME", filepath.Join(".local", "share"))
+		path := filepath.Join(dir, "bash-completion", "completions", name)
+
+		return path, "Completions are loaded by the bash-completion package in new shells.", nil
+	case "zsh":
+		path := filepath.Join(home, ".zsh", "completions", "_"+name)
+		activation := "To activate them, add the following to ~/.zshrc, before compinit is called:\n\n" +
+			"  fpath=(~/.zsh/completions $fpath)\n  autoload -U compinit && compinit"
+
+		return path, activation, nil
+	case "fish":
+		dir := xdgDir("XDG_CONFIG_HOME", ".config")
+
+		return filepath.Join(dir, "fish", "completions", name+".fish"), "", nil
+	case "":
+		return "", "", fmt.Errorf("%w: could not detect the current shell", ErrUnsupportedShell)
+	default:
+		return "", "", fmt.Errorf("%w: %q (expected bash, zsh or fish)", ErrUnsupportedShell, shell)
+	}
+}
@@ -0,0 +1,76 @@
+package completions
+
+import (
+	genflags "github.com/reeflective/flags/gen/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// exclusiveAnnotation is the annotation of cobra groups of mutually exclusive options,
+// of which carapace does not propose the others once one of them is set.
+const exclusiveAnnotation = "cobra_annotation_mutually_exclusive"
+
+// completeNames makes the completion command of a tree propose the negative flags of negatable
+// options (as carapace proposes hidden flags), but not those of the options already set, nor the
+// options whose negative flag is set. Options and their negative flags are only marked as mutually
+// exclusive while completing, since cobra would otherwise reject command-lines with both of them.
+//
+// Carapace proposes the long names of all options, with no way to filter them: those of
+// short-only options are thus completed after a double dash, along with their short names.
+func completeNames(root *cobra.Command) {
+	var carapaceCmd *cobra.Command
+
+	for _, subc := range root.Commands() {
+		if subc.Name() == "_carapace" {
+			carapaceCmd = subc
+		}
+	}
+
+	if carapaceCmd == nil || carapaceCmd.Run == nil {
+		return
+	}
+
+	run := carapaceCmd.Run
+
+	carapaceCmd.Run = func(cmd *cobra.Command, args []string) {
+		unmark := markNegations(root)
+		defer unmark()
+
+		run(cmd, args)
+	}
+}
+
+// markNegations marks the negatable options of a command tree and their negative flags as
+// mutually exclusive, if they are not already in such a group, and returns a function unmarking them.
+func markNegations(cmd *cobra.Command) func() {
+	var unmarks []func()
+
+	for _, flagSet := range []*pflag.FlagSet{cmd.Flags(), cmd.PersistentFlags()} {
+		flagSet := flagSet
+
+		flagSet.VisitAll(func(flag *pflag.Flag) {
+			negation := flagSet.Lookup(genflags.Negation(flag))
+			if negation == nil {
+				return
+			}
+
+			for _, exclusive := range []*pflag.Flag{flag, negation} {
+				if _, isSet := exclusive.Annotations[exclusiveAnnotation]; !isSet {
+					exclusive := exclusive
+					exclusive.Annotations[exclusiveAnnotation] = []string{flag.Name + " " + negation.Name}
+					unmarks = append(unmarks, func() { delete(exclusive.Annotations, exclusiveAnnotation) })
+				}
+			}
+		})
+	}
+
+	for _, subc := range cmd.Commands() {
+		unmarks = append(unmarks, markNegations(subc))
+	}
+
+	return func() {
+		for _, unmark := range unmarks {
+			unmark()
+		}
+	}
+}
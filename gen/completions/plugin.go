@@ -0,0 +1,39 @@
+package completions
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	genflags "github.com/reeflective/flags/gen/flags"
+	comp "github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+// pluginCompletions completes the arguments of the plugin commands of a root command with
+// those of their executables, by running them with the `_carapace export` hidden command.
+// Executables failing to do so (eg. not generated with carapace) have no completions.
+func pluginCompletions(root *cobra.Command) {
+	for _, subc := range root.Commands() {
+		path, isPlugin := genflags.PluginPath(subc)
+		if !isPlugin {
+			continue
+		}
+
+		comp.Gen(subc).PositionalAnyCompletion(comp.ActionCallback(func(cctx comp.Context) comp.Action {
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			args := append([]string{"_carapace", "export", filepath.Base(path)}, cctx.Args...)
+
+			output, err := Exec(ctx, cctx, path, append(args, cctx.Value)...)
+			if err != nil {
+				return comp.ActionValues()
+			}
+
+			return comp.ActionImport(output)
+		}))
+	}
+}
@@ -0,0 +1,50 @@
+//go:build !windows
+
+package completions
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reeflective/flags"
+	genflags "github.com/reeflective/flags/gen/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPluginCompletions checks that plugin commands are completed by their executables,
+// and that those not answering the completion protocol are not completed at all.
+func TestPluginCompletions(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	prefix := filepath.Join(dir, filepath.Base(os.Args[0])+"-")
+
+	script := `#!/bin/sh
+[ "$1" = "_carapace" ] && [ "$4" = "--env" ] && echo '{"values":[{"value":"prod","display":"prod","description":"production"}]}'
+`
+	require.NoError(t, os.WriteFile(prefix+"deploy", []byte(script), 0o755))
+	require.NoError(t, os.WriteFile(prefix+"legacy", []byte("#!/bin/sh\nexit 1\n"), 0o755))
+
+	data := struct{}{}
+	rootCmd := genflags.Generate(&data, flags.WithPlugins(dir))
+
+	_, err := Generate(rootCmd, &data, nil, flags.WithPlugins(dir))
+	require.NoError(t, err)
+
+	for _, plugin := range []string{"deploy", "legacy"} {
+		out := &bytes.Buffer{}
+		rootCmd.SetOut(out)
+		rootCmd.SetArgs([]string{"_carapace", "export", "", plugin, "--env", ""})
+		require.NoError(t, rootCmd.Execute())
+
+		if plugin == "deploy" {
+			assert.Contains(t, out.String(), `"value":"prod"`)
+			assert.Contains(t, out.String(), `"description":"production"`)
+		} else {
+			assert.Contains(t, out.String(), `"values":[]`)
+		}
+	}
+}
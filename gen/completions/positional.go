@@ -3,15 +3,21 @@ package completions
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
+	"github.com/reeflective/flags"
 	"github.com/reeflective/flags/internal/positional"
 	"github.com/reeflective/flags/internal/scan"
 	"github.com/reeflective/flags/internal/tag"
 	comp "github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // positionals finds a struct tagged as containing positional arguments and scans them.
-func positionals(comps *comp.Carapace, tag tag.MultiTag, val reflect.Value) (bool, error) {
+func positionals(cmd *cobra.Command, comps *comp.Carapace, tag tag.MultiTag, val reflect.Value, opts []flags.OptFunc) (bool, error) {
 	// We need the struct to be marked as such
 	if pargs, _ := tag.Get("positional-args"); len(pargs) == 0 {
 		return false, nil
@@ -21,76 +27,124 @@ func positionals(comps *comp.Carapace, tag tag.MultiTag, val reflect.Value) (boo
 	// with their own requirements, and references to their values.
 	// Return a type storing all the fields, references, and with the
 	// tools to manage, parse words and raise any errors related
-	args, err := positional.ScanArgs(val, tag)
+	args, err := positional.ScanArgs(val, tag, flags.ScanOptFuncs(opts...)...)
 	if err != nil || args == nil {
 		return true, fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
 	}
 
+	return true, positionalComps(cmd, comps, args, opts)
+}
+
+// fieldPositionals completes the fields of a command struct tagged as positional arguments, if any.
+func fieldPositionals(cmd *cobra.Command, comps *comp.Carapace, data interface{}, opts []flags.OptFunc) error {
+	args, err := positional.ScanFields(reflect.Indirect(reflect.ValueOf(data)), flags.ScanOptFuncs(opts...)...)
+	if err != nil || args == nil {
+		return err
+	}
+
+	return positionalComps(cmd, comps, args, opts)
+}
+
+// positionalComps binds the completions of positional arguments to their command.
+func positionalComps(cmd *cobra.Command, comps *comp.Carapace, args *positional.Args, opts []flags.OptFunc) error {
 	// Find all completer implementations, or
 	// build ones based on struct tag specs.
 	// Put them in a cache of completion callbacks that is accessed
 	// by all positional arguments in order to use their completions.
-	completionCache := getCompleters(args, comps)
-
-	// Make a custom function for consuming the command words,
-	args = positional.WithWordConsumer(args, consumeWith(completionCache))
+	completionCache, err := getCompleters(cmd, args, opts)
+	if err != nil {
+		return err
+	}
 
 	// Once we a have a list of positionals, completers for each,
 	// and the number of arguments required, we can build a single
 	// completion handler, similar to our ValidArgs function handler
+	toggles := flags.ScanOptions(opts...).PlusToggles
+
 	handler := func(ctx comp.Context) comp.Action {
+		// Words like +x are not positionals, but unset boolean flags.
+		if toggles {
+			if strings.HasPrefix(ctx.Value, "+") {
+				return toggleCompletions(cmd)(ctx)
+			}
+
+			ctx.Args = withoutToggles(cmd, ctx.Args)
+		}
+
+		// Completions might run concurrently (eg. in a console, or batched
+		// with others), so the completers to use are staged for this one only.
+		stage := newCompletionStage()
+
 		// Simply call the positionals with our command words.
 		// This function will call each positional with a copy
-		// of the list.
+		// of the list, with a custom function consuming them.
 		// Arguments that don't have enough words to work with
 		// will be ignored. The function blocks until all slots
 		// are done processing their word list.
-		args.ParseConcurrent(ctx.Args)
+		args.ParseConcurrentWith(ctx.Args, consumeWith(stage))
 
 		// We are done processing some/all of the positional words.
-		// The cache contains all the completions it needs, so we
+		// The stage contains all the completers we need, so we
 		// just unload them into one action to be returned
-		return completionCache.flush(ctx)
+		return completionCache.flush(ctx, stage)
 	}
 
 	// And bind this positional completer to our command
-	comps.PositionalAnyCompletion(comp.ActionCallback(handler))
+	comps.PositionalAnyCompletion(styled(comp.ActionCallback(handler), opts))
 
-	return true, nil
+	return nil
 }
 
 // getCompleters populates the completers for each positional argument in
 // a list of them, through either implemented methods or struct tag specs.
-func getCompleters(args *positional.Args, comps *comp.Carapace) *compCache {
+// Their descriptions and groups are translated with the catalog of the options, if any.
+func getCompleters(cmd *cobra.Command, args *positional.Args, opts []flags.OptFunc) (*compCache, error) {
 	// The cache stores all completer functions, to be used later.
 	cache := newCompletionCache()
+	catalog := flags.ScanOptions(opts...).Catalog
 
 	for _, arg := range args.Positionals() {
 		// By default, use the argument description as hint, in case there is
 		// no completion directive or implementation.
-		if completer, _ := hintCompletions(arg.Tag); completer != nil {
+		key := flags.CatalogKey(commandPath(cmd), "<"+arg.Name+">", flags.CatalogDescription)
+		if completer, _ := hintCompletions(arg.Tag, catalog, key); completer != nil {
 			cache.add(arg.Index, completer)
 		}
 
 		// Make parser function, get completer implementations, how many arguments, etc.
-		if completer, _, _ := typeCompleter(arg.Value); completer != nil {
+		if completer, _, _ := typeCompleter(cmd, arg.Value, catalog); completer != nil {
 			cache.add(arg.Index, completer)
 		}
 
+		// Choices override the completions of the type, as for options.
+		if choices := choiceCompletions(arg.Tag, true, opts); choices != nil {
+			cache.add(arg.Index, choices)
+		}
+
 		// But struct tags have precedence, so here should take place
 		// most of the work, since it's quite easy to specify powerful completions.
-		if completer, found := taggedCompletions(arg.Tag); found {
+		if completer, found := taggedCompletions(arg.Tag, flags.ScanOptions(opts...)); found {
+			cache.add(arg.Index, completer)
+		}
+
+		// Expensive completions might be cached for some time.
+		completer, err := cachedCompleter(cmd, "<"+arg.Name+">", arg.Tag, cache.completers[arg.Index])
+		if err != nil {
+			return nil, err
+		}
+
+		if completer != nil {
 			cache.add(arg.Index, completer)
 		}
 	}
 
-	return cache
+	return cache, nil
 }
 
 // consumeWith returns a custom handler which will be called on each positional
-// argument, so that it can consume one/more of the positional words and add
-// completions to the cache if needed.
-func consumeWith(comps *compCache) positional.WordConsumer {
+// argument, so that it can consume one/more of the positional words and stage
+// its completer if needed.
+func consumeWith(comps *compStage) positional.WordConsumer {
 	handler := func(args *positional.Args, arg *positional.Arg, _ int) error {
 		// First, pop all the words we KNOW we're not
 		// interested in, which is the number of minimum
@@ -146,7 +200,7 @@ func consumeWith(comps *compCache) positional.WordConsumer {
 }
 
 // completeOrIgnore finally takes the decision of completing this positional or not.
-func completeOrIgnore(arg *positional.Arg, comps *compCache, actuallyParsed int) error {
+func completeOrIgnore(arg *positional.Arg, comps *compStage, actuallyParsed int) error {
 	mustComplete := false
 
 	switch {
@@ -163,63 +217,137 @@ func completeOrIgnore(arg *positional.Arg, comps *compCache, actuallyParsed int)
 		mustComplete = true
 	}
 
-	// If something has said we must, cache the comps.
+	// If something has said we must, stage the comps.
 	if mustComplete {
-		comps.useCompleter(arg.Index)
+		comps.use(arg.Index)
 	}
 
 	return nil
 }
 
-// a list used to store completion callbacks produced by our
-// positional arguments' slots at some point in the process.
+// compCache stores the completion callbacks of positional arguments' slots,
+// which are all found when generating completions: it is never modified
+// afterwards, and can thus be used by concurrent completions.
 type compCache struct {
-	// All positionals have given their completers
-	// before running, so we can access them
-	completers *map[int]comp.CompletionCallback
-	// And the cache is the list of completion callbacks
-	// we will actually use when exiting the full process.
-	cache []comp.CompletionCallback
+	completers map[int]comp.CompletionCallback
 }
 
 func newCompletionCache() *compCache {
 	return &compCache{
-		completers: &map[int]comp.CompletionCallback{},
+		completers: map[int]comp.CompletionCallback{},
 	}
 }
 
 func (c *compCache) add(index int, cb comp.CompletionCallback) {
-	(*c.completers)[index] = cb
+	c.completers[index] = cb
 }
 
-func (c *compCache) useCompleter(index int) {
-	completer, found := (*c.completers)[index]
-	if found {
-		c.cache = append(c.cache, completer)
-	}
+// compStage collects the positional slots to complete for a single completion.
+// Slots are processed concurrently, so it is safe for concurrent use.
+type compStage struct {
+	mutex sync.Mutex
+	used  map[int]bool
 }
 
-// flush returns all the completions cached by our positional arguments,
-// so we invoke each of them with the context so that they can perform
-// so filtering tasks if they need to.
-func (c *compCache) flush(ctx comp.Context) comp.Action {
-	actions := make([]comp.Action, 0)
+func newCompletionStage() *compStage {
+	return &compStage{used: map[int]bool{}}
+}
+
+func (s *compStage) use(index int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.used[index] = true
+}
 
-	// fixed-max positional completers
-	for _, cb := range c.cache {
-		actions = append(actions, comp.ActionCallback(cb))
+// flush returns all the completions staged by our positional arguments,
+// so we invoke each of them with the context so that they can perform
+// so filtering tasks if they need to. They are merged in the order of
+// the positionals, regardless of the order in which they were staged.
+func (c *compCache) flush(ctx comp.Context, stage *compStage) comp.Action {
+	stage.mutex.Lock()
+	indexes := make([]int, 0, len(stage.used))
+
+	for index := range stage.used {
+		indexes = append(indexes, index)
 	}
+	stage.mutex.Unlock()
+
+	sort.Ints(indexes)
 
 	// Each of the completers should invoke with
 	// the context so that they can filter out
 	// the candidates that are already present.
-	processed := make([]comp.Action, 0)
+	processed := make([]comp.Action, 0, len(indexes))
 
-	for _, completion := range actions {
-		completion = completion.Invoke(ctx).Filter(ctx.Args).ToA()
+	for _, index := range indexes {
+		completer, found := c.completers[index]
+		if !found {
+			continue
+		}
+
+		completion := comp.ActionCallback(completer).Invoke(ctx).Filter(ctx.Args).ToA()
 		processed = append(processed, completion)
 	}
 
 	// Let carapace merge all of our callbacks.
 	return comp.Batch(processed...).ToA()
 }
+
+// toggleCompletions returns a completion callback proposing, for a word starting with a `+`,
+// the short names of the boolean flags of the command that are not yet in this word.
+func toggleCompletions(cmd *cobra.Command) comp.CompletionCallback {
+	return func(ctx comp.Context) comp.Action {
+		if !strings.HasPrefix(ctx.Value, "+") {
+			return comp.ActionValues()
+		}
+
+		toggles := make([]string, 0)
+		seen := map[string]bool{}
+
+		visit := func(flag *pflag.Flag) {
+			if flag.Shorthand == "" || flag.Hidden || flag.Value.Type() != "bool" {
+				return
+			}
+
+			if seen[flag.Shorthand] || strings.Contains(ctx.Value[1:], flag.Shorthand) {
+				return
+			}
+
+			seen[flag.Shorthand] = true
+			toggles = append(toggles, ctx.Value+flag.Shorthand, flag.Usage)
+		}
+
+		cmd.Flags().VisitAll(visit)
+		cmd.InheritedFlags().VisitAll(visit)
+
+		return comp.ActionValuesDescribed(toggles...)
+	}
+}
+
+// withoutToggles returns the words that are not made of a `+` followed
+// only by short names of boolean flags, which are not positional words.
+func withoutToggles(cmd *cobra.Command, args []string) []string {
+	words := make([]string, 0, len(args))
+
+	for _, arg := range args {
+		if !isToggle(cmd, arg) {
+			words = append(words, arg)
+		}
+	}
+
+	return words
+}
+
+// isToggle returns true if the word is made of a `+` followed only by short names
+// of boolean flags, either local to the command or inherited from its parents.
+func isToggle(cmd *cobra.Command, word string) bool {
+	return flags.IsToggle(word, func(short string) bool {
+		flag := cmd.Flags().ShorthandLookup(short)
+		if flag == nil {
+			flag = cmd.InheritedFlags().ShorthandLookup(short)
+		}
+
+		return flag != nil && flag.Value.Type() == "bool"
+	})
+}
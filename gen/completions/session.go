@@ -0,0 +1,112 @@
+package completions
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/reeflective/flags"
+	comp "github.com/rsteube/carapace"
+)
+
+// sessionTagPrefix is the prefix of completion tags completing values remembered
+// during the session, eg. `complete:"session:targets"`.
+const sessionTagPrefix = "session:"
+
+// session stores the values remembered by commands executed in a console
+// session, by key. It is nil (and remembers nothing) until EnableSession().
+var session struct {
+	sync.RWMutex
+	values map[string][]string
+}
+
+// EnableSession enables the session store, in which outputs of commands can be
+// remembered with Remember() and be completed by later commands declaring them
+// with `complete:"session:<key>"` tags. This is only useful in console (REPL)
+// applications, where commands are executed several times within the same process.
+func EnableSession() {
+	session.Lock()
+	defer session.Unlock()
+
+	if session.values == nil {
+		session.values = make(map[string][]string)
+	}
+}
+
+// DisableSession disables the session store, and forgets all its values.
+func DisableSession() {
+	session.Lock()
+	defer session.Unlock()
+
+	session.values = nil
+}
+
+// Remember adds some values to those completed by `complete:"session:<key>"` tags.
+// Values already remembered under this key are not duplicated, but are moved to the
+// end of the list, so that the most recent ones are the last ones. This function
+// does nothing if the session store has not been enabled with EnableSession().
+func Remember(key string, values []string) {
+	session.Lock()
+	defer session.Unlock()
+
+	if session.values == nil {
+		return
+	}
+
+	remembered := session.values[key]
+
+	for _, value := range values {
+		for i, known := range remembered {
+			if known == value {
+				remembered = append(remembered[:i], remembered[i+1:]...)
+
+				break
+			}
+		}
+
+		remembered = append(remembered, value)
+	}
+
+	session.values[key] = remembered
+}
+
+// Forget removes all the values remembered under a key.
+func Forget(key string) {
+	session.Lock()
+	defer session.Unlock()
+
+	delete(session.values, key)
+}
+
+// Recall returns a copy of the values remembered under a key, or nil if none.
+func Recall(key string) []string {
+	session.RLock()
+	defer session.RUnlock()
+
+	if len(session.values[key]) == 0 {
+		return nil
+	}
+
+	return append([]string{}, session.values[key]...)
+}
+
+// sessionCompletions returns an action completing the values remembered under a key,
+// when completion is actually requested, so that it always proposes the latest ones.
+func sessionCompletions(key string) comp.Action {
+	return comp.ActionCallback(func(comp.Context) comp.Action {
+		values := Recall(key)
+		for i, value := range values {
+			values[i] = flags.SanitizeLine(value)
+		}
+
+		return comp.ActionValues(values...).Tag(key)
+	})
+}
+
+// isSessionTag returns the key of a session completion tag, if it is one.
+func isSessionTag(tag string) (string, bool) {
+	if !strings.HasPrefix(strings.ToLower(tag), sessionTagPrefix) {
+		return "", false
+	}
+
+	return strings.TrimSpace(tag[len(sessionTagPrefix):]), true
+}
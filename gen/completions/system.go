@@ -0,0 +1,205 @@
+package completions
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/reeflective/flags"
+	comp "github.com/rsteube/carapace"
+)
+
+// System files read by the builtin completions of system entities.
+var (
+	hostsFile  = "/etc/hosts"
+	passwdFile = "/etc/passwd"
+	groupFile  = "/etc/group"
+	procDir    = "/proc"
+)
+
+// systemCompletions returns the completions of a system entity (the name of a `complete`
+// directive), read when completing, as values described with their details, grouped and
+// named with the catalog of the options. Entities not found on the system are not completed.
+func systemCompletions(entity string, catalog flags.Catalog) comp.Action {
+	var (
+		complete func() []string
+		group    string
+	)
+
+	switch entity {
+	case "hosts":
+		complete, group = hostCompletions, flags.MessageHosts
+	case "users":
+		complete, group = userCompletions, flags.MessageUsers
+	case "groups":
+		complete, group = groupCompletions, flags.MessageUserGroups
+	case "interfaces":
+		complete, group = interfaceCompletions, flags.MessageInterfaces
+	case "env":
+		complete, group = envCompletions, flags.MessageEnvVariables
+	case "processes":
+		complete, group = processCompletions, flags.MessageProcesses
+	default:
+		return comp.ActionValues()
+	}
+
+	return comp.ActionCallback(func(comp.Context) comp.Action {
+		return comp.ActionValuesDescribed(complete()...).Tag(catalog.Message(group))
+	})
+}
+
+// hostCompletions returns the host names of the hosts file (described with their
+// address) and the hosts of the SSH configuration of the user, without patterns.
+func hostCompletions() []string {
+	var described []string
+
+	seen := map[string]bool{}
+	add := func(name, description string) {
+		if !seen[name] && name != "" && !strings.ContainsAny(name, "*?!") {
+			seen[name] = true
+			described = append(described, name, flags.SanitizeLine(description))
+		}
+	}
+
+	readFields(hostsFile, strings.Fields, func(fields []string) {
+		for _, name := range fields[1:] {
+			add(name, fields[0])
+		}
+	})
+
+	if home, err := os.UserHomeDir(); err == nil {
+		sshFields := func(line string) []string {
+			return strings.FieldsFunc(line, func(r rune) bool { return r == ' ' || r == '\t' || r == '=' })
+		}
+
+		readFields(filepath.Join(home, ".ssh", "config"), sshFields, func(fields []string) {
+			if strings.EqualFold(fields[0], "Host") {
+				for _, name := range fields[1:] {
+					add(name, "ssh")
+				}
+			}
+		})
+	}
+
+	return described
+}
+
+// userCompletions returns the user names of the passwd file, described with their full name.
+func userCompletions() []string {
+	var described []string
+
+	readFields(passwdFile, colonFields, func(fields []string) {
+		description := ""
+		if len(fields) > 4 {
+			description, _, _ = strings.Cut(fields[4], ",")
+		}
+
+		described = append(described, fields[0], flags.SanitizeLine(description))
+	})
+
+	return described
+}
+
+// groupCompletions returns the group names of the group file, described with their ID.
+func groupCompletions() []string {
+	var described []string
+
+	readFields(groupFile, colonFields, func(fields []string) {
+		description := ""
+		if len(fields) > 2 {
+			description = "gid " + fields[2]
+		}
+
+		described = append(described, fields[0], flags.SanitizeLine(description))
+	})
+
+	return described
+}
+
+// interfaceCompletions returns the names of the network interfaces, described with their addresses.
+func interfaceCompletions() []string {
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return nil
+	}
+
+	described := make([]string, 0, len(interfaces)*2)
+
+	for _, iface := range interfaces {
+		var addresses []string
+
+		if addrs, err := iface.Addrs(); err == nil {
+			for _, addr := range addrs {
+				addresses = append(addresses, addr.String())
+			}
+		}
+
+		described = append(described, iface.Name, strings.Join(addresses, ", "))
+	}
+
+	return described
+}
+
+// envCompletions returns the names of the environment variables, without description:
+// their values are not shown, since they might hold secrets (tokens, passwords, etc).
+func envCompletions() []string {
+	environ := os.Environ()
+	described := make([]string, 0, len(environ)*2)
+
+	for _, variable := range environ {
+		if name, _, _ := strings.Cut(variable, "="); name != "" {
+			described = append(described, name, "")
+		}
+	}
+
+	return described
+}
+
+// processCompletions returns the IDs of the running processes, described with their
+// command name, as found in the proc filesystem (thus only on systems providing one).
+func processCompletions() []string {
+	entries, err := os.ReadDir(procDir)
+	if err != nil {
+		return nil
+	}
+
+	var described []string
+
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil || !entry.IsDir() {
+			continue
+		}
+
+		name, _ := os.ReadFile(filepath.Join(procDir, entry.Name(), "comm"))
+		described = append(described, entry.Name(), flags.SanitizeLine(strings.TrimSpace(string(name))))
+	}
+
+	return described
+}
+
+// readFields calls a function with the fields of the lines of a file (split with
+// a function), without comments and empty lines, if the file exists.
+func readFields(path string, split func(line string) []string, fields func([]string)) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+
+		if words := split(line); len(words) > 0 && strings.TrimSpace(words[0]) != "" {
+			fields(words)
+		}
+	}
+}
+
+// colonFields splits the lines of colon-separated files (eg. /etc/passwd).
+func colonFields(line string) []string {
+	return strings.Split(line, ":")
+}
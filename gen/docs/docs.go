@@ -0,0 +1,248 @@
+// Package docs (github.com/reeflective/flags/gen/docs) renders the commands scanned
+// from a struct into Markdown or HTML documentation, one file per command, with tables
+// of their options (grouped, with their short/long names, env variables, default values
+// and choices) and positional arguments (with their requirements), and their examples.
+package docs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/pages"
+	"github.com/spf13/cobra"
+)
+
+// CommandName is the name of the hidden command returned by Command.
+// It is reserved by default, like all names starting with two underscores.
+const CommandName = "__docs"
+
+// Format is the format of the documentation files.
+type Format string
+
+const (
+	// Markdown documents commands in Markdown files, with tables.
+	Markdown Format = "markdown"
+
+	// HTML documents commands in standalone HTML files, with tables.
+	HTML Format = "html"
+)
+
+// Extension returns the extension of the files written in the format.
+func (f Format) Extension() string {
+	if f == HTML {
+		return ".html"
+	}
+
+	return ".md"
+}
+
+// Generate writes the documentation of all the (non-hidden) commands found in data to
+// a directory, as `<name>-<command path>` files (`<name>` for the root one) with the
+// extension of the format, linked to each other. The name is the one of the program,
+// and the options are the same parsing options as those given to the flags.Generate() call.
+func Generate(data interface{}, name, dir string, format Format, opts ...flags.OptFunc) error {
+	cmdPages, err := pages.Scan(data, opts)
+	if err != nil {
+		return err
+	}
+
+	for _, page := range cmdPages {
+		var buf bytes.Buffer
+
+		writePage(newRenderer(&buf, format), page, name, format)
+
+		path := filepath.Join(dir, pages.Name(name, page.Command)+format.Extension())
+		if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Write writes the documentation of a single command, designated by its
+// path (eg. "remote", "add", or none for the root command), to a writer.
+func Write(writer io.Writer, data interface{}, name string, path []string, format Format, opts ...flags.OptFunc) error {
+	cmdPages, err := pages.Scan(data, opts)
+	if err != nil {
+		return err
+	}
+
+	if page := pages.Find(cmdPages, path); page != nil {
+		writePage(newRenderer(writer, format), page, name, format)
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: no command %q", flags.ErrParse, strings.Join(path, " "))
+}
+
+// Command returns a hidden command writing the documentation of the commands found
+// in data to the directory given as argument (Markdown by default, or HTML with its
+// --format flag), to be added to the root command of data, named after the program:
+//
+//	rootCmd := gen.Generate(data)
+//	rootCmd.AddCommand(docs.Command(data, rootCmd.Name()))
+func Command(data interface{}, name string, opts ...flags.OptFunc) *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:    CommandName + " DIR",
+		Short:  "Write the documentation of all commands to a directory",
+		Hidden: true,
+		Args:   cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch Format(format) {
+			case Markdown, HTML:
+				return Generate(data, name, args[0], Format(format), opts...)
+			default:
+				return fmt.Errorf("%w: unknown format %q (markdown or html)", flags.ErrInvalidValue, format)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", string(Markdown), "format of the documentation (markdown or html)")
+
+	return cmd
+}
+
+// writePage renders the documentation of a command.
+func writePage(out renderer, page *pages.Page, name string, format Format) {
+	command := strings.Join(append([]string{name}, page.Command.Path...), " ")
+
+	out.begin(command)
+	out.heading(1, command)
+
+	if page.Command.Description != "" {
+		out.paragraph(page.Command.Description)
+	}
+
+	out.heading(2, "Synopsis")
+	out.block(command + " " + pages.Synopsis(page))
+
+	if page.Command.LongDescription != "" {
+		out.paragraph(page.Command.LongDescription)
+	}
+
+	writeArgs(out, page.Args)
+	writeOptions(out, page.Options)
+
+	if len(page.Command.Examples) > 0 {
+		out.heading(2, "Examples")
+
+		for _, example := range page.Command.Examples {
+			out.block(example)
+		}
+	}
+
+	if len(page.Subcommands) > 0 {
+		out.heading(2, "Commands")
+
+		rows := make([][]string, 0, len(page.Subcommands))
+		for _, subc := range page.Subcommands {
+			rows = append(rows, []string{link(out, name, subc, format), out.text(subc.Description)})
+		}
+
+		out.table([]string{"Command", "Description"}, rows)
+	}
+
+	if parent := page.Command.Parent; parent != nil {
+		out.heading(2, "See also")
+		out.list([]string{link(out, name, parent, format)})
+	}
+
+	out.end()
+}
+
+// writeArgs renders the table of the positional arguments of a command.
+func writeArgs(out renderer, args []*flags.Positional) {
+	if len(args) == 0 {
+		return
+	}
+
+	out.heading(2, "Arguments")
+
+	rows := make([][]string, 0, len(args))
+	for _, arg := range args {
+		rows = append(rows, []string{
+			out.code(arg.Name), out.text(arg.Usage), out.text(pages.Requirement(arg)), codes(out, arg.Choices),
+		})
+	}
+
+	out.table([]string{"Name", "Description", "Required", "Choices"}, rows)
+}
+
+// writeOptions renders the tables of the options of a command, one for each of their groups.
+func writeOptions(out renderer, options []pages.Option) {
+	if len(options) == 0 {
+		return
+	}
+
+	out.heading(2, "Options")
+
+	var rows [][]string
+
+	for i, opt := range options {
+		if i == 0 || opt.Group != options[i-1].Group {
+			if i > 0 {
+				optionsTable(out, rows)
+				rows = nil
+			}
+
+			if opt.Group != nil {
+				out.heading(3, opt.Group.Name+" options")
+			}
+		}
+
+		long, short, env := "", "", ""
+		if opt.Flag.Name != "" && !opt.Flag.ShortOnly {
+			long = out.code("--" + opt.Flag.Name)
+		}
+
+		if opt.Flag.Short != "" {
+			short = out.code("-" + opt.Flag.Short)
+		}
+
+		if opt.Flag.EnvName != "" {
+			env = out.code(opt.Flag.EnvName)
+		}
+
+		usage := strings.TrimSpace(opt.Flag.Usage)
+		if opt.Flag.Required {
+			usage = strings.TrimSpace(usage + " (required)")
+		}
+
+		rows = append(rows, []string{
+			long, short, env, codes(out, opt.Flag.DefValue), codes(out, opt.Flag.Choices), out.text(usage),
+		})
+	}
+
+	optionsTable(out, rows)
+}
+
+// optionsTable renders a table of options.
+func optionsTable(out renderer, rows [][]string) {
+	out.table([]string{"Long", "Short", "Env", "Default", "Choices", "Description"}, rows)
+}
+
+// link returns a link to the documentation of a command.
+func link(out renderer, name string, cmd *flags.Command, format Format) string {
+	command := strings.Join(append([]string{name}, cmd.Path...), " ")
+
+	return out.link(command, pages.Name(name, cmd)+format.Extension())
+}
+
+// codes returns a list of values as a table cell, each of them as code.
+func codes(out renderer, values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = out.code(value)
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,118 @@
+package docs
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type remoteCommand struct {
+	Verbose bool `short:"v" long:"verbose" description:"verbose | output"`
+
+	Add struct {
+		Args struct {
+			Name  string   `description:"name of the remote" required:"1"`
+			Proto string   `choice:"ssh https"`
+			URLs  []string `description:"remote urls"`
+		} `positional-args:"yes"`
+		Options struct {
+			Mode  string `short:"m" long:"mode" choice:"fetch push" default:"fetch"`
+			Token string `long:"token" env:"REMOTE_TOKEN" required:"yes" description:"auth token"`
+		} `group:"remote"`
+	} `command:"add" description:"add a remote" example:"app add origin ssh git@host:repo"`
+}
+
+// TestGenerate checks that documentation files are written for all commands,
+// with their options and arguments tables, examples and links.
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	err := Generate(&remoteCommand{}, "app", dir, Markdown)
+	require.NoError(t, err)
+
+	root, err := os.ReadFile(filepath.Join(dir, "app.md"))
+	require.NoError(t, err)
+
+	add, err := os.ReadFile(filepath.Join(dir, "app-add.md"))
+	require.NoError(t, err)
+
+	test := assert.New(t)
+	test.Contains(string(root), "# app\n\n## Synopsis\n\n```\napp [OPTIONS] COMMAND\n```\n")
+	test.Contains(string(root), "| `--verbose` | `-v` | `VERBOSE` | `false` |  | verbose \\| output |\n")
+	test.Contains(string(root), "| [app add](app-add.md) | add a remote |\n")
+
+	page := string(add)
+	test.Contains(page, "app add [OPTIONS] NAME [PROTO] [URLS...]")
+	test.Contains(page, "| `Name` | name of the remote | Required. |  |\n")
+	test.Contains(page, "| `Proto` |  |  | `ssh`, `https` |\n")
+	test.Contains(page, "### remote options\n\n")
+	test.Contains(page, "| `--mode` | `-m` | `MODE` | `fetch` | `fetch`, `push` |  |\n")
+	test.Contains(page, "| `--token` |  | `REMOTE_TOKEN` |  |  | auth token (required) |\n")
+	test.Contains(page, "## Examples\n\n```\napp add origin ssh git@host:repo\n```\n")
+	test.Contains(page, "## See also\n\n- [app](app.md)\n")
+}
+
+// TestWrite checks that a single command is written, and that unknown ones are errors.
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	test := assert.New(t)
+	test.NoError(Write(&buf, &remoteCommand{}, "app", []string{"add"}, Markdown))
+	test.Contains(buf.String(), "# app add\n\nadd a remote\n")
+	test.Error(Write(&buf, &remoteCommand{}, "app", []string{"remove"}, Markdown))
+}
+
+// TestWriteHTML checks that commands are documented in standalone HTML pages, escaped.
+func TestWriteHTML(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	test := assert.New(t)
+	test.NoError(Write(&buf, &remoteCommand{}, "app", nil, HTML))
+
+	page := buf.String()
+	test.Contains(page, "<title>app</title>")
+	test.Contains(page, "<h2>Synopsis</h2>\n<pre><code>app [OPTIONS] COMMAND</code></pre>\n")
+	test.Contains(page, "<tr><th>Long</th><th>Short</th><th>Env</th><th>Default</th><th>Choices</th><th>Description</th></tr>\n")
+	test.Contains(page, "<tr><td><code>--verbose</code></td><td><code>-v</code></td><td><code>VERBOSE</code></td>"+
+		"<td><code>false</code></td><td></td><td>verbose | output</td></tr>\n")
+	test.Contains(page, `<td><a href="app-add.html">app add</a></td><td>add a remote</td>`)
+	test.True(strings.HasSuffix(page, "</body>\n</html>\n"))
+
+	buf.Reset()
+	test.NoError(Write(&buf, &remoteCommand{}, "app", []string{"add"}, HTML))
+	test.Contains(buf.String(), "<pre><code>app add origin ssh git@host:repo</code></pre>")
+}
+
+// TestCommand checks that the documentation command writes the
+// documentation of the commands of a tree, in the format given.
+func TestCommand(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cmd := Command(&remoteCommand{}, "app")
+
+	test := assert.New(t)
+	test.True(cmd.Hidden)
+
+	cmd.SetArgs([]string{"--format", "html", dir})
+	require.NoError(t, cmd.Execute())
+	test.FileExists(filepath.Join(dir, "app.html"))
+	test.FileExists(filepath.Join(dir, "app-add.html"))
+
+	cmd.SetArgs([]string{"--format", "pdf", dir})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	test.Error(cmd.Execute(), "Unknown formats should fail")
+}
@@ -0,0 +1,143 @@
+package docs
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// renderer writes the elements of a documentation page in a format. The inline elements
+// (text, code and links) are returned escaped, to be given to the block elements as is.
+type renderer interface {
+	begin(title string)
+	heading(level int, text string)
+	paragraph(text string)
+	block(text string)
+	table(header []string, rows [][]string)
+	list(items []string)
+	end()
+
+	text(text string) string
+	code(text string) string
+	link(text, target string) string
+}
+
+// newRenderer returns the renderer of a format, writing to a writer.
+func newRenderer(writer io.Writer, format Format) renderer {
+	if format == HTML {
+		return &htmlRenderer{writer}
+	}
+
+	return &markdownRenderer{writer}
+}
+
+// markdownRenderer writes Markdown pages, with tables in the GitHub flavor.
+type markdownRenderer struct {
+	io.Writer
+}
+
+func (r *markdownRenderer) begin(string) {}
+
+func (r *markdownRenderer) heading(level int, text string) {
+	fmt.Fprintf(r, "%s %s\n\n", strings.Repeat("#", level), text)
+}
+
+func (r *markdownRenderer) paragraph(text string) { fmt.Fprintf(r, "%s\n\n", text) }
+
+func (r *markdownRenderer) block(text string) { fmt.Fprintf(r, "```\n%s\n```\n\n", text) }
+
+func (r *markdownRenderer) table(header []string, rows [][]string) {
+	fmt.Fprintf(r, "| %s |\n|%s\n", strings.Join(header, " | "), strings.Repeat(" --- |", len(header)))
+
+	for _, row := range rows {
+		fmt.Fprintf(r, "| %s |\n", strings.Join(row, " | "))
+	}
+
+	io.WriteString(r, "\n")
+}
+
+func (r *markdownRenderer) list(items []string) {
+	for _, item := range items {
+		fmt.Fprintf(r, "- %s\n", item)
+	}
+}
+
+func (r *markdownRenderer) end() {}
+
+// text escapes a text so that it fits in a single table cell.
+func (r *markdownRenderer) text(text string) string {
+	text = strings.ReplaceAll(strings.TrimSpace(text), "|", `\|`)
+
+	return strings.ReplaceAll(text, "\n", "<br>")
+}
+
+func (r *markdownRenderer) code(text string) string { return "`" + r.text(text) + "`" }
+
+func (r *markdownRenderer) link(text, target string) string {
+	return fmt.Sprintf("[%s](%s)", text, target)
+}
+
+// htmlRenderer writes standalone HTML pages.
+type htmlRenderer struct {
+	io.Writer
+}
+
+func (r *htmlRenderer) begin(title string) {
+	fmt.Fprintf(r, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body>\n",
+		html.EscapeString(title))
+}
+
+func (r *htmlRenderer) heading(level int, text string) {
+	fmt.Fprintf(r, "<h%d>%s</h%d>\n", level, html.EscapeString(text), level)
+}
+
+func (r *htmlRenderer) paragraph(text string) { fmt.Fprintf(r, "<p>%s</p>\n", r.text(text)) }
+
+func (r *htmlRenderer) block(text string) {
+	fmt.Fprintf(r, "<pre><code>%s</code></pre>\n", html.EscapeString(text))
+}
+
+func (r *htmlRenderer) table(header []string, rows [][]string) {
+	io.WriteString(r, "<table>\n<tr>")
+
+	for _, cell := range header {
+		fmt.Fprintf(r, "<th>%s</th>", cell)
+	}
+
+	io.WriteString(r, "</tr>\n")
+
+	for _, row := range rows {
+		io.WriteString(r, "<tr>")
+
+		for _, cell := range row {
+			fmt.Fprintf(r, "<td>%s</td>", cell)
+		}
+
+		io.WriteString(r, "</tr>\n")
+	}
+
+	io.WriteString(r, "</table>\n")
+}
+
+func (r *htmlRenderer) list(items []string) {
+	io.WriteString(r, "<ul>\n")
+
+	for _, item := range items {
+		fmt.Fprintf(r, "<li>%s</li>\n", item)
+	}
+
+	io.WriteString(r, "</ul>\n")
+}
+
+func (r *htmlRenderer) end() { io.WriteString(r, "</body>\n</html>\n") }
+
+func (r *htmlRenderer) text(text string) string {
+	return strings.ReplaceAll(html.EscapeString(strings.TrimSpace(text)), "\n", "<br>")
+}
+
+func (r *htmlRenderer) code(text string) string { return "<code>" + r.text(text) + "</code>" }
+
+func (r *htmlRenderer) link(text, target string) string {
+	return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(target), html.EscapeString(text))
+}
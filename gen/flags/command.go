@@ -1,15 +1,18 @@
 package flags
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/reeflective/flags"
 	"github.com/reeflective/flags/internal/scan"
 	"github.com/reeflective/flags/internal/tag"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // Generate returns a root cobra Command to be used directly as an entry-point.
@@ -24,28 +27,355 @@ func Generate(data interface{}, opts ...flags.OptFunc) *cobra.Command {
 	}
 
 	// Scan the struct and bind all commands to this root.
-	generate(cmd, data, opts...)
+	if err := generate(cmd, data, opts...); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err.Error())
+		os.Exit(1)
+	}
 
 	return cmd
 }
 
+// Bind generates the options, positionals and subcommands found in data onto an existing cobra
+// command (root or not), instead of a new root command, so that applications already built
+// with cobra can adopt this library one command at a time. The data is the same as the one of
+// Generate(). The existing subcommands of the command are kept as they are: struct subcommands
+// cannot have their names or aliases (ErrCommandExists), nor options those of existing flags.
+//
+// The command runs the implementation of data, if it has one, or its own one otherwise.
+// As with Generate(), options given before subcommands on the command-line are only
+// parsed by their command if TraverseChildren is set on the root command. Completions of
+// a bound root are generated with completions.Generate, and those of a bound subcommand
+// with completions.AddCommand.
+func Bind(cmd *cobra.Command, data interface{}, opts ...flags.OptFunc) error {
+	val := reflect.ValueOf(data)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return flags.ErrNotPointerToStruct
+	}
+
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+
+	// Existing subcommands are left out of the generation of the tree.
+	existing := cmd.Commands()
+	cmd.RemoveCommand(existing...)
+
+	err := generate(cmd, data, opts...)
+
+	for _, subc := range existing {
+		for _, name := range append([]string{subc.Name()}, subc.Aliases...) {
+			if err == nil && hasSubcommand(cmd, name) {
+				err = fmt.Errorf("%w: %q", ErrCommandExists, name)
+			}
+		}
+
+		cmd.AddCommand(subc)
+	}
+
+	return err
+}
+
+// ParseArgs scans the data struct for commands, options and positionals, and parses
+// the args onto it, without executing any of the commands' implementations.
+// It returns the words that have not been parsed into flags or positional fields,
+// as they would otherwise be passed to the target command's Execute(args) method.
+//
+// This is useful for programs embedding parsing in other frameworks, or needing
+// early access to some option values (eg. --config) before generating the full
+// command tree for execution. The data is populated from env values as well, and
+// checked like when commands are executed, but none of its pre-runners are called.
+func ParseArgs(data interface{}, args []string, opts ...flags.OptFunc) ([]string, error) {
+	cmd := &cobra.Command{
+		Use:              os.Args[0],
+		Annotations:      map[string]string{},
+		TraverseChildren: true,
+	}
+
+	// The tree is only used for this command-line.
+	defer Forget(cmd)
+
+	if err := flags.CheckArgs(args, opts...); err != nil {
+		return args, err
+	}
+
+	if err := generate(cmd, data, append(opts[:len(opts):len(opts)], parseOnly)...); err != nil {
+		return args, err
+	}
+
+	// Find the target command, parsing its parents' flags along the way.
+	target, words, err := cmd.Traverse(args)
+	if err != nil {
+		return words, err
+	}
+
+	if err := target.ParseFlags(words); err != nil {
+		return words, flagErrors(target, target.Flags(), err)
+	}
+
+	retargs := target.Flags().Args()
+
+	// Commands collecting unknown flags or raw arguments parse their own.
+	if target.DisableFlagParsing {
+		retargs = words
+	}
+
+	// Positionals are parsed by the command arguments handler,
+	// which stores the words it did not consume for Execute().
+	if target.Args != nil {
+		if err := target.Args(target, retargs); err != nil {
+			return retargs, err
+		}
+
+		retargs = getRemainingArgs(target)
+	}
+
+	// Options are then set from the environment, interpolated and checked,
+	// with no pre-runner to call.
+	if target.PreRunE != nil {
+		if err := target.PreRunE(target, retargs); err != nil {
+			return retargs, err
+		}
+	}
+
+	return retargs, nil
+}
+
+// checkParsed makes the commands of a tree, once their command-line is parsed and before their
+// pre-runners, set their options from the environment, interpolate those whose values are templates,
+// check their groups and relations (with these final values), then validate their structs. This is
+// done once the full tree is built, since options might be inherited by commands. A Resolver bound
+// to the tree afterwards resolves the options of its commands before all of these steps.
+func checkParsed(cmd *cobra.Command, opts []flags.OptFunc) {
+	// Steps bound later run first: shared groups are copied with their final values.
+	shareGroups(cmd)
+	validateStructs(cmd)
+	requireGroups(cmd)
+	interpolateFlags(cmd, opts)
+	envFlags(cmd, opts)
+}
+
+// parseOnly generates commands without the implementations of their structs (ParseArgs).
+func parseOnly(opts *scan.Opts) { opts.ParseOnly = true }
+
 // generate wraps all main steps' invocations, to be reused in various cases.
-func generate(cmd *cobra.Command, data interface{}, opts ...flags.OptFunc) {
+func generate(cmd *cobra.Command, data interface{}, opts ...flags.OptFunc) error {
+	// Structs checking their values together do so once parsed, after all other checks.
+	// This is deferred so that they are never kept for commands failing to generate,
+	// like options only set from the environment, set once commands are parsed.
+	addValidater(cmd, data, false)
+	defer checkParsed(cmd, opts)
+
 	// Make a scan handler that will run various scans on all
 	// the struct fields, with arbitrary levels of nesting.
 	scanner := scanRoot(cmd, nil, opts)
 
 	// And scan the struct recursively, for arg/option groups and subcommands
 	if err := scan.Type(data, scanner); err != nil {
-		fmt.Fprintln(os.Stderr, "Error:", err.Error())
-		os.Exit(1)
+		return err
+	}
+
+	if err := generatedAliases(cmd, data, opts); err != nil {
+		return err
 	}
 
-	// Subcommands, optional or not
+	// Options can only require (or conflict with) the options of their command or parents.
+	if err := checkRelations(cmd); err != nil {
+		return err
+	}
+
+	// Invalid values of options are returned as errors inspectable by callers.
+	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return renderError(cmd, flagErrors(cmd, cmd.Flags(), err))
+	})
+
+	// Subcommands, optional or not (commands bound with their own implementation keep it).
 	if cmd.HasSubCommands() {
-		cmd.RunE = unknownSubcommandAction
+		if cmd.Run == nil && cmd.RunE == nil {
+			cmd.RunE = unknownSubcommandAction(flags.ScanOptions(opts...).Catalog)
+		}
 	} else {
-		setRuns(cmd, data)
+		setRuns(cmd, data, opts)
+	}
+
+	setPersistentRuns(cmd, data)
+
+	// Executables named after the root command extend it with plugins, if enabled.
+	pluginCommands(cmd, opts)
+
+	if err := unknownFlags(cmd, data, opts); err != nil {
+		return err
+	}
+
+	if err := fieldPositionals(cmd, data, opts); err != nil {
+		return err
+	}
+
+	if err := argvField(cmd, data); err != nil {
+		return err
+	}
+
+	// Command-lines are checked against any limits before being parsed
+	// by entrypoints, but executed commands only see their arguments.
+	limitArgs(cmd, opts)
+
+	// Errors and usages are shown with the renderers set on commands, if any.
+	renderErrors(cmd)
+	renderUsages(cmd)
+
+	// Uses of commands and options are counted, if enabled.
+	recordUsage(cmd)
+
+	// Commands run through the middlewares used by them and their parents, if any,
+	// the outermost one canceling their context on signals, if enabled.
+	signalCancel(cmd, opts)
+	useMiddlewares(cmd)
+
+	// Help templates can query whether their output may be colored.
+	colorUsages(cmd, opts)
+
+	// Help usages show positionals, and options by groups, with their details.
+	helpUsages(cmd)
+
+	// Descriptions are localized with the catalog given in options, if any.
+	if catalog := flags.ScanOptions(opts...).Catalog; len(catalog) > 0 {
+		translate(cmd, catalog)
+	}
+
+	// Builtin commands and flags might not be relevant to the frontend.
+	if flags.ScanOptions(opts...).Mode == flags.ModeREPL {
+		hideBuiltins(cmd)
+	}
+
+	// Help usages and builtins are translated once the latter are all added.
+	if catalog := flags.ScanOptions(opts...).Catalog; len(catalog) > 0 {
+		translateHelp(cmd, catalog)
+	}
+
+	// Usage lines show the required options and positionals of commands.
+	synopses(cmd)
+
+	// Structs are restored to their generated values when the tree is reset.
+	snapshotTree(cmd, data)
+
+	return nil
+}
+
+// limitArgs makes all commands of the tree check that their arguments
+// do not exceed the limits of the command-line set in options, if any.
+func limitArgs(cmd *cobra.Command, opts []flags.OptFunc) {
+	if limits := flags.ScanOptions(opts...); limits.MaxArgs == 0 && limits.MaxArgLength == 0 {
+		return
+	}
+
+	for _, subc := range cmd.Commands() {
+		limitArgs(subc, opts)
+	}
+
+	// Commands without arguments handlers use the cobra default one.
+	next := cmd.Args
+	if next == nil {
+		return
+	}
+
+	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		if err := flags.CheckArgs(args, opts...); err != nil {
+			return err
+		}
+
+		return next(cmd, args)
+	}
+}
+
+// translate replaces the descriptions of the commands, options and positionals of a tree
+// (and the placeholders of the latter, and the titles of groups of options) with their
+// translations in a catalog, for those having one.
+func translate(cmd *cobra.Command, catalog flags.Catalog) {
+	path := commandPath(cmd)
+
+	cmd.Short = catalog.Text(flags.CatalogKey(path, "", flags.CatalogDescription), cmd.Short)
+	cmd.Long = catalog.Text(flags.CatalogKey(path, "", flags.CatalogLongDescription), cmd.Long)
+
+	cmd.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+		flag.Usage = catalog.Text(flags.CatalogKey(path, "--"+flag.Name, flags.CatalogDescription), flag.Usage)
+	})
+
+	for _, arg := range commandPositionals(cmd) {
+		element := "<" + arg.Name + ">"
+		arg.Tag.Set("description", catalog.Text(flags.CatalogKey(path, element, flags.CatalogDescription), argumentUsage(arg)))
+		arg.Tag.Set("positional-arg-name", catalog.Text(flags.CatalogKey(path, element, flags.CatalogPlaceholder), arg.Name))
+	}
+
+	translateGroups(cmd, path, catalog)
+
+	for _, subc := range cmd.Commands() {
+		translate(subc, catalog)
+	}
+}
+
+// translateGroups replaces the names of the groups of options of a command,
+// which are the titles of their sections in help usages, with their translations.
+func translateGroups(cmd *cobra.Command, path []string, catalog flags.Catalog) {
+	if cmd.Annotations[groupsAnnotation] == "" {
+		return
+	}
+
+	titles := make(map[string]string)
+
+	var names []string
+
+	for _, name := range strings.Split(cmd.Annotations[groupsAnnotation], "\n") {
+		titles[name] = catalog.Text(flags.CatalogKey(path, "["+name+"]", flags.CatalogTitle), name)
+		names = append(names, titles[name])
+	}
+
+	cmd.Annotations[groupsAnnotation] = strings.Join(names, "\n")
+
+	cmd.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+		if group := flag.Annotations[groupAnnotation]; len(group) > 0 && titles[group[0]] != "" {
+			flag.Annotations[groupAnnotation] = []string{titles[group[0]]}
+		}
+	})
+}
+
+// translateHelp translates the descriptions of the help flags and command of a
+// command tree, which cobra adds if they are missing: the headers of the usage
+// template are translated by the template itself (see UsageTemplate).
+func translateHelp(cmd *cobra.Command, catalog flags.Catalog) {
+	if !cmd.HasParent() {
+		cmd.InitDefaultHelpCmd()
+	}
+
+	cmd.InitDefaultHelpFlag()
+
+	if help := cmd.Flags().Lookup("help"); help != nil && help.Usage == "help for "+cmd.Name() {
+		help.Usage = catalog.Message(flags.MessageHelpFlag, cmd.Name())
+	}
+
+	for _, subc := range cmd.Commands() {
+		if subc.Name() == "help" && subc.Short == "Help about any command" {
+			subc.Short = catalog.Message(flags.MessageHelpCommand)
+		}
+
+		translateHelp(subc, catalog)
+	}
+}
+
+// hideBuiltins hides the help command and flags cobra adds to the command tree,
+// and disables its completion command, since consoles have their own builtins.
+func hideBuiltins(cmd *cobra.Command) {
+	cmd.CompletionOptions.DisableDefaultCmd = true
+
+	cmd.InitDefaultHelpCmd()
+
+	for _, subc := range cmd.Commands() {
+		if subc.Name() == "help" {
+			subc.Hidden = true
+		}
+	}
+
+	if cmd.PersistentFlags().Lookup("help") == nil {
+		cmd.PersistentFlags().Bool("help", false, "help for "+cmd.Name())
+		_ = cmd.PersistentFlags().MarkHidden("help")
 	}
 }
 
@@ -56,11 +386,16 @@ func generate(cmd *cobra.Command, data interface{}, opts ...flags.OptFunc) {
 func scanRoot(cmd *cobra.Command, group *cobra.Group, opts []flags.OptFunc) scan.Handler {
 	handler := func(val reflect.Value, sfield *reflect.StructField) (bool, error) {
 		// Parse the tag or die tryin. We should find one, or we're not interested.
-		mtag, _, err := tag.GetFieldTag(*sfield)
+		mtag, _, err := flags.ScanOptions(opts...).FieldTag(*sfield)
 		if err != nil {
 			return true, fmt.Errorf("%w: %s", tag.ErrTag, err.Error())
 		}
 
+		// Fields used in another execution mode are not generated.
+		if !flags.InMode(mtag, opts...) {
+			return true, nil
+		}
+
 		// If the field is marked as -one or more- positional arguments, we
 		// return either on a successful scan of them, or with an error doing so.
 		if found, err := positionals(cmd, mtag, val, opts); found || err != nil {
@@ -94,6 +429,16 @@ func command(cmd *cobra.Command, grp *cobra.Group, tag tag.MultiTag, val reflect
 		return false, nil
 	}
 
+	// Internal commands would be shadowed by, or would shadow, user ones.
+	for _, used := range append([]string{name}, tag.GetMany("alias")...) {
+		if flags.IsReserved(used, opts...) {
+			return true, fmt.Errorf("%w: %q cannot be used by a command", flags.ErrReservedName, used)
+		}
+	}
+
+	// Options of the command might have their own env prefix.
+	opts = flags.CommandOptions(tag, opts...)
+
 	// Initialize the field if nil
 	data := initialize(val)
 
@@ -106,26 +451,63 @@ func command(cmd *cobra.Command, grp *cobra.Group, tag tag.MultiTag, val reflect
 	tagged, _ := tag.Get("group")
 	setGroup(cmd, subc, grp, tagged)
 
+	// Bind this subcommand to us before scanning it, so
+	// that it can find any of its parents' persistent flags.
+	cmd.AddCommand(subc)
+	addValidater(subc, data, false)
+
 	// Scan the struct recursively, for arg/option groups and subcommands
 	scanner := scanRoot(subc, grp, opts)
 	if err := scan.Type(data, scanner); err != nil {
 		return true, fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
 	}
 
+	if err := generatedAliases(subc, data, opts); err != nil {
+		return true, err
+	}
+
 	// Bind the various pre/run/post implementations of our command.
 	if _, isSet := tag.Get("subcommands-optional"); !isSet && subc.HasSubCommands() {
-		subc.RunE = unknownSubcommandAction
+		subc.RunE = unknownSubcommandAction(flags.ScanOptions(opts...).Catalog)
 	} else {
 		data := initialize(val)
-		setRuns(subc, data)
+		setRuns(subc, data, opts)
 	}
 
-	// And bind this subcommand back to us
-	cmd.AddCommand(subc)
+	setPersistentRuns(subc, data)
+
+	if err := unknownFlags(subc, data, opts); err != nil {
+		return true, err
+	}
+
+	if err := fieldPositionals(subc, data, opts); err != nil {
+		return true, err
+	}
+
+	if err := argvField(subc, data); err != nil {
+		return true, err
+	}
 
 	return true, nil
 }
 
+// generatedAliases adds the aliases generated with `alias-styles` tags to the subcommands
+// of a command, once all of them are known, so that those used by siblings are dropped.
+func generatedAliases(cmd *cobra.Command, data interface{}, opts []flags.OptFunc) error {
+	aliases, _, err := flags.SubcommandAliases(data, opts...)
+	if err != nil {
+		return err
+	}
+
+	for _, subc := range cmd.Commands() {
+		if named, found := aliases[subc.Name()]; found {
+			subc.Aliases = named
+		}
+	}
+
+	return nil
+}
+
 // builds a quick command template based on what has been specified through tags, and in context.
 func newCommand(name string, mtag tag.MultiTag, parent *cobra.Group) *cobra.Command {
 	subc := &cobra.Command{
@@ -134,15 +516,23 @@ func newCommand(name string, mtag tag.MultiTag, parent *cobra.Group) *cobra.Comm
 	}
 
 	if desc, _ := mtag.Get("description"); desc != "" {
-		subc.Short = desc
+		subc.Short = flags.Sanitize(desc)
 	} else if desc, _ := mtag.Get("desc"); desc != "" {
-		subc.Short = desc
+		subc.Short = flags.Sanitize(desc)
 	}
 
-	subc.Long, _ = mtag.Get("long-description")
+	long, _ := mtag.Get("long-description")
+	subc.Long = flags.Sanitize(long)
 	subc.Aliases = mtag.GetMany("alias")
+	subc.Example = flags.Sanitize(strings.Join(mtag.GetMany("example"), "\n"))
 	_, subc.Hidden = mtag.Get("hidden")
 
+	// Arbitrary metadata for downstream tooling
+	for _, annotation := range mtag.GetMany("annotation") {
+		key, value, _ := strings.Cut(annotation, "=")
+		subc.Annotations[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
 	return subc
 }
 
@@ -158,7 +548,7 @@ func setGroup(parent, subc *cobra.Command, parentGroup *cobra.Group, tagged stri
 		}
 
 		if group == nil {
-			group = &cobra.Group{ID: tagged, Title: tagged}
+			group = &cobra.Group{ID: tagged, Title: flags.Sanitize(tagged)}
 			parent.AddGroup(group)
 		}
 	} else if parentGroup != nil {
@@ -171,26 +561,30 @@ func setGroup(parent, subc *cobra.Command, parentGroup *cobra.Group, tagged stri
 	}
 }
 
-func unknownSubcommandAction(cmd *cobra.Command, args []string) error {
-	if len(args) == 0 {
-		return cmd.Help()
-	}
+// unknownSubcommandAction returns the implementation of commands requiring a subcommand, which
+// shows their help when none is given, or fails with an error (translated with the catalog).
+func unknownSubcommandAction(catalog flags.Catalog) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return cmd.Help()
+		}
 
-	err := fmt.Sprintf("unknown subcommand %q for %q", args[0], cmd.Name())
+		err := catalog.Message(flags.MessageUnknownSubcommand, args[0], cmd.Name())
 
-	if suggestions := cmd.SuggestionsFor(args[0]); len(suggestions) > 0 {
-		err += "\n\nDid you mean this?\n"
-		for _, s := range suggestions {
-			err += fmt.Sprintf("\t%v\n", s)
+		if suggestions := cmd.SuggestionsFor(args[0]); len(suggestions) > 0 {
+			err += "\n\n" + catalog.Message(flags.MessageSuggestions) + "\n"
+			for _, s := range suggestions {
+				err += fmt.Sprintf("\t%v\n", s)
+			}
+
+			err = strings.TrimSuffix(err, "\n")
 		}
 
-		err = strings.TrimSuffix(err, "\n")
+		return errors.New(err)
 	}
-
-	return fmt.Errorf(err)
 }
 
-func setRuns(cmd *cobra.Command, data interface{}) {
+func setRuns(cmd *cobra.Command, data interface{}, opts []flags.OptFunc) {
 	// No implementation means that this command
 	// requires subcommands by default.
 	if data == nil {
@@ -200,13 +594,28 @@ func setRuns(cmd *cobra.Command, data interface{}) {
 	// If our command hasn't any positional argument handler,
 	// we must make one to automatically put any of them in Execute
 	if cmd.Args == nil {
+		toggles := flags.ScanOptions(opts...).PlusToggles
+
 		cmd.Args = func(cmd *cobra.Command, args []string) error {
+			// Words like +x are not arguments, but unset boolean flags.
+			if toggles {
+				var err error
+				if args, _, err = toggleFlags(cmd.Flags(), args, cmd.ArgsLenAtDash()); err != nil {
+					return err
+				}
+			}
+
 			setRemainingArgs(cmd, args)
 
 			return nil
 		}
 	}
 
+	// Commands only parsing their command-lines run nothing.
+	if flags.ScanOptions(opts...).ParseOnly {
+		return
+	}
+
 	// Pre-runners
 	if runner, ok := data.(flags.PreRunner); ok && runner != nil {
 		cmd.PreRun = func(c *cobra.Command, _ []string) {
@@ -222,7 +631,13 @@ func setRuns(cmd *cobra.Command, data interface{}) {
 	}
 
 	// Runners
-	if commander, ok := data.(flags.Commander); ok && commander != nil {
+	if commander, ok := data.(flags.CommanderContext); ok && commander != nil {
+		cmd.RunE = func(c *cobra.Command, _ []string) error {
+			retargs := getRemainingArgs(c)
+			cmd.SetArgs(retargs)
+			return commander.ExecuteContext(c.Context(), retargs)
+		}
+	} else if commander, ok := data.(flags.Commander); ok && commander != nil {
 		cmd.RunE = func(c *cobra.Command, _ []string) error {
 			retargs := getRemainingArgs(c)
 			cmd.SetArgs(retargs)
@@ -244,19 +659,52 @@ func setRuns(cmd *cobra.Command, data interface{}) {
 
 	// Post-runners
 	if runner, ok := data.(flags.PostRunner); ok && runner != nil {
-		cmd.PreRun = func(c *cobra.Command, _ []string) {
+		cmd.PostRun = func(c *cobra.Command, _ []string) {
 			retargs := getRemainingArgs(c)
 			runner.PostRun(retargs)
 		}
 	}
 	if runner, ok := data.(flags.PostRunnerE); ok && runner != nil {
-		cmd.PreRunE = func(c *cobra.Command, _ []string) error {
+		cmd.PostRunE = func(c *cobra.Command, _ []string) error {
 			retargs := getRemainingArgs(c)
 			return runner.PostRunE(retargs)
 		}
 	}
 }
 
+// setPersistentRuns binds the persistent pre/post-run implementations of a command,
+// which also run for its subcommands (and with the arguments of the one executed).
+func setPersistentRuns(cmd *cobra.Command, data interface{}) {
+	if runner, ok := data.(flags.PersistentPreRunner); ok && runner != nil {
+		cmd.PersistentPreRun = func(c *cobra.Command, _ []string) {
+			retargs := getRemainingArgs(c)
+			runner.PersistentPreRun(retargs)
+		}
+	}
+	if runner, ok := data.(flags.PersistentPreRunnerE); ok && runner != nil {
+		cmd.PersistentPreRunE = func(c *cobra.Command, _ []string) error {
+			retargs := getRemainingArgs(c)
+			return runner.PersistentPreRunE(retargs)
+		}
+	}
+
+	// Options are set (from the environment, resolvers, etc) before persistent pre-runners.
+	persistentSteps(cmd)
+
+	if runner, ok := data.(flags.PersistentPostRunner); ok && runner != nil {
+		cmd.PersistentPostRun = func(c *cobra.Command, _ []string) {
+			retargs := getRemainingArgs(c)
+			runner.PersistentPostRun(retargs)
+		}
+	}
+	if runner, ok := data.(flags.PersistentPostRunnerE); ok && runner != nil {
+		cmd.PersistentPostRunE = func(c *cobra.Command, _ []string) error {
+			retargs := getRemainingArgs(c)
+			return runner.PersistentPostRunE(retargs)
+		}
+	}
+}
+
 func initialize(val reflect.Value) interface{} {
 	// Initialize if needed
 	var ptrval reflect.Value
@@ -275,3 +723,39 @@ func initialize(val reflect.Value) interface{} {
 
 	return ptrval.Interface()
 }
+
+// treeOptions are the options given to Generate() (or Bind), by generated command,
+// consulted by the functions of help and usage templates.
+var treeOptions sync.Map
+
+// rootOptions returns the options the tree of a command was generated with: those of the
+// closest command generated with some, since trees might be bound below other commands.
+func rootOptions(cmd *cobra.Command) []flags.OptFunc {
+	for parent := cmd; parent != nil; parent = parent.Parent() {
+		if opts, found := treeOptions.Load(parent); found {
+			return opts.([]flags.OptFunc)
+		}
+	}
+
+	return nil
+}
+
+// Options returns the options a command (or the tree it belongs to) was generated with,
+// so that the other generators of a tree (eg. completions) use the same ones by default.
+func Options(cmd *cobra.Command) []flags.OptFunc {
+	return append([]flags.OptFunc{}, rootOptions(cmd)...)
+}
+
+// colorUsages records the options of a tree for the `colors` function of help and
+// usage templates, which returns true if the help of a command may be colored (eg.
+// `{{if colors .}}`), as decided by flags.ColorsEnabled with those options.
+func colorUsages(cmd *cobra.Command, opts []flags.OptFunc) {
+	treeOptions.Store(cmd, opts)
+
+	addTemplateFuncs()
+}
+
+// colorsEnabled returns true if the help of a command may be colored.
+func colorsEnabled(cmd *cobra.Command) bool {
+	return flags.ColorsEnabled(rootOptions(cmd)...)
+}
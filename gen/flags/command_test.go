@@ -1,8 +1,15 @@
 package flags
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -341,3 +348,823 @@ func TestSubcommandsRequiredUsage(t *testing.T) {
 	err = root.Execute()
 	test.NotNil(err)
 }
+
+// TestParentCommand checks that commands not implementing Commander can be
+// used as pure parents, only holding options and grouping their subcommands.
+func TestParentCommand(t *testing.T) {
+	t.Parallel()
+
+	rootData := struct {
+		Remote struct {
+			Verbose bool `short:"v" long:"verbose"`
+
+			Add testCommand `command:"add"`
+		} `command:"remote"`
+	}{}
+
+	root := newCommandWithArgs(&rootData, []string{"remote", "-v", "add"})
+
+	test := assert.New(t)
+
+	resultCmd, err := root.ExecuteC()
+	test.Nil(err)
+	test.Equal("add", resultCmd.Name())
+	test.True(rootData.Remote.Verbose)
+
+	// The parent command prints its help, and rejects unknown subcommands.
+	root.SetArgs([]string{"remote"})
+	test.Nil(root.Execute())
+
+	root.SetArgs([]string{"remote", "invalid"})
+	test.NotNil(root.Execute())
+}
+
+// TestParseArgs checks that a command-line can be parsed onto a
+// command tree without executing any of its commands.
+func TestParseArgs(t *testing.T) {
+	t.Parallel()
+
+	rootData := root{}
+	args := []string{"-v", "c1", "-g", "remaining"}
+
+	retargs, err := ParseArgs(&rootData, args)
+
+	test := assert.New(t)
+	test.Nil(err, "Command-line should have been parsed successfully")
+	test.True(rootData.V, "flag -v should be true")
+	test.True(rootData.C1.G, "flag -g should be true")
+	test.False(rootData.C2.G, "flag -g of c2 should be false")
+	test.Equal([]string{"remaining"}, retargs)
+}
+
+// TestParseArgsForget checks that the trees generated to parse command-lines are
+// forgotten once parsed. It is not parallel, since it counts the trees kept.
+func TestParseArgsForget(t *testing.T) {
+	count := func() (trees int) {
+		for _, state := range []*sync.Map{&treeOptions, &snapshots} {
+			state.Range(func(_, _ any) bool { trees++; return true })
+		}
+
+		return trees
+	}
+
+	before := count()
+
+	for i := 0; i < 10; i++ {
+		_, err := ParseArgs(&root{}, []string{"-v", "c1"})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, before, count(), "parsed trees should not be kept")
+}
+
+// TestParseArgsEnv checks that options tagged with an env
+// variable are populated from the environment when parsing.
+func TestParseArgsEnv(t *testing.T) {
+	t.Setenv("FLAGS_TEST_CONFIG", "/etc/app.conf")
+	t.Setenv("FLAGS_TEST_HOSTS", "one:two")
+
+	data := struct {
+		Config string   `long:"config" env:"FLAGS_TEST_CONFIG"`
+		Hosts  []string `long:"hosts" env:"FLAGS_TEST_HOSTS" env-delim:":"`
+	}{}
+
+	_, err := ParseArgs(&data, []string{"--hosts", "three"})
+
+	test := assert.New(t)
+	test.Nil(err, "Command-line should have been parsed successfully")
+	test.Equal("/etc/app.conf", data.Config)
+	test.Equal([]string{"three"}, data.Hosts, "command-line words should reset env values")
+}
+
+// TestParseArgsEnvOnly checks that options only set from
+// the environment have no flag on the generated commands.
+func TestParseArgsEnvOnly(t *testing.T) {
+	t.Setenv("FLAGS_TEST_TOKEN", "secret")
+
+	data := struct {
+		Token string `env:"FLAGS_TEST_TOKEN" no-flag:"yes"`
+	}{}
+
+	cmd := Generate(&data)
+
+	test := assert.New(t)
+	test.Nil(cmd.Flags().Lookup("token"), "env-only options should not have a flag")
+
+	_, err := ParseArgs(&data, []string{"--token", "other"})
+	test.Error(err, "env-only options should not be parsed from the command-line")
+	test.Empty(data.Token, "options should not be set from the environment when generated")
+
+	_, err = ParseArgs(&data, nil)
+	test.NoError(err)
+	test.Equal("secret", data.Token)
+}
+
+// TestGenerateEnv checks that generating commands does not read the environment, and that
+// executed commands set their options (and those of their parents) from it, only once.
+func TestGenerateEnv(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Hosts []string `long:"hosts" env:"HOSTS" env-delim:":"`
+		Token string   `env:"TOKEN" no-flag:"yes"`
+		Level int      `long:"level" env:"LEVEL"`
+
+		Run testCommand `command:"run"`
+	}{Hosts: []string{"default"}}
+
+	opts := []flags.OptFunc{flags.WithEnviron([]string{"HOSTS=a:b", "TOKEN=secret", "LEVEL=2"})}
+
+	root := Generate(&data, opts...)
+	NewResolver(opts...).Bind(root)
+
+	test := assert.New(t)
+	test.Equal([]string{"default"}, data.Hosts, "generating should not read the environment")
+	test.Empty(data.Token)
+
+	root.SetArgs([]string{"--level", "3", "run"})
+	test.NoError(root.Execute())
+
+	test.Equal([]string{"a", "b"}, data.Hosts, "env values should replace defaults once")
+	test.Equal("secret", data.Token)
+	test.Equal(3, data.Level, "command-line words should override env values")
+	test.Equal(OriginEnv, FlagOrigin(root, "hosts"))
+}
+
+// TestParseArgsPlusToggles checks that words like +x unset boolean
+// flags when enabled, and are otherwise kept as positional words.
+func TestParseArgsPlusToggles(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		X    bool `short:"x"`
+		V    bool `short:"v"`
+		Args struct {
+			Words []string
+		} `positional-args:"yes"`
+	}{X: true, V: true}
+
+	retargs, err := ParseArgs(&data, []string{"+xv", "one", "+z", "--", "+x"}, flags.PlusToggles())
+
+	test := assert.New(t)
+	test.Nil(err, "Command-line should have been parsed successfully")
+	test.False(data.X, "flag -x should have been unset")
+	test.False(data.V, "flag -v should have been unset")
+	test.Equal([]string{"one", "+z"}, data.Args.Words)
+	test.Equal([]string{"+x"}, retargs, "words after a dash should not be toggles")
+
+	// Without positionals, toggles are removed from the remaining args.
+	noArgs := struct {
+		X bool `short:"x"`
+	}{X: true}
+
+	retargs, err = ParseArgs(&noArgs, []string{"+x", "remaining"}, flags.PlusToggles())
+	test.Nil(err, "Command-line should have been parsed successfully")
+	test.False(noArgs.X, "flag -x should have been unset")
+	test.Equal([]string{"remaining"}, retargs)
+}
+
+// TestCommandMode checks that commands and options tagged with an execution
+// mode are only generated in this mode, and that builtins are hidden in a REPL.
+func TestCommandMode(t *testing.T) {
+	t.Parallel()
+
+	type modeCommands struct {
+		Debug   bool        `long:"debug" mode:"cli"`
+		Exit    testCommand `command:"exit" mode:"repl"`
+		Version testCommand `command:"version"`
+	}
+
+	test := assert.New(t)
+
+	// Console mode
+	repl := Generate(&modeCommands{}, flags.WithMode(flags.ModeREPL))
+	names := commandNames(repl)
+
+	test.Contains(names, "exit", "repl commands should be generated in the console")
+	test.Contains(names, "version", "commands with no mode should always be generated")
+	test.Nil(repl.Flags().Lookup("debug"), "cli options should not be generated in the console")
+	test.True(repl.CompletionOptions.DisableDefaultCmd, "completion command should be disabled")
+	test.Contains(names, "help", "help command should still be available")
+
+	for _, cmd := range repl.Commands() {
+		if cmd.Name() == "help" {
+			test.True(cmd.Hidden, "help command should be hidden")
+		}
+	}
+
+	// One-shot mode
+	cli := Generate(&modeCommands{}, flags.WithMode(flags.ModeCLI))
+	names = commandNames(cli)
+
+	test.NotContains(names, "exit", "repl commands should not be generated in the CLI")
+	test.Contains(names, "version", "commands with no mode should always be generated")
+	test.NotNil(cli.Flags().Lookup("debug"), "cli options should be generated in the CLI")
+}
+
+func commandNames(cmd *cobra.Command) []string {
+	names := make([]string, 0)
+	for _, subc := range cmd.Commands() {
+		names = append(names, subc.Name())
+	}
+
+	return names
+}
+
+// TestCommandCollectUnknownFlags checks that unknown flags are collected
+// in the command field tagged for this purpose, when the option is given.
+func TestCommandCollectUnknownFlags(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Proxy struct {
+			Target string            `short:"t" long:"target"`
+			Force  bool              `short:"f"`
+			Extra  map[string]string `unknown:""`
+			Args   struct {
+				Words []string
+			} `positional-args:"yes"`
+			testCommand
+		} `command:"proxy"`
+	}{}
+
+	args := []string{"proxy", "--timeout", "10", "-t", "host", "--mode=fast", "word", "--dry-run", "-f", "--", "--last"}
+	retargs, err := ParseArgs(&data, args, flags.CollectUnknownFlags())
+
+	test := assert.New(t)
+	test.Nil(err, "Unknown flags should not be errors")
+	test.Equal("host", data.Proxy.Target)
+	test.True(data.Proxy.Force, "flag -f should be true")
+	test.Equal(map[string]string{"timeout": "10", "mode": "fast", "dry-run": ""}, data.Proxy.Extra)
+	test.Equal([]string{"word"}, data.Proxy.Args.Words)
+	test.Equal([]string{"--last"}, retargs)
+
+	// Without the option, unknown flags are still errors.
+	_, err = ParseArgs(&data, []string{"proxy", "--timeout", "10"})
+	test.Error(err, "Unknown flags should be errors")
+}
+
+// TestCommandAnnotations checks that annotation tags
+// are added to the annotations of the generated command.
+func TestCommandAnnotations(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Deploy testCommand `command:"deploy" annotation:"owner=platform" annotation:"route = ops"`
+	}{}
+
+	root := Generate(&data)
+	cmd, _, err := root.Find([]string{"deploy"})
+
+	test := assert.New(t)
+	test.Nil(err, "Command deploy should have been found")
+	test.Equal("platform", cmd.Annotations["owner"])
+	test.Equal("ops", cmd.Annotations["route"])
+}
+
+// TestCommandArgv checks that argv fields receive
+// the raw words given to their commands, untouched.
+func TestCommandArgv(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Exec struct {
+			Target string   `short:"t" long:"target"`
+			Argv   []string `argv:""`
+			Args   struct {
+				Words []string
+			} `positional-args:"yes"`
+		} `command:"exec"`
+	}{}
+
+	args := []string{"exec", "-t", "host", "word", "--", "--last"}
+	retargs, err := ParseArgs(&data, args)
+
+	test := assert.New(t)
+	test.Nil(err, "Command should have successfully parsed the flags")
+	test.Equal("host", data.Exec.Target)
+	test.Equal([]string{"-t", "host", "word", "--", "--last"}, data.Exec.Argv)
+	test.Equal([]string{"word"}, data.Exec.Args.Words)
+	test.Equal([]string{"--last"}, retargs)
+
+	// With unknown flags collected, raw words include them.
+	unknown := struct {
+		Exec struct {
+			Extra map[string]string `unknown:""`
+			Argv  []string          `argv:""`
+		} `command:"exec"`
+	}{}
+
+	_, err = ParseArgs(&unknown, []string{"exec", "--mode=fast"}, flags.CollectUnknownFlags())
+	test.Nil(err, "Unknown flags should not be errors")
+	test.Equal([]string{"--mode=fast"}, unknown.Exec.Argv)
+	test.Equal(map[string]string{"mode": "fast"}, unknown.Exec.Extra)
+
+	// And the field must be a list of words.
+	_, err = ParseArgs(&struct {
+		Argv string `argv:""`
+	}{}, []string{})
+	test.ErrorIs(err, flags.ErrInvalidTag)
+}
+
+// TestCommandReservedNames checks that commands cannot use the names reserved
+// for internal commands, unless applications reserve other names instead.
+func TestCommandReservedNames(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+
+	_, err := ParseArgs(&struct {
+		Complete testCommand `command:"__complete"`
+	}{}, []string{})
+	test.ErrorIs(err, flags.ErrReservedName, "__complete should be reserved")
+
+	_, err = ParseArgs(&struct {
+		Debug testCommand `command:"debug" alias:"__debug"`
+	}{}, []string{})
+	test.ErrorIs(err, flags.ErrReservedName, "The __ prefix should be reserved")
+
+	reserved := flags.WithReservedNames("__complete", "__completeNoDesc")
+	test.Equal([]string{"__complete", "__completeNoDesc"}, flags.ReservedNames(reserved))
+
+	_, err = ParseArgs(&struct {
+		Debug testCommand `command:"__debug"`
+	}{}, []string{"__debug"}, reserved)
+	test.Nil(err, "The __ prefix should not be reserved anymore")
+}
+
+// TestCommandAliasStyles checks that aliases are generated from the names of commands,
+// except those already used by their siblings, and that commands run with them.
+func TestCommandAliasStyles(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+
+	root := struct {
+		Install testCommand `command:"install" alias-styles:"short"`
+		GetPods testCommand `command:"get-pods" alias-styles:"short,initials"`
+		Inspect testCommand `command:"inspect" alias-styles:"short"`
+		Group   struct {
+			GetPorts testCommand `command:"get-ports" alias:"ports" alias-styles:"initials"`
+		} `commands:"network"`
+	}{}
+
+	cmd := Generate(&root)
+	aliases := map[string][]string{}
+
+	for _, subc := range cmd.Commands() {
+		aliases[subc.Name()] = subc.Aliases
+	}
+
+	test.Equal(map[string][]string{
+		"install":   nil,
+		"get-pods":  {"g"},
+		"inspect":   nil,
+		"get-ports": {"ports"},
+	}, aliases, "Aliases generated by several siblings should be dropped")
+
+	target, _, err := cmd.Find([]string{"g"})
+	test.Nil(err)
+	test.Equal("get-pods", target.Name())
+
+	_, err = Parse(&struct {
+		GetPods testCommand `command:"get-pods" alias-styles:"initials"`
+	}{}, []string{"gp"})
+	test.Nil(err, "Parse should find commands with their generated aliases")
+
+	_, err = ParseArgs(&struct {
+		Install testCommand `command:"install" alias-styles:"abbrev"`
+	}{}, []string{})
+	test.ErrorIs(err, flags.ErrInvalidTag, "Unknown alias styles should be invalid")
+}
+
+// TestGenerateCatalog checks that command and option descriptions
+// are translated with the catalog given to Generate.
+func TestGenerateCatalog(t *testing.T) {
+	t.Parallel()
+
+	data := &struct {
+		Verbose bool `long:"verbose" description:"verbose output"`
+
+		Run struct {
+			testCommand
+			Force bool `long:"force" description:"force"`
+
+			Remote struct {
+				Sync bool `long:"sync" description:"sync"`
+			} `group:"remote options"`
+
+			Args struct {
+				Target string `description:"target to run"`
+			} `positional-args:"yes"`
+		} `command:"run" description:"run something"`
+	}{}
+
+	test := assert.New(t)
+
+	catalog, err := flags.Extract(data)
+	test.Nil(err, "The catalog should have been extracted")
+	test.Equal("force", catalog["run --force#description"])
+
+	catalog["--verbose#description"] = "sortie détaillée"
+	catalog["run#description"] = "exécuter quelque chose"
+	catalog["run --force#description"] = "forcer"
+	catalog["run [remote options]#title"] = "options distantes"
+	catalog["run <Target>#positional-arg-name"] = "CIBLE"
+	catalog["run <Target>#description"] = "cible à exécuter"
+
+	root := Generate(data, flags.WithCatalog(catalog))
+	run, _, err := root.Find([]string{"run"})
+	test.Nil(err)
+
+	test.Equal("sortie détaillée", root.Flags().Lookup("verbose").Usage)
+	test.Equal("exécuter quelque chose", run.Short)
+	test.Equal("forcer", run.Flags().Lookup("force").Usage)
+
+	var out bytes.Buffer
+
+	run.SetOut(&out)
+	test.Nil(run.Help())
+	test.Contains(out.String(), "options distantes")
+	test.Contains(out.String(), "CIBLE")
+	test.Contains(out.String(), "cible à exécuter")
+	test.NotContains(out.String(), "remote options")
+	test.NotContains(out.String(), "Target")
+}
+
+// TestGenerateCatalogMessages checks that help usages and errors
+// are translated with the catalog given to Generate.
+func TestGenerateCatalogMessages(t *testing.T) {
+	t.Parallel()
+
+	data := &struct {
+		Remote struct {
+			Add struct {
+				testCommand
+				Args struct {
+					Name string `required:"yes"`
+				} `positional-args:"yes"`
+			} `command:"add" description:"add a remote"`
+		} `command:"remote"`
+	}{}
+
+	catalog := flags.Catalog{
+		flags.MessageUsage:             "Utilisation :",
+		flags.MessageCommands:          "Commandes disponibles :",
+		flags.MessageFlags:             "Options :",
+		flags.MessageGlobalFlags:       "Options globales :",
+		flags.MessageMoreInfo:          `Utilisez "%s [commande] --help" pour en savoir plus.`,
+		flags.MessageHelpFlag:          "aide pour %s",
+		flags.MessageUnknownSubcommand: "sous-commande %q inconnue pour %q",
+		flags.MessageSuggestions:       "Vouliez-vous dire :",
+		flags.MessageRequiredArgument:  "argument requis : %s manquant",
+	}
+
+	root := Generate(data, flags.WithCatalog(catalog))
+	root.SilenceErrors, root.SilenceUsage = true, true
+
+	test := assert.New(t)
+
+	var out bytes.Buffer
+
+	remote, _, _ := root.Find([]string{"remote"})
+	remote.SetOut(&out)
+	test.Nil(remote.Help())
+	test.Contains(out.String(), "Utilisation :\n  ")
+	test.Contains(out.String(), "Commandes disponibles :\n  add")
+	test.Contains(out.String(), "Options :\n  -h, --help   aide pour remote")
+	test.Contains(out.String(), `Utilisez "`+remote.CommandPath()+` [commande] --help" pour en savoir plus.`)
+	test.NotContains(out.String(), "Usage:")
+
+	root.SetArgs([]string{"remote", "ad"})
+	test.EqualError(root.Execute(), "sous-commande \"ad\" inconnue pour \"remote\"\n\nVouliez-vous dire :\n\tadd")
+
+	root.SetArgs([]string{"remote", "add"})
+	test.EqualError(root.Execute(), "argument requis : `Name` manquant")
+}
+
+func TestGenerateColors(t *testing.T) {
+	t.Parallel()
+
+	data := &struct {
+		Run testCommand `command:"run" description:"run something"`
+	}{}
+
+	template := `{{if colors .}}colored{{else}}plain{{end}}`
+
+	for mode, expected := range map[string]string{flags.ColorAlways: "colored", flags.ColorNever: "plain"} {
+		root := Generate(data, flags.WithColors(mode))
+		run, _, _ := root.Find([]string{"run"})
+
+		var out bytes.Buffer
+
+		run.SetHelpTemplate(template)
+		run.SetOut(&out)
+		assert.Nil(t, run.Help())
+		assert.Equal(t, expected, out.String(), "Subcommands should use the options of their tree")
+	}
+
+	root := Generate(data, flags.WithEnviron([]string{"NO_COLOR=1"}))
+
+	var out bytes.Buffer
+
+	root.SetHelpTemplate(template)
+	root.SetOut(&out)
+	assert.Nil(t, root.Help())
+	assert.Equal(t, "plain", out.String())
+}
+
+// rangeOptions is a group of options checking its values together.
+type rangeOptions struct {
+	Min int `long:"min"`
+	Max int `long:"max"`
+}
+
+func (r *rangeOptions) Validate() error {
+	if r.Min > r.Max {
+		return fmt.Errorf("--min (%d) is greater than --max (%d)", r.Min, r.Max)
+	}
+
+	return nil
+}
+
+// copyCommand is a command checking its values, and those of its positionals, together.
+type copyCommand struct {
+	Overwrite bool `long:"overwrite"`
+	Backup    bool `long:"backup"`
+
+	Range rangeOptions `group:"range"`
+
+	Args copyArgs `positional-args:"yes"`
+
+	executed bool
+}
+
+func (c *copyCommand) Validate() error {
+	if c.Overwrite && c.Backup {
+		return errors.New("--overwrite and --backup are exclusive")
+	}
+
+	return nil
+}
+
+func (c *copyCommand) Execute(args []string) error {
+	c.executed = true
+
+	return nil
+}
+
+type copyArgs struct {
+	Source string
+	Target string
+}
+
+func (c *copyArgs) Validate() error {
+	if c.Source != "" && c.Source == c.Target {
+		return errors.New("source and target are the same")
+	}
+
+	return nil
+}
+
+// TestGenerateValidaters checks that command, group and positionals structs
+// implementing flags.Validater are validated once parsed, before running.
+func TestGenerateValidaters(t *testing.T) {
+	t.Parallel()
+
+	type rootCommand struct {
+		Limits rangeOptions `group:"limits" namespace:"limits" persistent:"true"`
+		Copy   copyCommand  `command:"copy"`
+	}
+
+	test := assert.New(t)
+
+	data := &rootCommand{}
+	root := Generate(data)
+	root.SilenceUsage, root.SilenceErrors = true, true
+
+	root.SetArgs([]string{"copy", "--min", "1", "--max", "2", "a", "b"})
+	test.Nil(root.Execute())
+	test.True(data.Copy.executed)
+
+	for _, args := range [][]string{
+		{"copy", "--overwrite", "--backup", "a", "b"},
+		{"copy", "--min", "3", "--max", "2", "a", "b"},
+		{"copy", "a", "a"},
+		{"copy", "--limits.min", "3", "a", "b"},
+	} {
+		data = &rootCommand{}
+		root = Generate(data)
+		root.SilenceUsage, root.SilenceErrors = true, true
+
+		root.SetArgs(args)
+		test.NotNil(root.Execute(), "Validation should fail: %v", args)
+		test.False(data.Copy.executed, "Command should not run: %v", args)
+
+		_, err := ParseArgs(&rootCommand{}, args)
+		test.NotNil(err, "Validation should fail when parsing: %v", args)
+
+		_, err = Parse(&rootCommand{}, args)
+		test.NotNil(err, "Validation should fail without cobra: %v", args)
+	}
+}
+
+// contextCommand is a command running with the context of its execution.
+type contextCommand struct {
+	ctx  context.Context
+	args []string
+}
+
+func (c *contextCommand) ExecuteContext(ctx context.Context, args []string) error {
+	c.ctx, c.args = ctx, args
+
+	return ctx.Err()
+}
+
+// TestCommandContext checks that commands implementing flags.CommanderContext
+// run with the context given to the tree, and respect its cancellation.
+func TestCommandContext(t *testing.T) {
+	t.Parallel()
+
+	type ctxKey struct{}
+
+	type rootCommand struct {
+		Run contextCommand `command:"run"`
+	}
+
+	test := assert.New(t)
+
+	data := &rootCommand{}
+	root := Generate(data)
+	root.SilenceUsage, root.SilenceErrors = true, true
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	root.SetArgs([]string{"run", "a", "b"})
+	test.Nil(root.ExecuteContext(ctx))
+	test.Equal("value", data.Run.ctx.Value(ctxKey{}))
+	test.Equal([]string{"a", "b"}, data.Run.args)
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	data = &rootCommand{}
+	root = Generate(data)
+	root.SilenceUsage, root.SilenceErrors = true, true
+
+	root.SetArgs([]string{"run"})
+	test.ErrorIs(root.ExecuteContext(ctx), context.Canceled)
+}
+
+// hookedRoot is a parent command with persistent pre/post-runners.
+type hookedRoot struct {
+	calls []string
+
+	Sub hookedCommand `command:"sub"`
+}
+
+func (r *hookedRoot) PersistentPreRunE(args []string) error {
+	r.calls = append(r.calls, fmt.Sprintf("persistent-pre %v", args))
+
+	return nil
+}
+
+func (r *hookedRoot) PersistentPostRun(args []string) {
+	r.calls = append(r.calls, fmt.Sprintf("persistent-post %v", args))
+}
+
+// hookedCommand is a command with pre/post-runners, recording their calls in its parent.
+type hookedCommand struct {
+	calls *[]string
+}
+
+func (c *hookedCommand) PreRun(args []string) { *c.calls = append(*c.calls, "pre") }
+
+func (c *hookedCommand) Execute(args []string) error {
+	*c.calls = append(*c.calls, "execute")
+
+	return nil
+}
+
+func (c *hookedCommand) PostRunE(args []string) error {
+	*c.calls = append(*c.calls, "post")
+
+	return nil
+}
+
+// TestCommandHooks checks that pre/post-runners of commands, and
+// persistent ones of their parents, run around their execution.
+func TestCommandHooks(t *testing.T) {
+	t.Parallel()
+
+	data := &hookedRoot{}
+	data.Sub.calls = &data.calls
+
+	root := Generate(data)
+	root.SetArgs([]string{"sub", "a"})
+
+	assert.Nil(t, root.Execute())
+	assert.Equal(t, []string{"persistent-pre [a]", "pre", "execute", "post", "persistent-post [a]"}, data.calls)
+}
+
+// envRoot is a parent command with a persistent pre-runner reading its options.
+type envRoot struct {
+	Level string `long:"level" env:"FLAGS_TEST_LEVEL"`
+	level string
+
+	Sub testCommand `command:"sub"`
+}
+
+func (r *envRoot) PersistentPreRunE(args []string) error {
+	r.level = r.Level
+
+	return nil
+}
+
+// TestCommandHooksEnv checks that options are set from their environment
+// variables before the persistent pre-runners of the commands run.
+func TestCommandHooksEnv(t *testing.T) {
+	t.Parallel()
+
+	data := &envRoot{}
+
+	root := Generate(data, flags.WithEnviron([]string{"FLAGS_TEST_LEVEL=debug"}))
+	root.SetArgs([]string{"sub"})
+
+	assert.Nil(t, root.Execute())
+	assert.Equal(t, "debug", data.level, "persistent pre-runners should see env values")
+
+	root.SetArgs([]string{"--level", "info", "sub"})
+
+	assert.Nil(t, root.Execute())
+	assert.Equal(t, "info", data.level, "steps should run again for each execution")
+}
+
+// TestBind checks that commands and options are generated onto existing
+// cobra commands, which keep their own subcommands and implementations.
+func TestBind(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+
+	var ran []string
+
+	root := &cobra.Command{Use: "app", TraverseChildren: true}
+	legacy := &cobra.Command{
+		Use: "legacy",
+		Run: func(cmd *cobra.Command, args []string) { ran = append(ran, "legacy") },
+	}
+	root.AddCommand(legacy)
+
+	data := &struct {
+		Verbose bool `short:"v" long:"verbose" persistent:"true"`
+		Remote  struct {
+			Name string         `long:"name"`
+			Add  contextCommand `command:"add"`
+		} `command:"remote"`
+	}{}
+
+	test.NoError(Bind(root, data))
+	test.ErrorIs(Bind(root, struct{}{}), flags.ErrNotPointerToStruct)
+
+	root.SetArgs([]string{"legacy"})
+	test.NoError(root.Execute())
+	test.Equal([]string{"legacy"}, ran)
+
+	root.SetArgs([]string{"-v", "remote", "add", "a"})
+	test.NoError(root.Execute())
+	test.True(data.Verbose)
+	test.Equal([]string{"a"}, data.Remote.Add.args)
+
+	// Existing commands keep their names, and their own implementation.
+	other := &cobra.Command{Use: "other", RunE: func(*cobra.Command, []string) error { return nil }}
+	other.AddCommand(&cobra.Command{Use: "add"})
+
+	test.ErrorIs(Bind(other, &struct {
+		Add contextCommand `command:"add"`
+	}{}), ErrCommandExists)
+
+	sub := &cobra.Command{Use: "sub"}
+	other.AddCommand(sub)
+	test.NoError(Bind(sub, &struct {
+		Run contextCommand `command:"run"`
+	}{}))
+
+	other.SetArgs([]string{})
+	test.NoError(other.Execute())
+
+	// Commands bound below a root, and those added to them, use their own options.
+	serve := &struct {
+		testCommand
+		Port int `long:"port" env:"PORT"`
+	}{}
+
+	test.NoError(Bind(sub, &struct{}{}, flags.WithEnviron([]string{"PORT=8080"})))
+
+	_, err := AddCommand(sub, `command:"serve"`, serve)
+	test.NoError(err)
+
+	other.SetArgs([]string{"sub", "serve"})
+	test.NoError(other.Execute())
+	test.Equal(8080, serve.Port)
+}
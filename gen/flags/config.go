@@ -0,0 +1,583 @@
+package flags
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// configEntry is a single key/value(s) line found in a configuration file.
+type configEntry struct {
+	line    int
+	section []string
+	key     string
+	values  []string
+}
+
+// ParseConfigFile reads an INI or TOML configuration file, and sets the values it contains
+// on the flags of a command tree produced by Generate(). See ParseConfig for the format.
+func ParseConfigFile(cmd *cobra.Command, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("%w: %s", flags.ErrConfig, err.Error())
+	}
+	defer file.Close()
+
+	if err := ParseConfig(cmd, file); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+// ParseConfig reads an INI or TOML configuration and sets the values it contains on the
+// flags of a command tree produced by Generate(). This should be called before executing
+// the command, so that values found in the environment and on the command-line override
+// those of the file, like they do for default values (note that repeatable flags are
+// appended to, however).
+//
+// Keys before any section are options of the root command. Sections are the paths of
+// subcommands, with words separated by spaces or dots (eg. `[remote add]`), possibly
+// followed by the namespace of a group of options (eg. `[remote.add.server]`, with a
+// key `host` for the flag --server.host, --server-host or --server_host). Options in a
+// group without namespace can also be put in a section named after the group. Keys are
+// the long names of flags, and inherited (persistent) flags can be set in subsections.
+//
+// Both formats share the same subset: `#` or `;` comments, `key = value` lines, where
+// values can be quoted strings, or arrays (eg. `hosts = ["a", "b"]`) for repeatable
+// flags. Like on the command-line, INI keys can also be repeated for those flags.
+func ParseConfig(cmd *cobra.Command, reader io.Reader) error {
+	entries, err := parseConfig(reader)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		target, namespace := configCommand(cmd, entry.section)
+
+		words := append(append([]string{}, namespace...), strings.Split(entry.key, ".")...)
+
+		flag := configFlag(target, words)
+		if flag == nil {
+			return fmt.Errorf("%w: line %d: unknown option %q for command %q",
+				flags.ErrConfig, entry.line, strings.Join(words, "."), target.Name())
+		}
+
+		for _, value := range entry.values {
+			if err := flag.Value.Set(value); err != nil {
+				return fmt.Errorf("%w: line %d: invalid value for option %s: %s",
+					flags.ErrConfig, entry.line, flag.Name, err.Error())
+			}
+		}
+
+		setOrigin(flag, OriginConfig)
+	}
+
+	return nil
+}
+
+// parseConfig reads all the key/value(s) lines of a configuration, with their sections.
+func parseConfig(reader io.Reader) ([]configEntry, error) {
+	var (
+		entries []configEntry
+		section []string
+		line    int
+	)
+
+	scanner := bufio.NewScanner(reader)
+
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case text == "", strings.HasPrefix(text, "#"), strings.HasPrefix(text, ";"):
+			continue
+		case strings.HasPrefix(text, "["):
+			name := strings.TrimSpace(stripComment(text))
+			if !strings.HasSuffix(name, "]") {
+				return nil, fmt.Errorf("%w: line %d: invalid section %s", flags.ErrConfig, line, text)
+			}
+
+			name = strings.Trim(name, "[]")
+			section = strings.FieldsFunc(name, func(r rune) bool { return r == '.' || r == ' ' })
+
+			continue
+		}
+
+		key, value, found := strings.Cut(text, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("%w: line %d: expected key = value", flags.ErrConfig, line)
+		}
+
+		values, err := configValues(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", flags.ErrConfig, line, err.Error())
+		}
+
+		entries = append(entries, configEntry{
+			line:    line,
+			section: section,
+			key:     strings.Trim(strings.TrimSpace(key), `"`),
+			values:  values,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %s", flags.ErrConfig, err.Error())
+	}
+
+	return entries, nil
+}
+
+// configValues returns the value(s) of a key, unquoted and without comments.
+func configValues(value string) ([]string, error) {
+	if !strings.HasPrefix(value, "[") {
+		value, err := configValue(value)
+		return []string{value}, err
+	}
+
+	array := strings.TrimSpace(stripComment(value))
+	if !strings.HasSuffix(array, "]") {
+		return nil, fmt.Errorf("unterminated array %s", value)
+	}
+
+	var values []string
+
+	for _, item := range splitArray(array[1 : len(array)-1]) {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+
+		value, err := configValue(item)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// configValue returns a single value, unquoted if needed.
+func configValue(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, `"`):
+		end := closingQuote(value)
+		if end < 0 {
+			return "", fmt.Errorf("unterminated string %s", value)
+		}
+
+		return strconv.Unquote(value[:end+1])
+	case strings.HasPrefix(value, "'"):
+		end := strings.Index(value[1:], "'")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated string %s", value)
+		}
+
+		return value[1 : end+1], nil
+	default:
+		return strings.TrimSpace(stripComment(value)), nil
+	}
+}
+
+// closingQuote returns the index of the double quote closing a string, or -1.
+func closingQuote(value string) int {
+	for i := 1; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+
+	return -1
+}
+
+// splitArray splits the items of an array on the commas outside of quoted strings.
+func splitArray(array string) []string {
+	var (
+		items []string
+		start int
+		quote byte
+	)
+
+	for i := 0; i < len(array); i++ {
+		switch char := array[i]; {
+		case quote != 0 && char == '\\':
+			i++
+		case quote != 0 && char == quote:
+			quote = 0
+		case quote == 0 && (char == '"' || char == '\''):
+			quote = char
+		case quote == 0 && char == ',':
+			items = append(items, array[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(items, array[start:])
+}
+
+// stripComment removes an inline comment (preceded by a space) from an unquoted value.
+func stripComment(value string) string {
+	for _, comment := range []string{" #", " ;", "\t#", "\t;"} {
+		if i := strings.Index(value, comment); i >= 0 {
+			value = value[:i]
+		}
+	}
+
+	return value
+}
+
+// configCommand returns the command designated by the first words of a section,
+// and the remaining words of it, which are the namespace of its options.
+func configCommand(cmd *cobra.Command, section []string) (*cobra.Command, []string) {
+	for i, word := range section {
+		var subc *cobra.Command
+
+		for _, child := range cmd.Commands() {
+			if child.Name() == word || child.HasAlias(word) {
+				subc = child
+
+				break
+			}
+		}
+
+		if subc == nil {
+			return cmd, section[i:]
+		}
+
+		cmd = subc
+	}
+
+	return cmd, nil
+}
+
+// configFlag finds the flag named after some words joined with one of the usual
+// namespace delimiters, among a command's own flags and its inherited ones. The
+// last word alone is tried last, since group sections are not namespaces.
+func configFlag(cmd *cobra.Command, words []string) *pflag.Flag {
+	names := make([]string, 0, 4)
+
+	for _, delim := range []string{".", "-", "_"} {
+		names = append(names, strings.Join(words, delim))
+	}
+
+	names = append(names, words[len(words)-1])
+
+	for _, name := range names {
+		for parent := cmd; parent != nil; parent = parent.Parent() {
+			if parent == cmd {
+				if flag := cmd.Flags().Lookup(name); flag != nil {
+					return flag
+				}
+			}
+
+			if flag := parent.PersistentFlags().Lookup(name); flag != nil {
+				return flag
+			}
+		}
+	}
+
+	return nil
+}
+
+// Configuration formats written by WriteConfig.
+const (
+	ConfigINI  = "ini"
+	ConfigTOML = "toml"
+	ConfigYAML = "yaml"
+)
+
+// configSection holds the options of a command, to be written in a configuration.
+type configSection struct {
+	cmd     *flags.Command
+	options []configOption
+}
+
+// configOption is an option to be written in a configuration, with its group if any.
+type configOption struct {
+	group *flags.Group
+	flag  *flags.Flag
+}
+
+// WriteConfigFile writes the options found in a command struct to a configuration file,
+// whose format is given by its extension: `.toml`, `.yaml` or `.yml`, and INI otherwise.
+// See WriteConfig for the contents of the file.
+func WriteConfigFile(path string, data interface{}, opts ...flags.OptFunc) error {
+	format := ConfigINI
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		format = ConfigTOML
+	case ".yaml", ".yml":
+		format = ConfigYAML
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("%w: %s", flags.ErrConfig, err.Error())
+	}
+
+	if err := WriteConfig(file, data, format, opts...); err != nil {
+		file.Close()
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return file.Close()
+}
+
+// WriteConfig writes all the (non-hidden) options found in a command struct, with their
+// current values, in one of the ConfigINI, ConfigTOML or ConfigYAML formats. The options
+// of each command are written in a section named after its path, with their descriptions
+// and groups as comments. INI and TOML documents can be loaded back with ParseConfig().
+// This is meant for commands like `myapp config init`, writing a default configuration.
+func WriteConfig(writer io.Writer, data interface{}, format string, opts ...flags.OptFunc) error {
+	sections, err := configSections(data, opts)
+	if err != nil {
+		return err
+	}
+
+	buf := bufio.NewWriter(writer)
+
+	switch format {
+	case ConfigINI, ConfigTOML:
+		writeINI(buf, sections, format == ConfigTOML)
+	case ConfigYAML:
+		writeYAML(buf, sections)
+	default:
+		return fmt.Errorf("%w: unknown format %q", flags.ErrConfig, format)
+	}
+
+	return buf.Flush()
+}
+
+// configSections walks a command struct and returns the options of each
+// of its commands, with commands always coming before their subcommands.
+func configSections(data interface{}, opts []flags.OptFunc) ([]*configSection, error) {
+	var sections []*configSection
+
+	index := map[*flags.Command]*configSection{}
+
+	visitor := flags.VisitorFuncs{
+		Command: func(cmd *flags.Command) error {
+			index[cmd] = &configSection{cmd: cmd}
+			sections = append(sections, index[cmd])
+
+			return nil
+		},
+		Flag: func(cmd *flags.Command, grp *flags.Group, flag *flags.Flag) error {
+			if !flag.Hidden && flag.Name != "" {
+				index[cmd].options = append(index[cmd].options, configOption{group: grp, flag: flag})
+			}
+
+			return nil
+		},
+	}
+
+	if err := flags.Walk(data, visitor, opts...); err != nil {
+		return nil, err
+	}
+
+	return sections, nil
+}
+
+// writeINI writes the options of all commands in INI format, or in TOML format
+// (quoted strings and arrays, and dot-separated section names) if toml is true.
+func writeINI(buf *bufio.Writer, sections []*configSection, toml bool) {
+	comment, delim := "; ", " "
+	if toml {
+		comment, delim = "# ", "."
+	}
+
+	for _, section := range sections {
+		if len(section.options) == 0 {
+			continue
+		}
+
+		if section.cmd.Parent != nil {
+			buf.WriteString("\n")
+			writeComment(buf, "", comment, section.cmd.Description)
+			fmt.Fprintf(buf, "[%s]\n", strings.Join(section.cmd.Path, delim))
+		}
+
+		var group *flags.Group
+
+		for i, option := range section.options {
+			if option.group != group && option.group != nil {
+				if i > 0 {
+					buf.WriteString("\n")
+				}
+
+				writeComment(buf, "", comment, option.group.Name+" options")
+			}
+
+			group = option.group
+
+			writeComment(buf, "", comment, option.flag.Usage)
+
+			values, isList := optionValues(option.flag.Value)
+
+			switch {
+			case toml && isList:
+				fmt.Fprintf(buf, "%s = [%s]\n", option.flag.Name, strings.Join(quoteValues(option.flag, values), ", "))
+			case toml:
+				fmt.Fprintf(buf, "%s = %s\n", option.flag.Name, quoteValues(option.flag, values)[0])
+			case isList && len(values) == 0:
+				fmt.Fprintf(buf, "%s%s =\n", comment, option.flag.Name)
+			default:
+				for _, value := range values {
+					buf.WriteString(strings.TrimSpace(option.flag.Name+" = "+value) + "\n")
+				}
+			}
+		}
+	}
+}
+
+// writeYAML writes the options of all commands in YAML format, with
+// the options of subcommands nested under the names of their parents.
+func writeYAML(buf *bufio.Writer, sections []*configSection) {
+	var path []string
+
+	for _, section := range sections {
+		if len(section.options) == 0 {
+			continue
+		}
+
+		// Open the mappings of the commands we are not already in.
+		common := 0
+		for common < len(path) && common < len(section.cmd.Path) && path[common] == section.cmd.Path[common] {
+			common++
+		}
+
+		if common < len(section.cmd.Path) {
+			buf.WriteString("\n")
+		}
+
+		for depth := common; depth < len(section.cmd.Path); depth++ {
+			indent := strings.Repeat("  ", depth)
+
+			if depth == len(section.cmd.Path)-1 {
+				writeComment(buf, indent, "# ", section.cmd.Description)
+			}
+
+			fmt.Fprintf(buf, "%s%s:\n", indent, section.cmd.Path[depth])
+		}
+
+		path = section.cmd.Path
+		indent := strings.Repeat("  ", len(path))
+
+		var group *flags.Group
+
+		for _, option := range section.options {
+			if option.group != group && option.group != nil {
+				writeComment(buf, indent, "# ", option.group.Name+" options")
+			}
+
+			group = option.group
+
+			writeComment(buf, indent, "# ", option.flag.Usage)
+
+			values, isList := optionValues(option.flag.Value)
+			quoted := quoteValues(option.flag, values)
+
+			if isList {
+				fmt.Fprintf(buf, "%s%s: [%s]\n", indent, option.flag.Name, strings.Join(quoted, ", "))
+			} else {
+				fmt.Fprintf(buf, "%s%s: %s\n", indent, option.flag.Name, quoted[0])
+			}
+		}
+	}
+}
+
+// writeComment writes each line of a text as a comment, if not empty.
+func writeComment(buf *bufio.Writer, indent, comment, text string) {
+	if text == "" {
+		return
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		buf.WriteString(indent + comment + line + "\n")
+	}
+}
+
+// optionValues returns the current value(s) of an option, and true if it is a list or a map.
+func optionValues(val flags.Value) ([]string, bool) {
+	repeatable, isRepeatable := val.(flags.RepeatableFlag)
+	getter, isGetter := val.(flags.Getter)
+
+	if !isRepeatable || !repeatable.IsCumulative() || !isGetter {
+		return []string{val.String()}, false
+	}
+
+	value := reflect.ValueOf(getter.Get())
+
+	switch value.Kind() {
+	case reflect.Slice:
+		if value.Len() == 0 {
+			return nil, true
+		}
+
+		elems := make([]string, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elems = append(elems, elementText(value.Index(i)))
+		}
+
+		return elems, true
+	case reflect.Map:
+		entries := make([]string, 0, value.Len())
+
+		iter := value.MapRange()
+		for iter.Next() {
+			entries = append(entries, elementText(iter.Key())+":"+elementText(iter.Value()))
+		}
+
+		sort.Strings(entries)
+
+		return entries, true
+	default:
+		return []string{val.String()}, false
+	}
+}
+
+// elementText returns an element of a list or map option as text, formatted like the
+// values of its type (see flags.NewValue), so that it is parsed back as the same element.
+func elementText(elem reflect.Value) string {
+	ptr := reflect.New(elem.Type())
+	ptr.Elem().Set(elem)
+
+	if val, err := flags.NewValue(ptr.Interface()); err == nil {
+		return val.String()
+	}
+
+	return fmt.Sprint(elem.Interface())
+}
+
+// quoteValues quotes the values of an option, unless they are booleans or numbers.
+func quoteValues(flag *flags.Flag, values []string) []string {
+	switch strings.TrimSuffix(flag.Value.Type(), "Slice") {
+	case "bool", "count", "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return values
+	}
+
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+
+	return quoted
+}
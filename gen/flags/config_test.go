@@ -0,0 +1,164 @@
+package flags
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// configRoot is a command tree with options in
+// the various places a configuration can refer to.
+type configRoot struct {
+	Verbose bool `long:"verbose"`
+	Opts    struct {
+		Host string `long:"host"`
+		Port int    `long:"port"`
+	} `group:"server" namespace:"server" namespace-delimiter:"."`
+	Global struct {
+		Profile string `long:"profile"`
+	} `group:"global" persistent:"yes"`
+
+	Remote struct {
+		Add struct {
+			Hosts []string `long:"hosts"`
+			Name  string   `long:"name"`
+		} `command:"add" alias:"new"`
+	} `command:"remote"`
+}
+
+// TestParseConfig checks that values found in a configuration
+// are set on the options of the corresponding commands.
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+
+	config := `
+# Root options
+verbose = true
+server.port = 8080 # inline comment
+
+[server]
+host = "example.com"
+
+[remote add]
+hosts = ["a", "b"]
+name = 'origin'
+
+[remote.new.global]
+profile = dev
+`
+
+	data := &configRoot{}
+	root := Generate(data)
+
+	test := assert.New(t)
+	test.Nil(ParseConfig(root, strings.NewReader(config)), "Configuration should have been parsed")
+	test.True(data.Verbose)
+	test.Equal("example.com", data.Opts.Host)
+	test.Equal(8080, data.Opts.Port)
+	test.Equal([]string{"a", "b"}, data.Remote.Add.Hosts)
+	test.Equal("origin", data.Remote.Add.Name)
+	test.Equal("dev", data.Global.Profile)
+}
+
+// TestParseConfigEnv checks that environment variables override the values of
+// a configuration, and that the command-line overrides both of them.
+func TestParseConfigEnv(t *testing.T) {
+	t.Parallel()
+
+	data := &struct {
+		Host string `long:"host" env:"FLAGS_TEST_HOST"`
+		Port int    `long:"port" env:"FLAGS_TEST_PORT"`
+		Name string `long:"name"`
+	}{}
+
+	root := Generate(data, flags.WithEnviron([]string{"FLAGS_TEST_HOST=from-env", "FLAGS_TEST_PORT=2"}))
+	root.Run = func(*cobra.Command, []string) {}
+
+	test := assert.New(t)
+	test.Nil(ParseConfig(root, strings.NewReader("host = from-config\nport = 1\nname = from-config\n")))
+
+	root.SetArgs([]string{"--port", "3"})
+	test.Nil(root.Execute())
+
+	test.Equal("from-env", data.Host, "env values should override config ones")
+	test.Equal(OriginEnv, FlagOrigin(root, "host"))
+	test.Equal(3, data.Port, "command-line values should override env ones")
+	test.Equal(OriginCLI, FlagOrigin(root, "port"))
+	test.Equal("from-config", data.Name)
+	test.Equal(OriginConfig, FlagOrigin(root, "name"))
+}
+
+// TestParseConfigFail checks that malformed configurations,
+// or those with unknown options, are reported with their line.
+func TestParseConfigFail(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+
+	err := ParseConfig(Generate(&configRoot{}), strings.NewReader("[remote]\nname = origin"))
+	test.ErrorIs(err, flags.ErrConfig)
+	test.Contains(err.Error(), "line 2")
+
+	err = ParseConfig(Generate(&configRoot{}), strings.NewReader("port = http"))
+	test.ErrorIs(err, flags.ErrConfig)
+
+	err = ParseConfig(Generate(&configRoot{}), strings.NewReader("[remote\nname = origin"))
+	test.ErrorIs(err, flags.ErrConfig)
+}
+
+// TestParseConfigFile checks that command-line words override configuration files.
+func TestParseConfigFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	err := os.WriteFile(path, []byte("[remote.add]\nname = \"origin\"\n"), 0o600)
+	assert.Nil(t, err)
+
+	data := &configRoot{}
+	root := newCommandWithArgs(data, []string{"remote", "add", "--name", "upstream"})
+
+	test := assert.New(t)
+	test.Nil(ParseConfigFile(root, path), "Configuration file should have been parsed")
+	test.Equal("origin", data.Remote.Add.Name)
+
+	test.Nil(root.Execute(), "Command should have been executed")
+	test.Equal("upstream", data.Remote.Add.Name)
+}
+
+// TestWriteConfig checks that configurations written from a
+// command struct can be loaded back into another instance.
+func TestWriteConfig(t *testing.T) {
+	t.Parallel()
+
+	data := &configRoot{}
+	data.Verbose = true
+	data.Opts.Host = "example.com"
+	data.Opts.Port = 8080
+	data.Remote.Add.Hosts = []string{"a", "b"}
+
+	test := assert.New(t)
+
+	for _, format := range []string{ConfigINI, ConfigTOML} {
+		var config strings.Builder
+		test.Nil(WriteConfig(&config, data, format), "Configuration should have been written")
+
+		loaded := &configRoot{}
+		test.Nil(ParseConfig(Generate(loaded), strings.NewReader(config.String())), config.String())
+		test.Equal(data, loaded, "Configuration should have been loaded back")
+	}
+
+	// Elements are written as they are, even when containing commas.
+	data.Remote.Add.Hosts = []string{"a", "b,c"}
+
+	var config strings.Builder
+	test.Nil(WriteConfig(&config, data, ConfigYAML))
+	test.Contains(config.String(), "server.port: 8080\n")
+	test.Contains(config.String(), "remote:\n  add:\n    hosts: [\"a\", \"b,c\"]\n")
+
+	test.ErrorIs(WriteConfig(&config, data, "xml"), flags.ErrConfig)
+}
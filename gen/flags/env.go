@@ -0,0 +1,267 @@
+package flags
+
+import (
+	"sync"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// envDelimAnnotation stores, on options set from their environment variable,
+// the delimiter splitting its value into several ones (`env-delim` tag).
+const envDelimAnnotation = "flags-env-delim"
+
+// envOptions holds the options only set from their environment variables (tagged with
+// `env` and `no-flag`) found while generating commands, until bound to them by envFlags.
+var envOptions = struct {
+	sync.Mutex
+	options map[*cobra.Command][]*flags.Flag
+}{
+	options: map[*cobra.Command][]*flags.Flag{},
+}
+
+// addEnvOptions registers the options of a list only set from their
+// environment variables, to be set when the command (or a subcommand) runs.
+func addEnvOptions(cmd *cobra.Command, src []*flags.Flag) {
+	envOptions.Lock()
+	defer envOptions.Unlock()
+
+	envOptions.options[cmd] = append(envOptions.options[cmd], envOnly(src)...)
+}
+
+// envOnly returns the options of a list only set from their environment variables.
+func envOnly(src []*flags.Flag) []*flags.Flag {
+	var options []*flags.Flag
+
+	for _, srcFlag := range src {
+		if srcFlag.EnvOnly {
+			options = append(options, srcFlag)
+		}
+	}
+
+	return options
+}
+
+// envFlags makes the commands of a tree set their options, and those of their parents
+// (which might be given before the command name on the command-line), from their environment
+// variables once their command-line is parsed, before their pre-runners. Options given on the
+// command-line, or already set by other means (eg. by a Resolver bound to the tree), are not,
+// but environment variables override the values read from configurations by ParseConfig.
+func envFlags(cmd *cobra.Command, opts []flags.OptFunc) {
+	envOptions.Lock()
+	defer envOptions.Unlock()
+
+	bindEnv(cmd, nil, opts)
+}
+
+// bindEnv binds the options only set from the environment of a command and of its
+// subcommands, to which those of their parents are passed. They are not kept once bound.
+func bindEnv(cmd *cobra.Command, inherited []*flags.Flag, opts []flags.OptFunc) {
+	options := append(inherited[:len(inherited):len(inherited)], envOptions.options[cmd]...)
+	delete(envOptions.options, cmd)
+
+	for _, subc := range cmd.Commands() {
+		bindEnv(subc, options, opts)
+	}
+
+	preRun(cmd, func(cmd *cobra.Command, _ []string) error {
+		flagSets := []*pflag.FlagSet{cmd.Flags()}
+		for parent := cmd.Parent(); parent != nil; parent = parent.Parent() {
+			flagSets = append(flagSets, parent.Flags())
+		}
+
+		return commandErrors(cmd, setEnv(flagSets, options, opts))
+	})
+}
+
+// SetEnv sets the options of a flag set filled by ParseToFlagSet (or ParseFlags) from their
+// environment variables, once its command-line is parsed: like generated commands do, options
+// given on the command-line are left as they are. Options only set from the environment have
+// no flag, and are not set: they are only supported by generated commands and parsers.
+func SetEnv(flagSet *pflag.FlagSet, optFuncs ...flags.OptFunc) error {
+	return setEnv([]*pflag.FlagSet{flagSet}, nil, optFuncs)
+}
+
+// setEnv sets the options of some flag sets not given on the command-line, nor already set
+// by other means than configurations, from their environment variables (if set), and the
+// options only set from the environment. Options found in several sets (eg. inherited ones)
+// are only set once.
+func setEnv(flagSets []*pflag.FlagSet, options []*flags.Flag, opts []flags.OptFunc) error {
+	var err error
+
+	visited := make(map[string]bool)
+
+	for _, flagSet := range flagSets {
+		flagSet.VisitAll(func(flag *pflag.Flag) {
+			if err != nil || visited[flag.Name] {
+				return
+			}
+
+			visited[flag.Name] = true
+
+			// Values read from configurations with ParseConfig are overridden.
+			origin := valueOrigin(flag)
+			if isFlagSet(flagSet, flag) || (origin != OriginDefault && origin != OriginConfig) {
+				return
+			}
+
+			var isSet bool
+			if isSet, err = setFlagEnv(flag, opts); isSet {
+				setOrigin(flag, OriginEnv)
+			}
+		})
+	}
+
+	for _, option := range options {
+		if err != nil {
+			break
+		}
+
+		_, err = option.SetEnv(opts...)
+	}
+
+	return err
+}
+
+// setFlagEnv sets an option from its environment variable, if it has one and if it is set,
+// and returns true if so: the values of repeatable options replace their default ones.
+func setFlagEnv(flag *pflag.Flag, opts []flags.OptFunc) (bool, error) {
+	env := flag.Annotations["env"]
+	if len(env) == 0 {
+		return false, nil
+	}
+
+	var delim string
+	if annotation := flag.Annotations[envDelimAnnotation]; len(annotation) > 0 {
+		delim = annotation[0]
+	}
+
+	values, found := flags.EnvValues(env[0], delim, opts...)
+
+	for _, value := range values {
+		if err := flag.Value.Set(value); err != nil {
+			return true, optionError(flags.ErrInvalidValue, flag.Name, "",
+				"invalid value %q for --%s from env %s: %s", value, flag.Name, env[0], err.Error())
+		}
+	}
+
+	return found, nil
+}
+
+// preRunSteps are the steps run by commands once their command-line is parsed (see preRun).
+var preRunSteps sync.Map
+
+// stepChain holds the steps of a command, and whether they already ran
+// before persistent pre-runners during the current execution of the command.
+type stepChain struct {
+	run func(cmd *cobra.Command, args []string) error
+	ran bool
+}
+
+// preRun makes a command run a step once its command-line is parsed, before its pre-runners
+// (PreRunE, or PreRun), and before the persistent ones bound by this package (those of the
+// command or of its parents), so that they all see the same options: steps added later run
+// first, and Resolver.Bind adds the outermost.
+func preRun(cmd *cobra.Command, step func(cmd *cobra.Command, args []string) error) {
+	chain, loaded := preRunSteps.LoadOrStore(cmd, &stepChain{})
+	steps := chain.(*stepChain)
+
+	next := steps.run
+	steps.run = func(cmd *cobra.Command, args []string) error {
+		if err := step(cmd, args); err != nil {
+			return renderError(cmd, err)
+		}
+
+		if next != nil {
+			return next(cmd, args)
+		}
+
+		return nil
+	}
+
+	if loaded {
+		return
+	}
+
+	preRunE, preRun := cmd.PreRunE, cmd.PreRun
+
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := runSteps(cmd, args, false); err != nil {
+			return err
+		}
+
+		if preRunE != nil {
+			return preRunE(cmd, args)
+		}
+
+		if preRun != nil {
+			preRun(cmd, args)
+		}
+
+		return nil
+	}
+}
+
+// runSteps runs the steps of a command, unless they already ran during its execution:
+// the first persistent pre-runner run for it runs them, and its own pre-runners otherwise.
+func runSteps(cmd *cobra.Command, args []string, persistent bool) error {
+	chain, found := preRunSteps.Load(cmd)
+	if !found {
+		return nil
+	}
+
+	steps := chain.(*stepChain)
+
+	if steps.ran {
+		steps.ran = persistent
+
+		return nil
+	}
+
+	steps.ran = persistent
+
+	if err := steps.run(cmd, args); err != nil {
+		steps.ran = false
+
+		return err
+	}
+
+	return nil
+}
+
+// persistentSteps makes the persistent pre-runners of a command run the steps
+// of the command executed (itself, or one of its subcommands) before them.
+func persistentSteps(cmd *cobra.Command) {
+	switch preRunE, preRun := cmd.PersistentPreRunE, cmd.PersistentPreRun; {
+	case preRunE != nil:
+		cmd.PersistentPreRunE = func(c *cobra.Command, args []string) error {
+			if err := runSteps(c, args, true); err != nil {
+				return err
+			}
+
+			return forgetSteps(c, preRunE(c, args))
+		}
+	case preRun != nil:
+		cmd.PersistentPreRun = nil
+		cmd.PersistentPreRunE = func(c *cobra.Command, args []string) error {
+			if err := runSteps(c, args, true); err != nil {
+				return err
+			}
+
+			preRun(c, args)
+
+			return nil
+		}
+	}
+}
+
+// forgetSteps makes the steps of a command run again on its next execution if a
+// persistent pre-runner failed, since its own pre-runners do not run then.
+func forgetSteps(cmd *cobra.Command, err error) error {
+	if chain, found := preRunSteps.Load(cmd); found && err != nil {
+		chain.(*stepChain).ran = false
+	}
+
+	return err
+}
@@ -0,0 +1,147 @@
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/positional"
+	"github.com/spf13/pflag"
+)
+
+// flagErrors returns the structured error of an option whose value has been rejected
+// while parsing the flags of a command, or of an unknown flag, or the error as is.
+func flagErrors(cmd interface{ CommandPath() string }, flagSet *pflag.FlagSet, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var typed *flags.Error
+
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		valueErr := flags.ValueError(flag.Value)
+		if typed != nil || valueErr == nil {
+			return
+		}
+
+		// pflag only keeps the message of the value error in its own.
+		name := "--" + flag.Name
+		if flag.Shorthand != "" && flag.ShorthandDeprecated == "" {
+			name = fmt.Sprintf("-%s, --%s", flag.Shorthand, flag.Name)
+		}
+
+		if err.Error() != fmt.Sprintf("invalid argument %q for %q flag: %v", valueErr.Value, name, valueErr.Err) {
+			return
+		}
+
+		typed = &flags.Error{
+			Kind:    valueErr.Kind,
+			Command: cmd.CommandPath(),
+			Flag:    valueErr.Flag,
+			Value:   valueErr.Value,
+			Err:     fmt.Errorf("invalid argument %q for %q flag: %w", valueErr.Value, name, valueErr.Err),
+		}
+	})
+
+	if typed != nil {
+		return typed
+	}
+
+	if name, unknown := unknownFlag(err); unknown {
+		return &flags.Error{
+			Kind:    flags.ErrUnknownFlag,
+			Command: cmd.CommandPath(),
+			Flag:    name,
+			Err:     err,
+		}
+	}
+
+	return err
+}
+
+// unknownFlag returns the name of the flag that pflag reports as unknown in an error, if any.
+func unknownFlag(err error) (string, bool) {
+	msg := err.Error()
+
+	if strings.HasPrefix(msg, "unknown flag: --") {
+		return strings.TrimPrefix(msg, "unknown flag: --"), true
+	}
+
+	if strings.HasPrefix(msg, "unknown shorthand flag: ") {
+		quoted, _, _ := strings.Cut(strings.TrimPrefix(msg, "unknown shorthand flag: "), " in -")
+		short, _ := strconv.Unquote(quoted)
+
+		return short, true
+	}
+
+	return "", false
+}
+
+// argErrors returns the structured error of a positional argument of a command.
+func argErrors(cmd interface{ CommandPath() string }, err error) error {
+	var argErr *positional.ArgError
+	if !errors.As(err, &argErr) {
+		return err
+	}
+
+	kind := flags.ErrInvalidValue
+	if errors.Is(err, positional.ErrRequired) {
+		kind = flags.ErrRequiredArgument
+	}
+
+	return &flags.Error{
+		Kind:       kind,
+		Command:    cmd.CommandPath(),
+		Positional: argErr.Arg,
+		Value:      argErr.Word,
+		Err:        err,
+	}
+}
+
+// optionError returns the structured error of an option, or of a group of options.
+func optionError(kind error, flag, group, format string, args ...interface{}) error {
+	return &flags.Error{
+		Kind:  kind,
+		Flag:  flag,
+		Group: group,
+		Err:   fmt.Errorf("%w: "+format, append([]interface{}{kind}, args...)...),
+	}
+}
+
+// commandErrors sets the command path of a structured error, if it is one.
+func commandErrors(cmd interface{ CommandPath() string }, err error) error {
+	var typed *flags.Error
+	if errors.As(err, &typed) && typed.Command == "" {
+		typed.Command = cmd.CommandPath()
+	}
+
+	return err
+}
+
+// parseErrors returns the error of the flags of a command parsed without cobra,
+// prefixed with flags.ErrParse, which it matches along with its underlying errors.
+func parseErrors(cmd interface{ CommandPath() string }, flagSet *pflag.FlagSet, err error) error {
+	err = flagErrors(cmd, flagSet, err)
+
+	var typed *flags.Error
+	if errors.As(err, &typed) {
+		typed.Err = &parseError{typed.Err}
+
+		return typed
+	}
+
+	return fmt.Errorf("%w: %s", flags.ErrParse, err.Error())
+}
+
+// parseError is an error of the cobra-free parser, matching flags.ErrParse.
+type parseError struct {
+	err error
+}
+
+func (e *parseError) Error() string { return flags.ErrParse.Error() + ": " + e.err.Error() }
+
+func (e *parseError) Unwrap() error { return e.err }
+
+func (e *parseError) Is(target error) bool { return target == flags.ErrParse }
@@ -2,10 +2,15 @@ package flags
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/tag"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
@@ -13,14 +18,26 @@ import (
 // that's implemented by pflag library and required by flags.
 type flagSet interface {
 	VarPF(value pflag.Value, name, shorthand, usage string) *pflag.Flag
+	Lookup(name string) *pflag.Flag
+	ShorthandLookup(name string) *pflag.Flag
 }
 
 var _ flagSet = (*pflag.FlagSet)(nil)
 
 // GenerateTo takes a list of sflag.Flag,
 // that are parsed from some config structure, and put it to dst.
-func generateTo(src []*flags.Flag, dst flagSet) {
+// Notices about previous option names are written to output.
+func generateTo(src []*flags.Flag, dst flagSet, output func() io.Writer) error {
 	for _, srcFlag := range src {
+		// Options only set from the environment have no flag.
+		if srcFlag.EnvOnly {
+			continue
+		}
+
+		if err := checkDuplicate(dst, srcFlag.Name, srcFlag.Short); err != nil {
+			return err
+		}
+
 		flag := dst.VarPF(srcFlag.Value, srcFlag.Name, srcFlag.Short, srcFlag.Usage)
 
 		// Annotations used for things like completions
@@ -42,8 +59,12 @@ func generateTo(src []*flags.Flag, dst flagSet) {
 		flag.Hidden = srcFlag.Hidden
 
 		if srcFlag.Deprecated {
-			// we use Usage as Deprecated message for a pflag
-			flag.Deprecated = srcFlag.Usage
+			// we use Usage as Deprecated message for a pflag,
+			// unless the deprecated tag gives its own message.
+			flag.Deprecated = srcFlag.Deprecation
+			if flag.Deprecated == "" {
+				flag.Deprecated = srcFlag.Usage
+			}
 			if flag.Deprecated == "" {
 				flag.Deprecated = "Deprecated"
 			}
@@ -51,45 +72,622 @@ func generateTo(src []*flags.Flag, dst flagSet) {
 
 		// Register annotations to be used by clients and completers
 		flag.Annotations["flags"] = annots
+
+		if srcFlag.Env {
+			flag.Annotations["env"] = []string{srcFlag.EnvName}
+		}
+
+		if srcFlag.EnvDelim != "" {
+			flag.Annotations[envDelimAnnotation] = []string{srcFlag.EnvDelim}
+		}
+
+		if srcFlag.Source != "" {
+			flag.Annotations[sourceAnnotation] = []string{srcFlag.Source}
+		}
+
+		if srcFlag.DefaultFrom != "" {
+			flag.Annotations["default-from"] = []string{srcFlag.DefaultFrom}
+		}
+
+		if len(srcFlag.Choices) > 0 {
+			flag.Annotations[choicesAnnotation] = srcFlag.Choices
+		}
+
+		if srcFlag.ShortOnly {
+			flag.Annotations[shortOnlyAnnotation] = []string{"true"}
+		}
+
+		if srcFlag.Interpolate {
+			flag.Annotations[interpolateAnnotation] = []string{"true"}
+		}
+
+		// Relations with other options are checked once parsed.
+		setRelations(srcFlag, flag)
+
+		if err := generateAliases(srcFlag, flag, dst, output); err != nil {
+			return err
+		}
+
+		if err := generateNegation(srcFlag, flag, dst); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
+// checkDuplicate returns an error if a flag set already has a flag
+// with the same name, or the same short name if it is not empty.
+func checkDuplicate(dst flagSet, name, short string) error {
+	if dst.Lookup(name) != nil {
+		return fmt.Errorf("%w: --%s", flags.ErrDuplicatedFlag, name)
+	}
+
+	if short != "" && dst.ShorthandLookup(short) != nil {
+		return fmt.Errorf("%w: -%s (--%s and --%s)", flags.ErrDuplicatedFlag,
+			short, dst.ShorthandLookup(short).Name, name)
+	}
+
+	return nil
+}
+
+// checkDuplicates returns an error if a flag of a set has the
+// same name or short name as a flag of any of the other sets.
+func checkDuplicates(src *pflag.FlagSet, dsts ...*pflag.FlagSet) error {
+	var err error
+
+	src.VisitAll(func(flag *pflag.Flag) {
+		for _, dst := range dsts {
+			if err == nil {
+				err = checkDuplicate(dst, flag.Name, flag.Shorthand)
+			}
+		}
+	})
+
+	return err
+}
+
+// aliasAnnotation stores, on the flags of the other long names of an
+// option (aliases and previous names), the name of the option itself.
+const aliasAnnotation = "flags-alias-of"
+
+// generateAliases adds flags for the other long names of an option, which set the
+// same value: its aliases, only shown in help usages if requested, and its previous
+// names, always hidden, and warning (only once, to output) that the option has been renamed.
+func generateAliases(srcFlag *flags.Flag, flag *pflag.Flag, dst flagSet, output func() io.Writer) error {
+	for _, name := range srcFlag.Aliases {
+		if err := generateAlias(flag, name, flag.Value, !srcFlag.ShowAliases, dst); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range srcFlag.RenamedFrom {
+		renamed := &renamedValue{
+			Value:  flag.Value,
+			name:   name,
+			target: flag.Name,
+			output: output,
+		}
+
+		if err := generateAlias(flag, name, renamed, true, dst); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateAlias adds a flag for another long name of an option.
+func generateAlias(flag *pflag.Flag, name string, value pflag.Value, hidden bool, dst flagSet) error {
+	if err := checkDuplicate(dst, name, ""); err != nil {
+		return err
+	}
+
+	alias := dst.VarPF(value, name, "", flag.Usage)
+	alias.NoOptDefVal = flag.NoOptDefVal
+	alias.Hidden = hidden
+	alias.Annotations = map[string][]string{aliasAnnotation: {flag.Name}}
+
+	return nil
+}
+
+// generateNegation adds the negative flag of a negatable option, setting it to false.
+// This flag is hidden, since help usages show it along with the option itself.
+func generateNegation(srcFlag *flags.Flag, flag *pflag.Flag, dst flagSet) error {
+	if srcFlag.Negation == "" {
+		return nil
+	}
+
+	if err := generateAlias(flag, srcFlag.Negation, &negatedValue{flag.Value}, true, dst); err != nil {
+		return err
+	}
+
+	flag.Annotations[negationAnnotation] = []string{srcFlag.Negation}
+
+	return nil
+}
+
+// negatedValue is the value of the negative flag of a boolean option.
+type negatedValue struct {
+	pflag.Value
+}
+
+func (v *negatedValue) Set(value string) error {
+	negated, err := strconv.ParseBool(value)
+	if err != nil {
+		return err
+	}
+
+	return v.Value.Set(strconv.FormatBool(!negated))
+}
+
+// renamedValue is the value of the flag of a previous option name.
+type renamedValue struct {
+	pflag.Value
+	name   string
+	target string
+	output func() io.Writer
+	warned bool
+}
+
+func (v *renamedValue) Set(value string) error {
+	if !v.warned {
+		fmt.Fprintf(v.output(), "Flag --%s has been renamed, use --%s instead\n", v.name, v.target)
+		v.warned = true
+	}
+
+	return v.Value.Set(value)
+}
+
+// Reset warns again of the previous option name on the next command-line.
+func (v *renamedValue) Reset() { v.warned = false }
+
+// stderr returns the standard error, to which flag sets without
+// a command write the notices about previous option names.
+func stderr() io.Writer { return os.Stderr }
+
 // Parse parses cfg, that is a pointer to some structure, puts it to the new
 // pflag.FlagSet and returns it.
 //
 // This is generally not needed if you intend to generate a directly working CLI:
 // This function is used for generating things like completions for flags, etc.
 func ParseFlags(cfg interface{}, optFuncs ...flags.OptFunc) (*pflag.FlagSet, error) {
+	flagSet, _, err := parseFlags(cfg, optFuncs...)
+
+	return flagSet, err
+}
+
+// parseFlags is like ParseFlags, but also returns the options of
+// cfg only set from their environment variables, which have no flag.
+func parseFlags(cfg interface{}, optFuncs ...flags.OptFunc) (*pflag.FlagSet, []*flags.Flag, error) {
 	flagSet := pflag.NewFlagSet(os.Args[0], pflag.ExitOnError)
 
-	err := parseTo(cfg, flagSet, optFuncs...)
+	options, err := parseTo(cfg, flagSet, optFuncs...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return flagSet, nil
+	return flagSet, options, nil
 }
 
-// parseTo parses cfg, that is a pointer to some structure,
-// and puts it to dst.
-func parseTo(cfg interface{}, dst flagSet, optFuncs ...flags.OptFunc) error {
-	flagSet, err := flags.ParseStruct(cfg, optFuncs...)
+// Bootstrap tolerantly parses the args for the options declared in data, ignoring any
+// other word, unknown flag or command found in them. This allows applications to
+// extract a small set of early options (eg. --config or --profile) before loading
+// their configuration and generating their full command tree with Generate().
+// Options not found in the args are set from their environment variables, if any.
+func Bootstrap(args []string, data interface{}, optFuncs ...flags.OptFunc) error {
+	flagSet := pflag.NewFlagSet(os.Args[0], pflag.ContinueOnError)
+	flagSet.ParseErrorsWhitelist.UnknownFlags = true
+	flagSet.SetOutput(io.Discard)
+
+	options, err := parseTo(data, flagSet, optFuncs...)
 	if err != nil {
+		return err
+	}
+
+	// Help flags are not ours to handle at this stage, but would
+	// otherwise stop the parsing as soon as they are encountered.
+	var help bool
+
+	if flagSet.Lookup("help") == nil {
+		flagSet.BoolVar(&help, "help", false, "")
+	}
+
+	if flagSet.ShorthandLookup("h") == nil {
+		flagSet.BoolVarP(&help, "help-bootstrap", "h", false, "")
+	}
+
+	if err := flagSet.Parse(args); err != nil {
 		return fmt.Errorf("%w: %s", flags.ErrParse, err.Error())
 	}
 
-	generateTo(flagSet, dst)
+	return setEnv([]*pflag.FlagSet{flagSet}, options, optFuncs)
+}
 
-	return nil
+// parseTo parses cfg, that is a pointer to some structure, and puts it
+// to dst, returning its options only set from environment variables.
+func parseTo(cfg interface{}, dst flagSet, optFuncs ...flags.OptFunc) ([]*flags.Flag, error) {
+	flagSet, err := flags.ParseStruct(cfg, optFuncs...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", flags.ErrParse, err.Error())
+	}
+
+	return envOnly(flagSet), generateTo(flagSet, dst, stderr)
 }
 
 // ParseToDef parses cfg, that is a pointer to some structure and
 // puts it to the default pflag.CommandLine.
 func parseToDef(cfg interface{}, optFuncs ...flags.OptFunc) error {
-	err := parseTo(cfg, pflag.CommandLine, optFuncs...)
+	_, err := parseTo(cfg, pflag.CommandLine, optFuncs...)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// toggleFlags finds the words made of a `+` followed by the short names of boolean flags
+// (eg. `+x` or `+xv`), sets these flags to false and removes the words from the list.
+// Words found after a double dash are left untouched: the dash index, as given by
+// cobra.Command.ArgsLenAtDash(), is returned updated for the remaining words.
+func toggleFlags(flagSet *pflag.FlagSet, args []string, dash int) ([]string, int, error) {
+	words := make([]string, 0, len(args))
+	wordsDash := dash
+
+	for i, arg := range args {
+		if (dash >= 0 && i >= dash) || !isToggle(flagSet, arg) {
+			words = append(words, arg)
+
+			continue
+		}
+
+		for _, short := range arg[1:] {
+			flag := flagSet.ShorthandLookup(string(short))
+			if err := flagSet.Set(flag.Name, "false"); err != nil {
+				return args, dash, fmt.Errorf("%w: invalid toggle %s: %s", flags.ErrParse, arg, err.Error())
+			}
+		}
+
+		if dash > i {
+			wordsDash--
+		}
+	}
+
+	return words, wordsDash, nil
+}
+
+// isToggle returns true if the word is made of a `+` followed only by short names of boolean flags.
+func isToggle(flagSet *pflag.FlagSet, word string) bool {
+	return flags.IsToggle(word, func(short string) bool {
+		flag := flagSet.ShorthandLookup(short)
+
+		return flag != nil && flag.Value.Type() == "bool"
+	})
+}
+
+// unknownFlags finds the field of a command struct tagged as collecting its unknown flags,
+// and if the corresponding option is set, takes over the parsing of the command flags,
+// so that unknown `--key value` flags are stored in this map instead of being errors.
+func unknownFlags(cmd *cobra.Command, data interface{}, opts []flags.OptFunc) error {
+	if data == nil || !flags.ScanOptions(opts...).CollectUnknownFlags {
+		return nil
+	}
+
+	collected, err := taggedField(data, "unknown", reflect.TypeOf(map[string]string{}))
+	if err != nil || !collected.IsValid() {
+		return err
+	}
+
+	// Cobra would otherwise fail on the first unknown flag.
+	cmd.DisableFlagParsing = true
+	next := cmd.Args
+
+	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		cmd.InitDefaultHelpFlag() // Also merges persistent flags
+
+		known, unknown := splitUnknownFlags(cmd.Flags(), args)
+		if err := cmd.Flags().Parse(known); err != nil {
+			return cmd.FlagErrorFunc()(cmd, err)
+		}
+
+		if collected.IsNil() {
+			collected.Set(reflect.MakeMap(collected.Type()))
+		}
+
+		for key, value := range unknown {
+			collected.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(value))
+		}
+
+		if help, _ := cmd.Flags().GetBool("help"); help {
+			return pflag.ErrHelp
+		}
+
+		if next == nil {
+			return nil
+		}
+
+		return next(cmd, cmd.Flags().Args())
+	}
+
+	return nil
+}
+
+// argvField finds the field of a command struct tagged as receiving the raw arguments
+// of the command, and if found, takes over the parsing of the command flags (unless it
+// is already handled by another step) so that these arguments are captured untouched.
+func argvField(cmd *cobra.Command, data interface{}) error {
+	if data == nil {
+		return nil
+	}
+
+	argv, err := taggedField(data, "argv", reflect.TypeOf([]string{}))
+	if err != nil || !argv.IsValid() {
+		return err
+	}
+
+	parsed := !cmd.DisableFlagParsing
+	cmd.DisableFlagParsing = true
+	next := cmd.Args
+
+	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		argv.Set(reflect.ValueOf(append([]string{}, args...)))
+
+		if parsed {
+			cmd.InitDefaultHelpFlag() // Also merges persistent flags
+
+			if err := cmd.Flags().Parse(args); err != nil {
+				return cmd.FlagErrorFunc()(cmd, err)
+			}
+
+			if help, _ := cmd.Flags().GetBool("help"); help {
+				return pflag.ErrHelp
+			}
+
+			args = cmd.Flags().Args()
+		}
+
+		if next == nil {
+			return nil
+		}
+
+		return next(cmd, args)
+	}
+
+	return nil
+}
+
+// taggedField returns the field of a command struct marked with a tag, which
+// must be of the given type, or an invalid value if there is no such field.
+func taggedField(data interface{}, name string, typ reflect.Type) (reflect.Value, error) {
+	val := reflect.Indirect(reflect.ValueOf(data))
+	if val.Kind() != reflect.Struct {
+		return reflect.Value{}, nil
+	}
+
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+
+		mtag, _, err := tag.GetFieldTag(field)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %s", tag.ErrTag, err.Error())
+		}
+
+		if _, isSet := mtag.Get(name); !isSet {
+			continue
+		}
+
+		if field.Type != typ {
+			return reflect.Value{}, fmt.Errorf("%w: field %s tagged `%s` must be a %s",
+				flags.ErrInvalidTag, field.Name, name, typ)
+		}
+
+		return val.Field(i), nil
+	}
+
+	return reflect.Value{}, nil
+}
+
+// splitUnknownFlags separates the unknown flags (and their values) found in the words of
+// a command-line from the other words, which are left for the flag set to parse. Values
+// of unknown flags are either given with `=`, or are the next word if it is not a flag.
+func splitUnknownFlags(flagSet *pflag.FlagSet, args []string) ([]string, map[string]string) {
+	known := make([]string, 0, len(args))
+	unknown := map[string]string{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if arg == "--" {
+			known = append(known, args[i:]...)
+
+			break
+		}
+
+		if len(arg) < 2 || arg[0] != '-' {
+			known = append(known, arg)
+
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+
+		// Known flags keep their values, whether in the same word or the next one.
+		if takesValue, isKnown := lookupFlagWord(flagSet, arg, name); isKnown {
+			known = append(known, arg)
+
+			if takesValue && !hasValue && i+1 < len(args) {
+				known = append(known, args[i+1])
+				i++
+			}
+
+			continue
+		}
+
+		if !hasValue && i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+			value = args[i+1]
+			i++
+		}
+
+		unknown[name] = value
+	}
+
+	return known, unknown
+}
+
+// lookupFlagWord returns true if a flag word (long or shorthands) is known to the flag set,
+// and whether its last flag takes its value from the next word, if not given in this one.
+func lookupFlagWord(flagSet *pflag.FlagSet, arg, name string) (takesValue, isKnown bool) {
+	if strings.HasPrefix(arg, "--") {
+		flag := flagSet.Lookup(name)
+
+		return flag != nil && flag.NoOptDefVal == "", flag != nil
+	}
+
+	for i, short := range name {
+		if len(string(short)) != 1 {
+			return false, false
+		}
+
+		flag := flagSet.ShorthandLookup(string(short))
+		if flag == nil {
+			return false, false
+		}
+
+		// The remaining characters are the value of this flag.
+		if flag.NoOptDefVal == "" {
+			return i == len(name)-1, true
+		}
+	}
+
+	return false, true
+}
+
+// shortOnlyAnnotation marks the flags of options with only a short name:
+// their long name, derived from their field name, is not shown in help usages.
+const shortOnlyAnnotation = "flags-short-only"
+
+// negationAnnotation stores, on the flags of negatable options, the name
+// of their negative flag, shown along with them in help usages.
+const negationAnnotation = "flags-negation"
+
+// Negation returns the name of the negative flag of a negatable option, or an empty string.
+func Negation(flag *pflag.Flag) string {
+	if negation := flag.Annotations[negationAnnotation]; len(negation) > 0 {
+		return negation[0]
+	}
+
+	return ""
+}
+
+// flagUsages returns the usages of a flag set like pflag does, but without the long
+// names of short-only options, and with negative flags next to their options. Usages
+// are wrapped in their column to fit in a number of columns, if it is positive, and
+// the names, placeholders and default values of options are styled with a theme.
+func flagUsages(flagSet *pflag.FlagSet, columns int, theme Theme) string {
+	lines := strings.Split(flagSet.FlagUsages(), "\n")
+	heads, usages := make([]string, len(lines)), make([]string, len(lines))
+
+	// Split the names (and type) of flags from their usage, separated by at least
+	// two spaces. Other lines (eg. multiline usages) are left untouched.
+	for i, line := range lines {
+		start := strings.Index(line, "-")
+		if start == -1 || strings.TrimSpace(line[:start]) != "" {
+			continue
+		}
+
+		if end := strings.Index(line[start:], "  "); end == -1 {
+			heads[i] = strings.TrimRight(line, " ")
+		} else {
+			heads[i], usages[i] = line[:start+end], strings.TrimLeft(line[start+end:], " ")
+		}
+	}
+
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		if flag.Hidden {
+			return
+		}
+
+		_, shortOnly := flag.Annotations[shortOnlyAnnotation]
+		negation := flag.Annotations[negationAnnotation]
+
+		if !shortOnly && len(negation) == 0 {
+			return
+		}
+
+		names := "--" + flag.Name
+		if flag.Shorthand != "" {
+			names = "-" + flag.Shorthand + ", " + names
+		}
+
+		for i, head := range heads {
+			trimmed := strings.TrimLeft(head, " ")
+			rest := strings.TrimPrefix(trimmed, names)
+
+			if !strings.HasPrefix(trimmed, names) || (rest != "" && rest[0] != ' ' && rest[0] != '[') {
+				continue
+			}
+
+			paired := names
+			if shortOnly {
+				paired = "-" + flag.Shorthand
+			}
+
+			if len(negation) > 0 {
+				paired += "/--" + negation[0]
+			}
+
+			heads[i] = head[:len(head)-len(trimmed)] + paired + rest
+		}
+	})
+
+	width := 0
+	for _, head := range heads {
+		if len(head) > width {
+			width = len(head)
+		}
+	}
+
+	for i, head := range heads {
+		if head != "" {
+			usage := styledUsage(wrap(usages[i], width+3, columns), theme)
+			lines[i] = styledHead(head, theme) + strings.Repeat(" ", width-len(head)+3) + usage
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// minWrapWidth is the minimum width of the column of usages when wrapping them:
+// below, they are not wrapped, since they would be harder to read than overflowing.
+const minWrapWidth = 20
+
+// wrap breaks the lines of a text between words so that, once indented by a
+// number of columns, they fit in a total number of columns (if positive).
+func wrap(text string, indent, columns int) string {
+	if columns <= 0 || columns-indent < minWrapWidth {
+		return text
+	}
+
+	var wrapped []string
+
+	for _, line := range strings.Split(text, "\n") {
+		current := ""
+
+		for _, word := range strings.Fields(line) {
+			if current != "" && len(current)+1+len(word) > columns-indent {
+				wrapped = append(wrapped, current)
+				current = ""
+			}
+
+			if current != "" {
+				current += " "
+			}
+
+			current += word
+		}
+
+		wrapped = append(wrapped, current)
+	}
+
+	return strings.Join(wrapped, "\n"+strings.Repeat(" ", indent))
+}
@@ -1,6 +1,7 @@
 package flags
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
 )
 
 //
@@ -381,3 +383,349 @@ func TestPFlagGetters(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, []int{10, 20}, intSliceValue)
 }
+
+// TestFlagOnSet checks that hooks registered on a struct
+// field are called with its old/new values when it is set.
+func TestFlagOnSet(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Verbose bool              `long:"verbose"`
+		Level   string            `long:"level"`
+		Labels  map[string]string `long:"label"`
+	}{Level: "info", Labels: map[string]string{"env": "dev"}}
+
+	var verbose bool
+	var oldLevel, newLevel string
+	var oldLabels, newLabels []map[string]string
+
+	hooks := []flags.OptFunc{
+		flags.OnSet(&cfg.Verbose, func(_, new bool) { verbose = new }),
+		flags.OnSet(&cfg.Level, func(old, new string) { oldLevel, newLevel = old, new }),
+		flags.OnSet(&cfg.Labels, func(old, new map[string]string) {
+			oldLabels, newLabels = append(oldLabels, old), append(newLabels, new)
+		}),
+	}
+
+	flagSet, err := ParseFlags(cfg, hooks...)
+	require.NoError(t, err)
+
+	err = flagSet.Parse([]string{"--verbose", "--level", "debug", "--label", "app:web", "--label", "team:ops"})
+	require.NoError(t, err)
+
+	assert.True(t, verbose, "verbose hook should have been called")
+	assert.Equal(t, "info", oldLevel)
+	assert.Equal(t, "debug", newLevel)
+
+	// Maps are set in place: their old value is a copy.
+	require.Len(t, newLabels, 2, "map hook should be called for each value")
+	assert.Equal(t, map[string]string{"env": "dev", "app": "web"}, oldLabels[1])
+	assert.Equal(t, map[string]string{"env": "dev", "app": "web", "team": "ops"}, newLabels[1])
+}
+
+// TestBootstrap checks that early options can be extracted from
+// a command-line containing commands and flags unknown to them.
+func TestBootstrap(t *testing.T) {
+	t.Parallel()
+
+	early := &struct {
+		Config  string `long:"config"`
+		Profile string `short:"p"`
+	}{}
+
+	args := []string{"-v", "--config", "app.conf", "cmd", "--unknown", "val", "-h", "-p", "dev", "--help", "arg"}
+
+	err := Bootstrap(args, early)
+	require.NoError(t, err)
+
+	assert.Equal(t, "app.conf", early.Config)
+	assert.Equal(t, "dev", early.Profile)
+}
+
+// TestFlagChoiceCaseInsensitive checks that choices can be matched
+// regardless of case, and that values are normalized accordingly.
+func TestFlagChoiceCaseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Level  string   `long:"level" choice:"debug" choice:"info" choice-case:"insensitive"`
+		Format string   `long:"format" choice:"json" choice:"text"`
+		Tags   []string `long:"tags" choice:"Alpha Beta"`
+	}{}
+
+	flagSet, err := ParseFlags(cfg, flags.ChoiceCaseInsensitive())
+	require.NoError(t, err)
+
+	err = flagSet.Parse([]string{"--level", "DEBUG", "--format", "Json", "--tags", "alpha,BETA"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "debug", cfg.Level)
+	assert.Equal(t, "json", cfg.Format)
+	assert.Equal(t, []string{"Alpha", "Beta"}, cfg.Tags)
+
+	// Without the global option, only the tagged flag is insensitive.
+	flagSet = pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+
+	_, err = parseTo(cfg, flagSet)
+	require.NoError(t, err)
+
+	err = flagSet.Parse([]string{"--format", "JSON"})
+	assert.Error(t, err, "invalid choice should have been rejected")
+}
+
+// TestFlagLocale checks that number and duration flags accept
+// values written in the conventions of the locale in options.
+func TestFlagLocale(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Ratio   float64       `long:"ratio"`
+		Count   int           `long:"count"`
+		Timeout time.Duration `long:"timeout"`
+		Delay   time.Duration `long:"delay"`
+	}{}
+
+	flagSet, err := ParseFlags(cfg, flags.WithLocale(language.German))
+	require.NoError(t, err)
+
+	args := []string{"--ratio", "1.234,56", "--count", "10.000", "--timeout", "1,5 Stunden 30 Minuten", "--delay", "2m"}
+	err = flagSet.Parse(args)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1234.56, cfg.Ratio)
+	assert.Equal(t, 10000, cfg.Count)
+	assert.Equal(t, 2*time.Hour, cfg.Timeout)
+	assert.Equal(t, 2*time.Minute, cfg.Delay, "Go durations should still be accepted")
+
+	// Group separators must separate thousands.
+	flagSet = pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+
+	_, err = parseTo(cfg, flagSet, flags.WithLocale(language.German))
+	require.NoError(t, err)
+
+	for _, count := range []string{"1.5", "10.00", "1.0000", "1.000,5.0"} {
+		err = flagSet.Parse([]string{"--count", count})
+		assert.Error(t, err, "misplaced group separators in %q should have been rejected", count)
+	}
+
+	err = flagSet.Parse([]string{"--ratio", "-1.000.000,5"})
+	require.NoError(t, err)
+	assert.Equal(t, -1000000.5, cfg.Ratio)
+}
+
+// TestFlagCounterLimits checks that counters are increased by the step of
+// their field, and that they are not increased past their maximum count.
+func TestFlagCounterLimits(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Verbose flags.Counter `short:"v" max:"3"`
+		Level   flags.Counter `short:"l" step:"10"`
+	}{}
+
+	_, err := Parse(cfg, []string{"-vvv", "-ll"})
+	require.NoError(t, err)
+	assert.Equal(t, flags.Counter(3), cfg.Verbose)
+	assert.Equal(t, flags.Counter(20), cfg.Level)
+
+	_, err = Parse(cfg, []string{"-vvvv"})
+	assert.ErrorIs(t, err, flags.ErrParse)
+	assert.ErrorContains(t, err, "counter maximum exceeded")
+
+	_, err = Parse(&struct {
+		Verbose flags.Counter `short:"v" step:"0"`
+	}{}, nil)
+	assert.ErrorIs(t, err, flags.ErrInvalidTag)
+}
+
+func TestFlagRenamedAndDeprecated(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Address string   `long:"address" renamed-from:"addr" renamed-from:"host"`
+		Tags    []string `long:"tags" renamed-from:"labels"`
+		Old     bool     `long:"old" deprecated:"use --address instead"`
+
+		Run testCommand `command:"run"`
+	}{}
+
+	root := Generate(cfg)
+
+	var warnings bytes.Buffer
+
+	root.SetErr(&warnings)
+
+	for _, name := range []string{"addr", "host", "labels"} {
+		alias := root.Flags().Lookup(name)
+		require.NotNil(t, alias)
+		assert.True(t, alias.Hidden, "previous names should be hidden")
+	}
+
+	assert.Equal(t, "use --address instead", root.Flags().Lookup("old").Deprecated)
+
+	root.SetArgs([]string{"--addr", "local", "--labels", "a", "--labels", "b", "run"})
+	require.NoError(t, root.Execute())
+
+	assert.Equal(t, "local", cfg.Address)
+	assert.Equal(t, []string{"a", "b"}, cfg.Tags)
+	assert.Equal(t, "Flag --addr has been renamed, use --address instead\n"+
+		"Flag --labels has been renamed, use --tags instead\n", warnings.String(),
+		"renamed flags should warn only once")
+}
+
+func TestFlagShortOnly(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Verbose bool   `short:"v" desc:"verbose output"`
+		Output  string `short:"o" desc:"output file"`
+		Name    string `long:"name" short:"n" desc:"name"`
+
+		Run testCommand `command:"run"`
+	}{}
+
+	root := Generate(cfg)
+
+	usages := flagUsages(root.Flags(), 0, Theme{})
+	assert.Contains(t, usages, "  -n, --name string   name\n")
+	assert.Contains(t, usages, "  -o string           output file\n")
+	assert.Contains(t, usages, "  -v                  verbose output\n")
+	assert.NotContains(t, usages, "--verbose")
+
+	root.SetArgs([]string{"-v", "-o", "file", "run"})
+	require.NoError(t, root.Execute())
+	assert.True(t, cfg.Verbose)
+	assert.Equal(t, "file", cfg.Output)
+
+	duplicated := &struct {
+		Local struct {
+			Verbose bool `short:"v"`
+		} `group:"local" namespace:"local"`
+		Remote struct {
+			Verbose bool `short:"v"`
+		} `group:"remote" namespace:"remote"`
+	}{}
+
+	_, err := Parse(duplicated, nil)
+	require.ErrorIs(t, err, flags.ErrDuplicatedFlag)
+}
+
+func TestFlagNegatable(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Color bool `long:"color" negatable:"" desc:"colorize output"`
+		Cache bool `long:"cache" negatable:"disable-" desc:"use the cache"`
+
+		Remote struct {
+			Sync bool `long:"sync" negatable:"" desc:"sync remotely"`
+		} `group:"remote" namespace:"remote" namespace-delimiter:"."`
+
+		Run testCommand `command:"run"`
+	}{Color: true}
+
+	root := Generate(cfg)
+
+	usages := flagUsages(root.Flags(), 0, Theme{})
+	assert.Contains(t, usages, "      --cache/--disable-cache          use the cache\n")
+	assert.Contains(t, usages, "      --color/--no-color               colorize output (default true)\n")
+	assert.Contains(t, usages, "      --remote.sync/--remote.no-sync   sync remotely\n")
+	assert.NotContains(t, usages, "  --no-color ")
+
+	root.SetArgs([]string{"--no-color", "--cache", "run"})
+	require.NoError(t, root.Execute())
+	assert.False(t, cfg.Color)
+	assert.True(t, cfg.Cache)
+
+	root.SetArgs([]string{"--disable-cache", "run"})
+	require.NoError(t, root.Execute())
+	assert.False(t, cfg.Cache)
+
+	invalid := &struct {
+		Name string `long:"name" negatable:""`
+	}{}
+
+	_, err := Parse(invalid, nil)
+	require.ErrorIs(t, err, flags.ErrInvalidTag)
+}
+
+func TestFlagInterpolate(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Name      string   `long:"name"`
+		OutputDir string   `long:"output-dir"`
+		Tags      []string `long:"tags"`
+		Output    string   `long:"output" interpolate:""`
+		Raw       string   `long:"raw"`
+
+		Run testCommand `command:"run"`
+	}
+
+	cfg := &config{}
+	root := Generate(cfg, flags.WithEnviron([]string{"USER=alice"}))
+
+	root.SetArgs([]string{
+		"--name", "report", "--output-dir", "/tmp", "--tags", "a,b",
+		"--output", `{{.Flags.OutputDir}}/{{.Flags.Name}}-{{index .Flags "tags"}}-{{env "USER"}}.json`,
+		"--raw", "{{.Flags.Name}}", "run",
+	})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, "/tmp/report-a,b-alice.json", cfg.Output)
+	assert.Equal(t, "{{.Flags.Name}}", cfg.Raw, "options not tagged should not be interpolated")
+
+	cfg = &config{}
+	_, err := Parse(cfg, []string{"--name", "report", "--output", "{{.Flags.Name}}.json", "run"})
+	require.NoError(t, err)
+	assert.Equal(t, "report.json", cfg.Output)
+
+	_, err = Parse(&config{}, []string{"--output", "{{.Flags.Unknown}}", "run"})
+	require.ErrorIs(t, err, flags.ErrInvalidValue)
+
+	var typed *flags.Error
+	require.True(t, errors.As(err, &typed))
+	assert.Equal(t, "output", typed.Flag)
+
+	invalid := &struct {
+		Count int `long:"count" interpolate:""`
+	}{}
+
+	_, err = Parse(invalid, nil)
+	require.ErrorIs(t, err, flags.ErrInvalidTag)
+}
+
+func TestFlagAliases(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Color string   `long:"color" alias:"colour,colors"`
+		Tags  []string `long:"tags" alias:"labels" alias:"tag"`
+
+		Run testCommand `command:"run"`
+	}{}
+
+	root := Generate(cfg)
+
+	for _, name := range []string{"colour", "colors", "labels", "tag"} {
+		alias := root.Flags().Lookup(name)
+		require.NotNil(t, alias)
+		assert.True(t, alias.Hidden, "aliases should be hidden by default")
+	}
+
+	root.SetArgs([]string{"--colour", "red", "--tags", "a", "--labels", "b", "--tag", "c", "run"})
+	require.NoError(t, root.Execute())
+
+	assert.Equal(t, "red", cfg.Color)
+	assert.Equal(t, []string{"a", "b", "c"}, cfg.Tags, "aliases should share their option value")
+
+	root = Generate(cfg, flags.ShowFlagAliases())
+	assert.False(t, root.Flags().Lookup("colour").Hidden)
+
+	_, err := Parse(&struct {
+		Color  string `long:"color" alias:"colour"`
+		Colour string `long:"colour"`
+	}{}, nil)
+	require.ErrorIs(t, err, flags.ErrDuplicatedFlag)
+}
@@ -27,15 +27,43 @@
 // command:              When specified on a struct field, makes the struct
 //                       field a (sub)command with the given name (optional).
 //                       Note that a struct marked as a command does not mandatorily
-//                       have to implement the `flags.Commander` interface.
+//                       have to implement the `flags.Commander` interface (or the
+//                       `flags.CommanderContext` one): if it does not, it is a pure
+//                       parent, only grouping its subcommands (and printing its help
+//                       usage when invoked without any of them).
 // subcommands-optional: When specified on a command struct field, makes
 //                       any subcommands of that command optional (optional)
 // alias:                When specified on a command struct field, adds the
 //                       specified name as an alias for the command. Can be
 //                       be specified multiple times to add more than one
 //                       alias (optional)
+// alias-styles:         Comma-separated styles of aliases generated from the name of the
+//                       command: "short" (its first letter, eg. `i` for `install`) and
+//                       "initials" (the first letters of its words, eg. `gp` for `get-pods`).
+//                       Generated aliases already used by sibling commands (or reserved)
+//                       are dropped, and reported by flags.Lint() (optional)
+// example:              An example of use of the command, shown in its help usage
+//                       and generated documentation. Can be specified multiple
+//                       times to add more than one example. Examples can be
+//                       checked with SelfTest() or its hidden command (optional)
+// env-namespace:        When specified on a command struct field, the env-namespace gets
+//                       prepended to the env keys of the options of the command and of its
+//                       subcommands, like for groups (see below) (optional)
 // group:                If the group name is not nil, this command will be
 //                       grouped under this heading in the help usage.
+// annotation:           A `key=value` pair added to the annotations of the command,
+//                       for use by downstream tooling (routing, ownership, etc). Can
+//                       be specified multiple times to add more than one (optional)
+// unknown:              When specified on a map[string]string field of a command struct,
+//                       and when the flags.CollectUnknownFlags() option is given, the
+//                       unknown flags given to the command (`--key value` or `--key=value`)
+//                       are stored in this map, instead of being errors (optional)
+// argv:                 When specified on a []string field of a command struct, the
+//                       exact words given to the command (after its name), before any
+//                       parsing or normalization, are stored in this field (optional)
+// mode:                 Either "cli" or "repl": when the flags.WithMode() option is
+//                       given another mode, the command is not generated. Can also
+//                       be used on groups, options and positionals (optional)
 //
 //
 // B) Flags ----------------------------------------------------------------------
@@ -45,7 +73,12 @@
 // flag:             Short and/or long names for the flag, space-separated.
 //                   (ex: `flag:"-v --verbose`).
 // short:            The short name of the option (single character)
-// long:             The long name of the option
+// long:             The long name of the option. Options with a short name but no
+//                   long one are short-only: help usages, man pages and docs only
+//                   show their short name (their field-derived long name is still
+//                   accepted on the command-line, and used for env and config keys).
+//                   Options of a command cannot share names or short names, even in
+//                   different namespaces: this returns flags.ErrDuplicatedFlag.
 // required:         If non empty, makes the option required to appear on the command
 //                   line. If a required option is not present, the parser will
 //                   return ErrRequired (optional)
@@ -53,7 +86,10 @@
 // desc:             Same as 'description'
 // long-description: The long description of the option. Currently only
 //                   displayed in generated man pages (optional)
-// no-flag:          If non-empty, this field is ignored as an option (optional)
+// no-flag:          If non-empty, this field is ignored as an option. When used with
+//                   an env tag, the field is only set from this environment variable,
+//                   without any flag, help or completions (ex: `env:"API_TOKEN"
+//                   no-flag:"true"`) (optional)
 // optional:         If non-empty, makes the argument of the option optional. When an
 //                   argument is optional it can only be specified using
 //                   --option=argument (optional)
@@ -69,16 +105,82 @@
 //                   value "-", then no default value will be shown at all
 //                   (optional)
 // env:              The default value of the option is overridden from the
-//                   specified environment variable, if one has been defined.
-//                   (optional)
+//                   specified environment variable, if one has been defined,
+//                   once the command-line is parsed (optional)
 // env-delim:        The 'env' default value from environment is split into
 //                   multiple values with the given delimiter string, use with
 //                   slices and maps (optional)
+// source:           Binds the default value of the option to the key of a value
+//                   source registered with flags.WithSource(), in the name:key
+//                   format (ex: `source:"vault:path/to/secret"`), queried by a
+//                   Resolver bound to the commands. Values found in registered
+//                   sources or the environment override those of the source (optional)
+// layout:           The layout used to parse and format the values of time.Time
+//                   options (and slices/maps of them), as with time.Parse. By
+//                   default, flags.DefaultTimeLayout (RFC3339) is used. Since layouts
+//                   might contain commas, each value of a slice or map is given
+//                   separately, like --dates "Apr 1, 2023" --dates "Apr 2, 2023" (optional)
+// max:              The maximum count of a flags.Counter option: giving it more
+//                   times returns flags.ErrCounterMax (ex: `short:"v" max:"3"`) (optional)
+// step:             The amount by which a flags.Counter option is increased
+//                   each time it is given without a value, 1 by default (optional)
+// default-from:     The name of another option (ex: `default-from:"address"`), whose
+//                   value this option takes when it is not given any (on the
+//                   command-line, from its environment or from configurations),
+//                   once resolved by a Resolver bound to the commands (optional)
+// flagtype:         If "json", the option (usually a struct or a map) takes a JSON
+//                   document, unmarshaled onto its field as a whole, instead of
+//                   having each of its fields scanned as an option (optional)
 // choice:           Limits the values for an option to a set of values.
 //                   You can either specify multiple values in a single tag
 //                   if they are space-separated, and/or with multiple tags.
 //                   (e.g. `long:"animal" choice:"cat bird" choice:"dog"`)
+// choice-case:      If "insensitive", the choices are matched regardless of case, and
+//                   the value is normalized to the spelling of the matching choice (the
+//                   first one, as shown in help usages and completions).
+//                   This is the default when the flags.ChoiceCaseInsensitive() option
+//                   is given, in which case "sensitive" can be used to opt out (optional).
+// choice-desc:      Description of a choice in completions, after its name and a colon
+//                   (e.g. `choice:"json" choice-desc:"json:machine-readable output"`)
+// check:            Comma-separated checks of each value given to the option or positional:
+//                   min=N and max=N (bounds of numbers and durations, or length of strings),
+//                   regexp=EXPR (takes the remainder of the tag), file, dir, exists, url,
+//                   and checks registered with flags.RegisterCheck (ex: `check:"min=1,max=65535"`).
+// deprecated:       Marks the option as deprecated (hidden from help usages), with
+//                   a message printed when it is used (ex: "use --new instead")
+// alias:            Other long names of the option, comma-separated, which set the
+//                   same value (ex: `long:"color" alias:"colour,colors"`). They
+//                   are hidden from help usages, unless flags.ShowFlagAliases()
+//                   is given (optional)
+// renamed-from:     A previous name of the option, which is still accepted as a
+//                   hidden flag setting the same value, but prints a warning once
+//                   (ex: `long:"address" renamed-from:"addr"`). Can be repeated.
+// requires:         Names of the options that must also be set on the command-line when
+//                   this one is (ex: `long:"key" requires:"cert"`), comma-separated.
+// conflicts-with:   Names of the options that cannot be set on the command-line along
+//                   with this one (ex: `long:"json" conflicts-with:"yaml,xml"`).
+//                   In namespaced groups, names are those of the options of the group
+//                   if it has such options. Unknown names fail to generate commands.
+// one-required:     The name of a group of options, possibly declared in several structs
+//                   and commands (with persistent ones), of which at least one must be
+//                   set on the command-line (ex: `one-required:"source"`).
+// required-if:      Makes the option required when another option is set on the command-line
+//                   (ex: `required-if:"remote"`), or has a given value there (ex:
+//                   `required-if:"mode=remote"`). Can be repeated, any condition applying.
+// required-unless:  Makes the option required unless one of other options is set on the
+//                   command-line (ex: `long:"host" required-unless:"config"`).
+// negatable:        On boolean options, adds a flag setting them to false, named with
+//                   the given prefix, or "no-" if empty (ex: `long:"cache" negatable:""`
+//                   adds --no-cache, or --ns.no-cache in a group prefixed with "ns.").
+//                   Help usages show both flags in the same entry.
+// interpolate:      On string options, makes their value a Go template executed once the
+//                   command-line is parsed, with the values of the other options of the
+//                   command and the environment: `{{.Flags.Name}}` or `{{index .Flags "name"}}`,
+//                   and `{{env "HOME"}}` (ex: `long:"output" interpolate:""` given the value
+//                   "{{.Flags.Name}}.json"). Options are referenced as parsed, not interpolated.
 // hidden:           If non-empty, the option is not visible in the help or man page.
+// mode:             Either "cli" or "repl": the option is only generated when the
+//                   flags.WithMode() option is not given another mode (optional)
 //
 // b) github.com/octago/sflags tag specification:
 //
@@ -145,6 +247,16 @@
 //                      Various examples of positional arguments declaration can be found
 //                      on the online documentation.
 //
+//                      Fields of type func(yield func(string) bool) are lists whose words
+//                      are not converted nor copied into a slice: they are set to an iterator
+//                      yielding the words they consumed, for commands taking huge numbers of
+//                      arguments (ex: file paths). Their requirements are checked as for slices.
+//
+// positional-arg:      When specified on fields of a command struct (instead of a struct tagged
+//                      with positional-args), these fields are the positional arguments of the
+//                      command, in their order, with the same tags as above (optional). It is
+//                      what `arg` fields are converted to, with flags.WithKongTags().
+//
 //
 // D) Groups (of flags or commands) ----------------------------------------------
 //
@@ -160,9 +272,31 @@
 // env-namespace: When specified on a group struct field, the env-namespace
 //                gets prepended to every option's env key and
 //                subgroup's env-namespace of this group, separated by
-//                the parser's env-namespace delimiter (optional) (flags only)
+//                the parser's env-namespace delimiter (optional) (flags only).
+//                If not set, the upper-cased namespace is used instead.
+// env-namespace-delimiter: The delimiter appended to the group env-namespace,
+//                which is the parser EnvDivider ("_" by default) if not set.
 // persistent:    If non-empty, all flags belonging to this group will be
-//                persistent across subcommands.
+//                persistent across subcommands. A group declared again by the
+//                subcommands (same struct, or same type with the same group name
+//                and namespace) is bound once, on the parent: its values are the
+//                only ones set, and are copied into the other structs of the group
+//                when their commands execute (execute concurrent command-lines on
+//                their own trees, eg. with ExecuteArgs).
+// require-one:   If specified on a group struct field, at least one of the
+//                options of the group must be set on the command-line.
+// require-n:     Same as require-one, but at least the given number of options
+//                (ex: `require-n:"2"`) must be set. Both are mentioned in usages.
+// group-provider: When specified on a struct field of interface type, the
+//                concrete value (a pointer to struct) set by the application
+//                before generation is scanned as a group of options. This tag
+//                accepts the same namespace/persistent tags as a group.
+//                A nil interface simply adds no options.
+// use-group:     When specified on a struct field of interface type (ex: interface{}),
+//                the group of options registered under this name with
+//                flags.RegisterGroup() is instantiated, set on the field and
+//                scanned like a group-provider (ex: `use-group:"logging"`).
+//                This allows packages to share groups without importing them.
 //
 //
 // D) Completions (flags or positionals) -------------------------------------------
@@ -185,6 +319,32 @@
 // `Dirs` completes all directories in the current filesystem context.
 // ex: `complete:"dirs"` (lowercase is still valid)
 //
+// The file directives also accept `ext=EXT;EXT` options, only completing files with these
+// extensions, and `root=DIR` ones, completing paths relative to a directory instead.
+// ex: `complete:"files,ext=yaml;yml"` or `complete:"dirs,root=./profiles"`
+//
+// `hosts`, `users`, `groups`, `interfaces`, `env` and `processes` complete, with descriptions,
+// the host names (/etc/hosts and ~/.ssh/config), the user names and groups (/etc/passwd and
+// /etc/group), the network interfaces, the environment variables and the PIDs of the system.
+// ex: `complete:"users"`
+//
+// `multipart` completes values made of two parts joined by a separator (other than a comma),
+// each completed with a directive without arguments (builtin or registered).
+// ex: `complete:"multipart,@,users,hosts"` completes `user@host` targets.
+//
+// Other names complete with the completers registered under them, with the
+// `completions.WithCompleter("<name>", completer)` option, for options and positionals.
+// ex: `complete:"regions"`
+//
+// complete-cache: Caches the completions of an option or positional (whatever their completer)
+//                 for a duration, in the user cache directory, by command, option and prefix.
+// ex: `complete:"regions" complete-cache:"30s"`
+//
+// `session:<key>` completes the values remembered by previous commands in a console
+// session, with `completions.Remember("<key>", values)`. The session store is opt-in,
+// and must be enabled with `completions.EnableSession()`.
+// ex: `complete:"session:targets"`
+//
 // b) Additional completions
 //
 // Completers can also be implement by positional/flags field types, with:
@@ -195,6 +355,11 @@
 // but the engine itself is already very performant at handling prefixing/formatting.
 // Please check the carapace documentation for writing completers.
 //
+// Completers querying slow/remote sources should rather implement:
+// `func (m *myType) CompleteContext(ctx context.Context, cctx carapace.Context) carapace.Action`
+// The context is canceled after completions.CompleterTimeout, or when the shell
+// interrupts the completion, so that the completer can give up its work in time.
+//
 // Also, note that the flags library is quite efficient at identifying the kind of
 // the positional/flag field (whether it's a map/slice or not), and if it detects
 // a []YourType, where `YourType` individually implements the completer, flags will
@@ -219,4 +384,18 @@
 // Check the documentation for adding other custom validations directly through the
 // go-validator engine.
 //
+//
+// F) Configuration files -----------------------------------------------------------
+//
+// Once generated, a command tree can be given the values found in an INI or TOML file,
+// where sections are command paths and keys are the long names of their flags:
+//
+// root := flags.Generate(data)
+// err := flags.ParseConfigFile(root, "config.toml") // Before root.Execute()
+//
+// Conversely, the options of a command struct and their current values can be written
+// to such a file (or to YAML), with their descriptions as comments:
+//
+// err := flags.WriteConfigFile("config.toml", data)
+//
 package flags
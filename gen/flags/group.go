@@ -3,11 +3,31 @@ package flags
 import (
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/reeflective/flags"
 	"github.com/reeflective/flags/internal/scan"
 	"github.com/reeflective/flags/internal/tag"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// requiredAnnotation stores, on each option of a group, the group name and
+	// the minimum number of its options that must be set on the command-line.
+	requiredAnnotation = "flags-required-group"
+
+	// requiresAnnotation and conflictsAnnotation store the names of the options
+	// that an option requires, or conflicts with, when set on the command-line.
+	requiresAnnotation  = "flags-requires"
+	conflictsAnnotation = "flags-conflicts-with"
+
+	// requiredIfAnnotation and requiredUnlessAnnotation store the conditions
+	// (other options set, with a given value or not set) making an option required.
+	requiredIfAnnotation     = "flags-required-if"
+	requiredUnlessAnnotation = "flags-required-unless"
 )
 
 // flagScan builds a small struct field handler so that we can scan
@@ -25,9 +45,9 @@ func flagScan(cmd *cobra.Command, opts []flags.OptFunc) scan.Handler {
 		}
 
 		// Put these flags into the command's flagset.
-		generateTo(flagSet, cmd.Flags())
+		addEnvOptions(cmd, flagSet)
 
-		return true, nil
+		return true, generateTo(flagSet, cmd.Flags(), cmd.ErrOrStderr)
 	}
 
 	return flagScanner
@@ -35,13 +55,18 @@ func flagScan(cmd *cobra.Command, opts []flags.OptFunc) scan.Handler {
 
 // flagsGroup finds if a field is marked as a subgroup of options, and if yes, scans it recursively.
 func flagsGroup(cmd *cobra.Command, val reflect.Value, field *reflect.StructField, opts []flags.OptFunc) (bool, error) {
-	mtag, skip, err := tag.GetFieldTag(*field)
+	mtag, skip, err := flags.ScanOptions(opts...).FieldTag(*field)
 	if err != nil {
 		return true, fmt.Errorf("%w: %s", flags.ErrParse, err.Error())
 	} else if skip {
 		return false, nil
 	}
 
+	// A group of options provided at runtime by an interface value.
+	if flags.IsProvided(mtag) {
+		return true, addProvidedFlagSet(cmd, mtag, val, opts)
+	}
+
 	legacyGroup, legacyIsSet := mtag.Get("group")
 	commandGroup, commandsIsSet := mtag.Get("commands")
 
@@ -73,7 +98,7 @@ func flagsGroup(cmd *cobra.Command, val reflect.Value, field *reflect.StructFiel
 		var group *cobra.Group
 		if !isStringFalsy(commandGroup) {
 			group = &cobra.Group{
-				Title: commandGroup,
+				Title: flags.Sanitize(commandGroup),
 				ID:    commandGroup,
 			}
 			cmd.AddGroup(group)
@@ -94,38 +119,529 @@ func flagsGroup(cmd *cobra.Command, val reflect.Value, field *reflect.StructFiel
 
 // addFlagSet scans a struct (potentially nested) for flag sets to bind to the command.
 func addFlagSet(cmd *cobra.Command, mtag tag.MultiTag, data interface{}, opts []flags.OptFunc) error {
-	// New change, in order to easily propagate parent namespaces
-	// in heavily/specially nested option groups at bind time.
-	delim, _ := mtag.Get("namespace-delimiter")
+	// Namespaces (flags and env) are composed with the parent ones,
+	// so that they propagate in heavily/specially nested option groups.
+	opts = flags.GroupOptions(mtag, opts...)
 
-	namespace, _ := mtag.Get("namespace")
-	if namespace != "" {
-		opts = append(opts, flags.Prefix(namespace+delim))
-	}
+	// Persistent options of a group already bound by one of our parents
+	// are inherited: they are stored once, by the first group bound, and
+	// other structs of the group are given a copy of its values when one
+	// of their commands executes (see shareGroups).
+	persistent, _ := mtag.Get("persistent")
+	group := groupName(mtag)
+	prefix := flags.ScanOptions(opts...).Prefix
+	bound := boundGroup{name: group, prefix: prefix, data: data}
 
-	envNamespace, _ := mtag.Get("env-namespace")
-	if envNamespace != "" {
-		opts = append(opts, flags.EnvPrefix(envNamespace))
+	if persistent != "" && sharePersistent(cmd, bound) {
+		return nil
 	}
 
 	// Create a new set of flags in which we will put our options
-	flags, err := ParseFlags(data, opts...)
+	flags, options, err := parseFlags(data, opts...)
 	if err != nil {
 		return err
 	}
 
 	flags.SetInterspersed(true)
+	resolveRelations(flags, prefix)
+
+	if err := setRequiredGroup(flags, mtag); err != nil {
+		return err
+	}
+
+	// Options of different groups (eg. in different namespaces)
+	// cannot have the same names or short names on a command.
+	if err := checkDuplicates(flags, cmd.Flags(), cmd.PersistentFlags()); err != nil {
+		return err
+	}
+
+	// Help usages show the options of each group in their own section.
+	setGroupAnnotations(cmd, flags, group)
 
-	persistent, _ := mtag.Get("persistent")
 	if persistent != "" {
 		cmd.PersistentFlags().AddFlagSet(flags)
+		setPersistentBound(cmd, bound)
 	} else {
 		cmd.Flags().AddFlagSet(flags)
 	}
 
+	addValidater(cmd, data, persistent != "")
+	addEnvOptions(cmd, options)
+
+	return nil
+}
+
+// setRequiredGroup marks all options of a group tagged with `require-one` or `require-n`
+// with the minimum number of them that must be set, and mentions it in their usage.
+func setRequiredGroup(flagSet *pflag.FlagSet, mtag tag.MultiTag) error {
+	minimum := 0
+
+	if _, isSet := mtag.Get("require-one"); isSet {
+		minimum = 1
+	}
+
+	if required, isSet := mtag.Get("require-n"); isSet {
+		count, err := strconv.Atoi(required)
+		if err != nil || count < 1 {
+			return fmt.Errorf("%w: require-n must be a positive number: %q", flags.ErrInvalidTag, required)
+		}
+
+		minimum = count
+	}
+
+	if minimum == 0 {
+		return nil
+	}
+
+	group, _ := mtag.Get("group")
+
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		_ = flagSet.SetAnnotation(flag.Name, requiredAnnotation, []string{group, strconv.Itoa(minimum)})
+		flag.Usage = strings.TrimSpace(fmt.Sprintf("%s (at least %d of %s options required)", flag.Usage, minimum, group))
+	})
+
 	return nil
 }
 
+// setRelations marks an option with the names of the options it requires or conflicts
+// with, the conditions making it required, and with the group of options (`one-required`)
+// of which at least one is required.
+func setRelations(srcFlag *flags.Flag, flag *pflag.Flag) {
+	if len(srcFlag.Requires) > 0 {
+		flag.Annotations[requiresAnnotation] = srcFlag.Requires
+	}
+
+	if len(srcFlag.ConflictsWith) > 0 {
+		flag.Annotations[conflictsAnnotation] = srcFlag.ConflictsWith
+	}
+
+	if len(srcFlag.RequiredIf) > 0 {
+		flag.Annotations[requiredIfAnnotation] = srcFlag.RequiredIf
+	}
+
+	if len(srcFlag.RequiredUnless) > 0 {
+		flag.Annotations[requiredUnlessAnnotation] = srcFlag.RequiredUnless
+	}
+
+	if srcFlag.OneRequired != "" {
+		flag.Annotations[requiredAnnotation] = []string{srcFlag.OneRequired, "1"}
+		flag.Usage = strings.TrimSpace(fmt.Sprintf("%s (at least 1 of %s options required)", flag.Usage, srcFlag.OneRequired))
+	}
+}
+
+// resolveRelations makes the options of a group in a namespace require, or conflict
+// with, the options of the group named in the same namespace, if any: their names
+// are otherwise those of options of the command or of its parents, as given.
+func resolveRelations(flagSet *pflag.FlagSet, prefix string) {
+	if prefix == "" {
+		return
+	}
+
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		for _, annotation := range []string{requiresAnnotation, conflictsAnnotation} {
+			names := flag.Annotations[annotation]
+			resolved := make([]string, len(names))
+
+			for i, name := range names {
+				if resolved[i] = name; flagSet.Lookup(prefix+name) != nil {
+					resolved[i] = prefix + name
+				}
+			}
+
+			if len(resolved) > 0 {
+				flag.Annotations[annotation] = resolved
+			}
+		}
+	})
+}
+
+// checkRelations returns an error if an option of a command tree requires, or conflicts
+// with, an option that neither its command nor the parents of the latter have.
+func checkRelations(cmd *cobra.Command) error {
+	flagSet := pflag.NewFlagSet(cmd.Name(), pflag.ContinueOnError)
+	flagSet.AddFlagSet(cmd.Flags())
+	flagSet.AddFlagSet(cmd.PersistentFlags())
+	flagSet.AddFlagSet(cmd.InheritedFlags())
+
+	if err := unknownRelations(flagSet); err != nil {
+		return err
+	}
+
+	for _, subc := range cmd.Commands() {
+		if err := checkRelations(subc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unknownRelations returns an error if an option of a flag set requires,
+// or conflicts with, an option that the flag set does not have.
+func unknownRelations(flagSet *pflag.FlagSet) error {
+	var err error
+
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		for _, relation := range []struct{ annotation, verb string }{
+			{requiresAnnotation, "requires"}, {conflictsAnnotation, "conflicts with"},
+		} {
+			for _, name := range flag.Annotations[relation.annotation] {
+				if err == nil && flagSet.Lookup(name) == nil {
+					err = fmt.Errorf("%w: option --%s %s unknown option --%s", flags.ErrInvalidTag, flag.Name, relation.verb, name)
+				}
+			}
+		}
+	})
+
+	return err
+}
+
+// requireGroups walks the command tree and makes each command having some options
+// in groups with a minimum number of them required to check for them once parsed.
+func requireGroups(cmd *cobra.Command) {
+	for _, subc := range cmd.Commands() {
+		requireGroups(subc)
+	}
+
+	hasRequired := false
+
+	visit := func(flag *pflag.Flag) {
+		for _, annotation := range []string{
+			requiredAnnotation, requiresAnnotation, conflictsAnnotation,
+			requiredIfAnnotation, requiredUnlessAnnotation,
+		} {
+			if _, isSet := flag.Annotations[annotation]; isSet {
+				hasRequired = true
+			}
+		}
+	}
+
+	cmd.Flags().VisitAll(visit)
+	cmd.InheritedFlags().VisitAll(visit)
+
+	if !hasRequired {
+		return
+	}
+
+	preRun(cmd, func(cmd *cobra.Command, _ []string) error {
+		return commandErrors(cmd, validateRequiredGroups(cmd.Flags()))
+	})
+}
+
+// validateRequiredGroups checks that all groups of options have at least
+// as many of their options set on the command-line as they require, and
+// that the options set are given along with those they require (and
+// without those they conflict with).
+func validateRequiredGroups(flagSet *pflag.FlagSet) error {
+	type requiredGroup struct {
+		minimum int
+		set     int
+		names   []string
+	}
+
+	groups := map[string]*requiredGroup{}
+	names := make([]string, 0)
+
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		annotation := flag.Annotations[requiredAnnotation]
+		if len(annotation) != 2 {
+			return
+		}
+
+		group, found := groups[annotation[0]]
+		if !found {
+			minimum, _ := strconv.Atoi(annotation[1])
+			group = &requiredGroup{minimum: minimum}
+			groups[annotation[0]] = group
+			names = append(names, annotation[0])
+		}
+
+		group.names = append(group.names, "--"+flag.Name)
+
+		if isFlagSet(flagSet, flag) {
+			group.set++
+		}
+	})
+
+	for _, name := range names {
+		if group := groups[name]; group.set < group.minimum {
+			return optionError(flags.ErrRequiredGroup, "", name, "at least %d of the %s options must be set: %s",
+				group.minimum, name, strings.Join(group.names, ", "))
+		}
+	}
+
+	var err error
+
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		switch {
+		case err != nil:
+		case isFlagSet(flagSet, flag):
+			err = validateRelations(flagSet, flag)
+		default:
+			err = validateConditions(flagSet, flag)
+		}
+	})
+
+	return err
+}
+
+// validateRelations checks that an option set on the command-line is given along
+// with the options it requires, and without the options it conflicts with.
+func validateRelations(flagSet *pflag.FlagSet, flag *pflag.Flag) error {
+	var missing, conflicting []string
+
+	for _, name := range flag.Annotations[requiresAnnotation] {
+		other := flagSet.Lookup(name)
+		if other == nil {
+			return optionError(flags.ErrInvalidTag, flag.Name, "", "option --%s requires unknown option --%s", flag.Name, name)
+		}
+
+		if !isFlagSet(flagSet, other) {
+			missing = append(missing, "--"+name)
+		}
+	}
+
+	for _, name := range flag.Annotations[conflictsAnnotation] {
+		other := flagSet.Lookup(name)
+		if other == nil {
+			return optionError(flags.ErrInvalidTag, flag.Name, "", "option --%s conflicts with unknown option --%s", flag.Name, name)
+		}
+
+		if isFlagSet(flagSet, other) {
+			conflicting = append(conflicting, "--"+name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return optionError(flags.ErrRequiredFlag, flag.Name, "", "--%s must be used with %s", flag.Name, strings.Join(missing, ", "))
+	}
+
+	if len(conflicting) > 0 {
+		return optionError(flags.ErrConflictingFlags, flag.Name, "", "--%s cannot be used with %s", flag.Name, strings.Join(conflicting, ", "))
+	}
+
+	return nil
+}
+
+// validateConditions checks that an option not set on the command-line is not
+// required by a `required-if` or `required-unless` condition on other options.
+func validateConditions(flagSet *pflag.FlagSet, flag *pflag.Flag) error {
+	for _, condition := range flag.Annotations[requiredIfAnnotation] {
+		name, value, hasValue := strings.Cut(condition, "=")
+
+		other := flagSet.Lookup(name)
+		if other == nil {
+			return optionError(flags.ErrInvalidTag, flag.Name, "", "option --%s is required if unknown option --%s", flag.Name, name)
+		}
+
+		switch {
+		case !isFlagSet(flagSet, other):
+		case !hasValue:
+			return optionError(flags.ErrRequiredFlag, flag.Name, "", "--%s is required when --%s is set", flag.Name, name)
+		case hasFlagValue(other, value):
+			return optionError(flags.ErrRequiredFlag, flag.Name, "", "--%s is required when --%s is %s", flag.Name, name, value)
+		}
+	}
+
+	names := flag.Annotations[requiredUnlessAnnotation]
+	if len(names) == 0 {
+		return nil
+	}
+
+	for _, name := range names {
+		other := flagSet.Lookup(name)
+		if other == nil {
+			return optionError(flags.ErrInvalidTag, flag.Name, "", "option --%s is required unless unknown option --%s", flag.Name, name)
+		}
+
+		if isFlagSet(flagSet, other) {
+			return nil
+		}
+	}
+
+	return optionError(flags.ErrRequiredFlag, flag.Name, "", "--%s is required unless %s is set", flag.Name,
+		"--"+strings.Join(names, " or --"))
+}
+
+// hasFlagValue returns true if an option has a value, or has
+// it among its elements for repeatable ones (slices).
+func hasFlagValue(flag *pflag.Flag, value string) bool {
+	if !strings.HasSuffix(flag.Value.Type(), "Slice") {
+		return flag.Value.String() == value
+	}
+
+	// Repeatable options are formatted as comma-separated lists, between brackets.
+	elems := strings.TrimSuffix(strings.TrimPrefix(flag.Value.String(), "["), "]")
+
+	for _, elem := range strings.Split(elems, ",") {
+		if elem == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isFlagSet returns true if an option has been set on the command-line, either
+// with its own name, or with one of its aliases, previous names or negative flag.
+func isFlagSet(flagSet *pflag.FlagSet, flag *pflag.Flag) bool {
+	changed := flag.Changed
+
+	flagSet.Visit(func(alias *pflag.Flag) {
+		if target := alias.Annotations[aliasAnnotation]; len(target) > 0 && target[0] == flag.Name {
+			changed = true
+		}
+	})
+
+	return changed
+}
+
+// persistentGroups holds the structs bound as persistent option groups of commands,
+// and the other structs of these groups, to which their values are copied.
+var persistentGroups = struct {
+	sync.Mutex
+	bound  map[*cobra.Command][]boundGroup
+	copies map[*cobra.Command][]groupCopy
+}{
+	bound:  map[*cobra.Command][]boundGroup{},
+	copies: map[*cobra.Command][]groupCopy{},
+}
+
+// boundGroup is a struct bound as a persistent group of options, with their prefix.
+type boundGroup struct {
+	name   string
+	prefix string
+	data   interface{}
+}
+
+// groupCopy is a struct of a persistent group bound by a parent command,
+// which is given a copy of the values of the bound one when executing.
+type groupCopy struct {
+	from, to interface{}
+}
+
+// groupName returns the name of a group of options, declared or provided.
+func groupName(mtag tag.MultiTag) string {
+	group, _ := mtag.Get("group")
+	if flags.IsProvided(mtag) {
+		if group, _ = mtag.Get("group-provider"); group == "" {
+			group, _ = mtag.Get("use-group")
+		}
+	}
+
+	return group
+}
+
+// isSameGroup returns true if both groups are bound to the same struct, or to structs
+// of the same type for groups of the same name and prefix, storing the same options.
+func (bound boundGroup) isSameGroup(group boundGroup) bool {
+	if bound.data == group.data {
+		return true
+	}
+
+	return bound.name == group.name && bound.prefix == group.prefix &&
+		reflect.TypeOf(bound.data) == reflect.TypeOf(group.data)
+}
+
+// boundPersistent returns the struct of a persistent group bound by a
+// command or one of its parents, storing the same options as the group.
+func boundPersistent(cmd *cobra.Command, group boundGroup) (interface{}, bool) {
+	persistentGroups.Lock()
+	defer persistentGroups.Unlock()
+
+	for parent := cmd; parent != nil; parent = parent.Parent() {
+		for _, bound := range persistentGroups.bound[parent] {
+			if bound.isSameGroup(group) {
+				return bound.data, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// sharePersistent returns true if a persistent group is already bound by the command
+// or one of its parents, in which case another struct of this group is given a copy
+// of the values of the bound one when the command (or its subcommands) executes.
+func sharePersistent(cmd *cobra.Command, group boundGroup) bool {
+	bound, found := boundPersistent(cmd, group)
+	if !found {
+		return false
+	}
+
+	if bound != group.data {
+		persistentGroups.Lock()
+		persistentGroups.copies[cmd] = append(persistentGroups.copies[cmd], groupCopy{from: bound, to: group.data})
+		persistentGroups.Unlock()
+	}
+
+	return true
+}
+
+// setPersistentBound registers an options struct as a persistent group of the command.
+func setPersistentBound(cmd *cobra.Command, group boundGroup) {
+	persistentGroups.Lock()
+	defer persistentGroups.Unlock()
+
+	persistentGroups.bound[cmd] = append(persistentGroups.bound[cmd], group)
+}
+
+// shareGroups makes the commands of a tree copy, once their options are set and checked,
+// the values of the persistent groups they inherit into their other structs of these groups.
+func shareGroups(cmd *cobra.Command) {
+	persistentGroups.Lock()
+	defer persistentGroups.Unlock()
+
+	bindCopies(cmd, nil)
+}
+
+// bindCopies binds the copies of persistent groups of a command and of its subcommands,
+// to which those of their parents are passed. They are not kept once bound.
+func bindCopies(cmd *cobra.Command, inherited []groupCopy) {
+	copies := append(inherited[:len(inherited):len(inherited)], persistentGroups.copies[cmd]...)
+	delete(persistentGroups.copies, cmd)
+
+	if len(copies) > 0 {
+		preRun(cmd, func(*cobra.Command, []string) error {
+			copyGroups(copies)
+
+			return nil
+		})
+	}
+
+	for _, subc := range cmd.Commands() {
+		bindCopies(subc, copies)
+	}
+}
+
+// copyGroups copies the values of bound persistent groups into the other structs of these
+// groups. Their maps are copied as well, so that they can be modified independently.
+func copyGroups(copies []groupCopy) {
+	for _, groupCopy := range copies {
+		from, to := reflect.ValueOf(groupCopy.from).Elem(), reflect.ValueOf(groupCopy.to).Elem()
+		to.Set(from)
+
+		for i := 0; i < to.NumField(); i++ {
+			if field := to.Field(i); field.Kind() == reflect.Map && field.CanSet() {
+				field.Set(cloneMap(from.Field(i)))
+			}
+		}
+	}
+}
+
+// addProvidedFlagSet scans the concrete value stored in an interface field for options
+// (or a new instance of the registered group it uses). This allows external packages
+// to contribute flags to commands without the host application having to know about
+// their types: a nil provider adds no options.
+func addProvidedFlagSet(cmd *cobra.Command, mtag tag.MultiTag, val reflect.Value, opts []flags.OptFunc) error {
+	data, err := flags.ProvidedGroup(mtag, val)
+	if err != nil || data == nil {
+		return err
+	}
+
+	return addFlagSet(cmd, mtag, data, opts)
+}
+
 func isStringFalsy(s string) bool {
 	return s == "" || s == "false" || s == "no" || s == "0"
 }
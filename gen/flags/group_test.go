@@ -0,0 +1,407 @@
+package flags
+
+import (
+	"io"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/stretchr/testify/assert"
+)
+
+// pluginOptions is a group of options declared by an external package,
+// and provided to the host command through an interface field.
+type pluginOptions struct {
+	Plugin string `short:"P" long:"plugin"`
+}
+
+// providerCommand has a group of options whose type is not known to it.
+type providerCommand struct {
+	Provider interface{} `group-provider:"plugin options"`
+}
+
+// TestGroupProvider checks that options sourced from an
+// interface field are bound to the command and parsed.
+func TestGroupProvider(t *testing.T) {
+	t.Parallel()
+
+	plugin := &pluginOptions{}
+	data := &providerCommand{Provider: plugin}
+
+	root := newCommandWithArgs(data, []string{"--plugin", "value"})
+	err := root.Execute()
+
+	test := assert.New(t)
+	test.Nil(err, "Command should have successfully parsed the flags")
+	test.NotNil(root.Flags().Lookup("plugin"), "A flag --plugin should have been found on the command")
+	test.Equal("value", plugin.Plugin)
+}
+
+// TestGroupRegistered checks that groups registered by name are instantiated
+// for the commands using them, and that their options are parsed.
+func TestGroupRegistered(t *testing.T) {
+	t.Parallel()
+
+	flags.RegisterGroup("test-plugin", func() interface{} { return &pluginOptions{} })
+
+	type usingCommand struct {
+		Plugin interface{} `use-group:"test-plugin" namespace:"ext." persistent:"true"`
+	}
+
+	test := assert.New(t)
+
+	data := &usingCommand{}
+	root := newCommandWithArgs(data, []string{"--ext.plugin", "value"})
+	test.Nil(root.Execute(), "Command should have successfully parsed the flags")
+	test.Equal(&pluginOptions{Plugin: "value"}, data.Plugin, "The group should have been set on the field")
+
+	data = &usingCommand{}
+	_, err := Parse(data, []string{"-P", "value"})
+	test.Nil(err)
+	test.Equal(&pluginOptions{Plugin: "value"}, data.Plugin)
+
+	type unknownCommand struct {
+		Plugin interface{} `use-group:"test-unknown"`
+	}
+
+	_, err = Parse(&unknownCommand{}, nil)
+	test.ErrorIs(err, flags.ErrInvalidTag, "Unregistered groups should not be used")
+
+	test.Panics(func() {
+		flags.RegisterGroup("test-plugin", func() interface{} { return &pluginOptions{} })
+	}, "Groups should not be registered twice")
+}
+
+// TestGroupProviderNil checks that a nil provider does not contribute options.
+func TestGroupProviderNil(t *testing.T) {
+	t.Parallel()
+
+	data := &providerCommand{}
+	root := newCommandWithArgs(data, []string{})
+
+	test := assert.New(t)
+	test.False(root.Flags().HasFlags(), "No flags should have been generated")
+}
+
+// TestGroupEnvNamespace checks that env namespaces of nested
+// groups are composed into the env names of their options.
+func TestGroupEnvNamespace(t *testing.T) {
+	t.Setenv("APP_DB_USER", "admin")
+	t.Setenv("APP_DB_POOL_SIZE", "10")
+
+	data := struct {
+		Opts struct {
+			User string `long:"user" env:"USER"`
+			Pool struct {
+				Size int `long:"size" env:"SIZE"`
+			} `group:"pool" namespace:"pool" namespace-delimiter:"-" env-namespace:"POOL"`
+		} `group:"database" namespace:"db" namespace-delimiter:"-" env-namespace:"APP_DB" persistent:"yes"`
+
+		Command testCommand `command:"cmd"`
+	}{}
+
+	_, err := ParseArgs(&data, []string{"cmd", "--db-pool-size", "20"})
+
+	test := assert.New(t)
+	test.Nil(err, "Command-line should have been parsed successfully")
+	test.Equal("admin", data.Opts.User)
+	test.Equal(20, data.Opts.Pool.Size, "command-line words should override env values")
+}
+
+// TestCommandEnvNamespace checks that the options of commands tagged with an
+// env-namespace (and of their subcommands) have their env keys prefixed with it,
+// and that env values can be looked up with a custom function.
+func TestCommandEnvNamespace(t *testing.T) {
+	t.Parallel()
+
+	secrets := map[string]string{
+		"APP_VERBOSE":           "true",
+		"APP_DEPLOY_TOKEN":      "secret",
+		"APP_DEPLOY_PROD_IMAGE": "nginx",
+	}
+
+	lookup := func(key string) (string, bool) {
+		val, found := secrets[key]
+
+		return val, found
+	}
+
+	data := struct {
+		Verbose bool `long:"verbose" env:"VERBOSE"`
+
+		Deploy struct {
+			Token string `long:"token" env:"TOKEN"`
+
+			Prod struct {
+				Image string `long:"image" env:"IMAGE"`
+			} `command:"prod" env-namespace:"PROD"`
+		} `command:"deploy" env-namespace:"DEPLOY"`
+	}{}
+
+	opts := []flags.OptFunc{flags.EnvPrefix("APP_"), flags.WithEnvLookup(lookup)}
+
+	_, err := Parse(&data, []string{"deploy", "prod"}, opts...)
+
+	test := assert.New(t)
+	test.Nil(err, "Command-line should have been parsed successfully")
+	test.True(data.Verbose)
+	test.Equal("secret", data.Deploy.Token)
+	test.Equal("nginx", data.Deploy.Prod.Image)
+
+	cmd := Generate(&data, opts...)
+	prod, _, err := cmd.Find([]string{"deploy", "prod"})
+	test.Nil(err)
+	test.NotNil(prod.Flags().Lookup("image"))
+}
+
+// sharedOptions is a persistent group shared by several commands.
+type sharedOptions struct {
+	Debug bool `long:"debug"`
+}
+
+// TestGroupPersistentShared checks that a persistent group bound to the
+// same struct by a parent and its child is only bound once, on the parent.
+func TestGroupPersistentShared(t *testing.T) {
+	t.Parallel()
+
+	shared := &sharedOptions{}
+
+	data := struct {
+		Opts    *sharedOptions `group:"shared" persistent:"yes"`
+		Command struct {
+			Opts *sharedOptions `group:"shared" persistent:"yes"`
+			testCommand
+		} `command:"cmd"`
+	}{}
+
+	data.Opts = shared
+	data.Command.Opts = shared
+
+	root := newCommandWithArgs(&data, []string{"cmd", "--debug"})
+	cmd, err := root.ExecuteC()
+
+	test := assert.New(t)
+	test.Nil(err, "Command should have successfully parsed the flags")
+	test.Equal("cmd", cmd.Name())
+	test.Nil(cmd.PersistentFlags().Lookup("debug"), "Child should not bind the shared group again")
+	test.True(shared.Debug, "flag --debug should be true")
+	test.NotContains(root.Annotations, "flags-persistent", "Bound groups should not be visible in annotations")
+
+	Forget(root)
+	_, bound := boundPersistent(cmd, boundGroup{name: "shared", data: shared})
+	test.False(bound, "Forgotten commands should not keep their bound groups")
+}
+
+// sharedCommand is a command declaring its own struct of a persistent group of its parent.
+type sharedCommand struct {
+	Opts sharedOptions `group:"shared" persistent:"yes"`
+	Sub  struct {
+		Opts sharedOptions `group:"shared" persistent:"yes"`
+		testCommand
+	} `command:"sub"`
+	testCommand
+}
+
+// TestGroupPersistentCopies checks that the structs of a persistent group declared by a
+// command and its subcommands share the values of the group bound once, on the parent,
+// which are copied into them when the subcommands execute, with or without cobra.
+func TestGroupPersistentCopies(t *testing.T) {
+	t.Parallel()
+
+	type sharedRoot struct {
+		Opts    sharedOptions `group:"shared" persistent:"yes"`
+		Command sharedCommand `command:"cmd"`
+	}
+
+	test := assert.New(t)
+
+	data := sharedRoot{}
+	root := newCommandWithArgs(&data, []string{"cmd", "sub", "--debug"})
+	cmd, err := root.ExecuteC()
+
+	test.NoError(err)
+	test.Equal("sub", cmd.Name())
+	test.Nil(cmd.Parent().PersistentFlags().Lookup("debug"), "Subcommands should not bind the shared group again")
+	test.True(data.Opts.Debug, "The bound group should be set")
+	test.True(data.Command.Opts.Debug, "Parents of the executed command should get the shared values")
+	test.True(data.Command.Sub.Opts.Debug, "The executed command should get the shared values")
+
+	// Values are copied from the parsed ones on each execution.
+	Reset(root)
+	root.SetArgs([]string{"cmd", "sub"})
+	test.NoError(root.Execute())
+	test.False(data.Command.Sub.Opts.Debug, "Copies should not keep values of previous executions")
+
+	// Commands not executed keep their values.
+	data = sharedRoot{}
+	root = newCommandWithArgs(&data, []string{"--debug", "cmd"})
+	_, err = root.ExecuteC()
+	test.NoError(err)
+	test.True(data.Command.Opts.Debug)
+	test.False(data.Command.Sub.Opts.Debug, "Subcommands not executed should not get the shared values")
+
+	parsed := sharedRoot{}
+	_, err = Parse(&parsed, []string{"cmd", "sub", "--debug"})
+	test.NoError(err)
+	test.True(parsed.Command.Opts.Debug && parsed.Command.Sub.Opts.Debug, "Parse should copy shared values")
+}
+
+// TestGroupRequireOne checks that groups requiring some of their
+// options to be set fail the command-line when they are not.
+func TestGroupRequireOne(t *testing.T) {
+	t.Parallel()
+
+	type requireCommand struct {
+		Source struct {
+			File string `long:"file"`
+			URL  string `long:"url"`
+		} `group:"source" require-one:""`
+		Output struct {
+			JSON  bool `long:"json"`
+			YAML  bool `long:"yaml"`
+			Table bool `long:"table"`
+		} `group:"output" require-n:"2"`
+	}
+
+	test := assert.New(t)
+
+	data := &requireCommand{}
+	_, err := ParseArgs(data, []string{"--url", "host", "--json", "--table"})
+	test.Nil(err, "Required groups should be satisfied")
+
+	_, err = ParseArgs(&requireCommand{}, []string{"--json", "--table"})
+	test.ErrorIs(err, flags.ErrRequiredGroup, "At least one source option should be required")
+
+	_, err = ParseArgs(&requireCommand{}, []string{"--file", "path", "--yaml"})
+	test.ErrorIs(err, flags.ErrRequiredGroup, "At least two output options should be required")
+
+	root := Generate(&requireCommand{})
+	test.Contains(root.Flags().Lookup("file").Usage, "at least 1 of source options required")
+}
+
+// TestFlagRelations checks that options requiring or conflicting with other
+// options, or in groups requiring one of them, fail the command-line when needed.
+func TestFlagRelations(t *testing.T) {
+	t.Parallel()
+
+	type relationsCommand struct {
+		Key  string `long:"key" requires:"cert" one-required:"auth"`
+		Cert string `long:"cert"`
+		JSON bool   `long:"json" conflicts-with:"yaml,xml"`
+		YAML bool   `long:"yaml"`
+		XML  bool   `long:"xml"`
+
+		Token string `long:"token" alias:"tok" one-required:"auth"`
+	}
+
+	test := assert.New(t)
+
+	_, err := ParseArgs(&relationsCommand{}, []string{"--key", "k", "--cert", "c", "--json"})
+	test.Nil(err, "Relations should be satisfied")
+
+	_, err = ParseArgs(&relationsCommand{}, []string{"--key", "k"})
+	test.ErrorIs(err, flags.ErrRequiredFlag)
+	test.ErrorContains(err, "--key must be used with --cert")
+
+	_, err = ParseArgs(&relationsCommand{}, []string{"--tok", "t", "--json", "--xml", "--yaml"})
+	test.ErrorIs(err, flags.ErrConflictingFlags)
+	test.ErrorContains(err, "--json cannot be used with --yaml, --xml")
+
+	_, err = ParseArgs(&relationsCommand{}, []string{"--cert", "c"})
+	test.ErrorIs(err, flags.ErrRequiredGroup, "At least one auth option should be required")
+
+	_, err = Parse(&relationsCommand{}, []string{"--tok", "t", "--json", "--yaml"})
+	test.ErrorIs(err, flags.ErrConflictingFlags, "Relations should be checked without cobra")
+
+	// Options of namespaced groups are related to the options of the same namespace.
+	type namespacedCommand struct {
+		Cert string `long:"cert"`
+
+		TLS struct {
+			Key  string `long:"key" requires:"cert"`
+			Cert string `long:"cert"`
+		} `group:"tls" namespace:"tls" namespace-delimiter:"."`
+	}
+
+	_, err = ParseArgs(&namespacedCommand{}, []string{"--tls.key", "k", "--cert", "c"})
+	test.ErrorContains(err, "--tls.key must be used with --tls.cert")
+
+	_, err = Parse(&namespacedCommand{}, []string{"--tls.key", "k", "--tls.cert", "c"})
+	test.Nil(err, "Namespaced relations should be checked without cobra")
+
+	// Unknown options are reported when generating commands, not when parsing them.
+	type unknownCommand struct {
+		Key string `long:"key" requires:"certificate"`
+	}
+
+	_, err = ParseArgs(&unknownCommand{}, []string{})
+	test.ErrorIs(err, flags.ErrInvalidTag)
+	test.ErrorContains(err, "option --key requires unknown option --certificate")
+
+	_, err = Parse(&unknownCommand{}, []string{})
+	test.ErrorIs(err, flags.ErrInvalidTag, "Unknown options should be reported without cobra")
+
+	// Groups of options are formed across commands, with persistent ones.
+	type childCommand struct {
+		testCommand
+		Password string `long:"password" one-required:"login"`
+	}
+
+	type parentCommand struct {
+		Opts struct {
+			User string `long:"user" one-required:"login"`
+		} `group:"options" persistent:"true"`
+
+		Child childCommand `command:"child"`
+	}
+
+	root := Generate(&parentCommand{})
+	root.SetArgs([]string{"child", "--user", "me"})
+	test.Nil(root.Execute(), "Persistent options should satisfy groups of subcommands")
+
+	root = Generate(&parentCommand{})
+	root.SetArgs([]string{"child"})
+	root.SetOut(io.Discard)
+	root.SetErr(io.Discard)
+	test.ErrorIs(root.Execute(), flags.ErrRequiredGroup)
+}
+
+// TestFlagRequiredIf checks that options are required depending
+// on other options being set, having some value or not being set.
+func TestFlagRequiredIf(t *testing.T) {
+	t.Parallel()
+
+	type conditionsCommand struct {
+		Mode    string   `long:"mode"`
+		Host    string   `long:"host" required-if:"mode=remote" required-unless:"config,local"`
+		Config  string   `long:"config"`
+		Local   bool     `long:"local"`
+		Verbose bool     `long:"verbose"`
+		Log     string   `long:"log" required-if:"verbose" required-if:"features=trace"`
+		Feature []string `long:"features"`
+	}
+
+	test := assert.New(t)
+
+	_, err := ParseArgs(&conditionsCommand{}, []string{"--mode", "local", "--local"})
+	test.Nil(err, "Conditions should not apply")
+
+	_, err = ParseArgs(&conditionsCommand{}, []string{"--mode", "remote", "--config", "path"})
+	test.ErrorIs(err, flags.ErrRequiredFlag)
+	test.ErrorContains(err, "--host is required when --mode is remote")
+
+	_, err = ParseArgs(&conditionsCommand{}, []string{"--mode", "local"})
+	test.ErrorIs(err, flags.ErrRequiredFlag)
+	test.ErrorContains(err, "--host is required unless --config or --local is set")
+
+	_, err = ParseArgs(&conditionsCommand{}, []string{"--local", "--verbose"})
+	test.ErrorContains(err, "--log is required when --verbose is set")
+
+	_, err = ParseArgs(&conditionsCommand{}, []string{"--local", "--features", "a,trace"})
+	test.ErrorContains(err, "--log is required when --features is trace")
+
+	_, err = ParseArgs(&conditionsCommand{}, []string{"--local", "--features", "a,trace", "--log", "file"})
+	test.Nil(err)
+
+	_, err = Parse(&conditionsCommand{}, []string{"--mode", "remote", "--local"})
+	test.ErrorIs(err, flags.ErrRequiredFlag, "Conditions should be checked without cobra")
+}
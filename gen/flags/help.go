@@ -0,0 +1,379 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/positional"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// groupAnnotation stores, on each option of a group, the name of the group.
+	groupAnnotation = "flags-group"
+
+	// groupsAnnotation stores the names of the option groups of a command, in
+	// declaration order, separated by newlines, so that help usages keep it.
+	groupsAnnotation = "flags-groups"
+
+	// choicesAnnotation stores the values allowed for an option, if restricted.
+	choicesAnnotation = "flags-choices"
+)
+
+// UsageTemplate is the usage template of the commands generated by this package. Unlike the
+// default one of cobra, it shows the positional arguments of commands (with requirements and
+// choices) and their options in a section for each of their groups, with their choices, env
+// variables and requirements. Its headers are translated with the catalog of the options,
+// and styled, along with options, with the theme of the command when colored (see SetTheme).
+//
+// Applications can customize it with cobra's SetUsageTemplate on the root command, using the
+// following functions, along with those of cobra (rpad, trimTrailingWhitespaces, etc):
+//   - message:           a message of the library, translated (eg. `{{message . "#flags"}}`)
+//   - helpHeader:        a header styled with the theme of a command (eg. `{{helpHeader $ .Title}}`)
+//   - helpArgumentsLine: the placeholders of the positionals of a command (see Synopsis)
+//   - helpArguments:     the positionals of a command, as a list of HelpArgument
+//   - helpSections:      the sections of the options of a command, as a list of HelpSection
+const UsageTemplate = `{{helpHeader . (message . "#usage")}}{{if .Runnable}}
+  {{.UseLine}}{{end}}{{if .HasAvailableSubCommands}}
+  {{.CommandPath}} [command]{{end}}{{if gt (len .Aliases) 0}}
+
+{{helpHeader . (message . "#aliases")}}
+  {{.NameAndAliases}}{{end}}{{if .HasExample}}
+
+{{helpHeader . (message . "#examples")}}
+{{.Example}}{{end}}{{if .HasAvailableSubCommands}}{{$cmds := .Commands}}{{if eq (len .Groups) 0}}
+
+{{helpHeader . (message . "#available-commands")}}{{range $cmds}}{{if (or .IsAvailableCommand (eq .Name "help"))}}
+  {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{else}}{{range $group := .Groups}}
+
+{{helpHeader $ .Title}}{{range $cmds}}{{if (and (eq .GroupID $group.ID) (or .IsAvailableCommand (eq .Name "help")))}}
+  {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{if not .AllChildCommandsHaveGroup}}
+
+{{helpHeader . (message . "#additional-commands")}}{{range $cmds}}{{if (and (eq .GroupID "") (or .IsAvailableCommand (eq .Name "help")))}}
+  {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{end}}{{end}}{{with helpArguments .}}
+
+{{helpHeader $ (message $ "#arguments")}}{{range .}}
+  {{rpad .Name .Padding}}   {{.Usage}}{{end}}{{end}}{{range helpSections .}}
+
+{{helpHeader $ .Title}}
+{{.Usages | trimTrailingWhitespaces}}{{end}}{{if .HasHelpSubCommands}}
+
+{{helpHeader . (message . "#additional-help-topics")}}{{range .Commands}}{{if .IsAdditionalHelpTopicCommand}}
+  {{rpad .CommandPath .CommandPathPadding}} {{.Short}}{{end}}{{end}}{{end}}{{if .HasAvailableSubCommands}}
+
+{{message . "#more-info" .CommandPath}}{{end}}
+`
+
+// HelpSection is a section of the options of a command in help usages:
+// its options in no group, those of one of its groups, or those it inherits.
+type HelpSection struct {
+	Title     string        // The header of the section, translated
+	Group     string        // The name of the group of options, if any
+	Inherited bool          // The options are the persistent ones of parent commands
+	Flags     []*pflag.Flag // The (visible) options of the section
+	Usages    string        // The usages of the options, aligned, with their details (and styled)
+}
+
+// HelpArgument is a positional argument of a command in help usages.
+type HelpArgument struct {
+	Name    string // The name of the argument, or its translated placeholder
+	Usage   string // The description of the argument, with its requirement and choices (wrapped)
+	Padding int    // The width of the longest name of the arguments of the command
+}
+
+// helpPositionals are the positionals of the generated commands, for their help usages.
+var helpPositionals sync.Map
+
+// templateFuncs registers the functions of help and usage templates only once, since
+// cobra keeps them in a global map, which trees generated concurrently cannot write to.
+var templateFuncs sync.Once
+
+// helpUsages sets the group-aware usage template on the root command of a tree, and
+// registers the functions it uses (see UsageTemplate), styled for the output they go to.
+func helpUsages(cmd *cobra.Command) {
+	addTemplateFuncs()
+
+	cmd.SetUsageTemplate(UsageTemplate)
+	themeOutputs(cmd)
+}
+
+// addTemplateFuncs registers the functions of help and usage templates, if not already.
+func addTemplateFuncs() {
+	templateFuncs.Do(func() {
+		cobra.AddTemplateFunc("colors", colorsEnabled)
+		cobra.AddTemplateFunc("message", helpMessage)
+		cobra.AddTemplateFunc("helpHeader", helpHeader)
+		cobra.AddTemplateFunc("helpArgumentsLine", helpArgumentsLine)
+		cobra.AddTemplateFunc("helpArguments", helpArguments)
+		cobra.AddTemplateFunc("helpSections", helpSections)
+	})
+}
+
+// helpMessage returns a message of the library, translated with the catalog of the command tree.
+func helpMessage(cmd *cobra.Command, key string, args ...interface{}) string {
+	return treeCatalog(cmd).Message(key, args...)
+}
+
+// treeCatalog returns the catalog of the options the tree of a command was generated with.
+func treeCatalog(cmd *cobra.Command) flags.Catalog {
+	return flags.ScanOptions(rootOptions(cmd)...).Catalog
+}
+
+// synopses sets the Use line of the commands of a tree to their synopsis (see Synopsis),
+// unless it has been set to something else than the name of the command.
+func synopses(cmd *cobra.Command) {
+	for _, subc := range cmd.Commands() {
+		synopses(subc)
+	}
+
+	if cmd.Use == cmd.Name() {
+		cmd.Use = Synopsis(cmd)
+	}
+}
+
+// Synopsis returns the usage line of a command, generated from its options and positionals,
+// like `cp [flags] --mode MODE SOURCE... DEST`: the required options are shown with their
+// placeholders, and the positionals as with helpArgumentsLine, where optional ones are
+// bracketed and those accepting several words are dotted. Generated commands use it as
+// their Use line, unless the latter is set to something else than their name.
+func Synopsis(cmd *cobra.Command) string {
+	words := []string{cmd.Name()}
+
+	if !cmd.DisableFlagsInUseLine {
+		words = append(words, "[flags]")
+	}
+
+	cmd.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+		if required := flag.Annotations["flags"]; flag.Hidden || len(required) == 0 || required[0] != "required" {
+			return
+		}
+
+		word := "--" + flag.Name
+		if flag.Shorthand != "" {
+			word = "-" + flag.Shorthand
+		}
+
+		if name, _ := pflag.UnquoteUsage(flag); name != "" && flag.NoOptDefVal == "" {
+			word += " " + strings.ToUpper(name)
+		}
+
+		words = append(words, word)
+	})
+
+	if args := helpArgumentsLine(cmd); args != "" {
+		words = append(words, args)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// helpArgumentsLine returns the placeholders of the positionals of a command, like
+// `NAME [PROTO] [URLS...]`, where optional ones are bracketed and lists are dotted.
+func helpArgumentsLine(cmd *cobra.Command) string {
+	var words []string
+
+	for _, arg := range commandPositionals(cmd) {
+		word := strings.ToUpper(argumentName(arg))
+		if arg.Maximum < 0 || arg.Maximum > 1 {
+			word += "..."
+		}
+
+		if arg.Minimum == 0 {
+			word = "[" + word + "]"
+		}
+
+		words = append(words, word)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// helpArguments returns the positionals of a command, with their descriptions.
+func helpArguments(cmd *cobra.Command) []HelpArgument {
+	catalog := treeCatalog(cmd)
+	args := commandPositionals(cmd)
+	helpArgs := make([]HelpArgument, 0, len(args))
+	padding := 0
+
+	for _, arg := range args {
+		usage := argumentUsage(arg)
+
+		var details []string
+		if arg.Minimum > 0 {
+			details = append(details, catalog.Message(flags.MessageRequired))
+		}
+
+		if len(arg.Choices) > 0 {
+			details = append(details, catalog.Message(flags.MessageChoices, flags.SanitizeLine(strings.Join(arg.Choices, ", "))))
+		}
+
+		helpArg := HelpArgument{Name: argumentName(arg), Usage: withDetails(usage, details)}
+		if len(helpArg.Name) > padding {
+			padding = len(helpArg.Name)
+		}
+
+		helpArgs = append(helpArgs, helpArg)
+	}
+
+	// Descriptions are wrapped in their column, after the names and their padding.
+	columns := flags.HelpWidth(rootOptions(cmd)...)
+
+	for i := range helpArgs {
+		helpArgs[i].Padding = padding
+		helpArgs[i].Usage = wrap(helpArgs[i].Usage, padding+5, columns)
+	}
+
+	return helpArgs
+}
+
+// helpSections returns the sections of the options of a command: those in no group first,
+// then those of each of its groups in declaration order, and those inherited from parents.
+func helpSections(cmd *cobra.Command) []HelpSection {
+	catalog := treeCatalog(cmd)
+
+	local := HelpSection{Title: catalog.Message(flags.MessageFlags)}
+	inherited := HelpSection{Title: catalog.Message(flags.MessageGlobalFlags), Inherited: true}
+
+	groups := map[string]*HelpSection{}
+
+	var names []string
+
+	for _, name := range strings.Split(cmd.Annotations[groupsAnnotation], "\n") {
+		if name != "" {
+			groups[name] = &HelpSection{Title: catalog.Message(flags.MessageGroupFlags, name), Group: name}
+			names = append(names, name)
+		}
+	}
+
+	cmd.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+		if flag.Hidden {
+			return
+		}
+
+		section := &local
+		if group := flag.Annotations[groupAnnotation]; len(group) > 0 && groups[group[0]] != nil {
+			section = groups[group[0]]
+		}
+
+		section.Flags = append(section.Flags, flag)
+	})
+
+	cmd.InheritedFlags().VisitAll(func(flag *pflag.Flag) {
+		if !flag.Hidden {
+			inherited.Flags = append(inherited.Flags, flag)
+		}
+	})
+
+	sections := []*HelpSection{&local}
+	for _, name := range names {
+		sections = append(sections, groups[name])
+	}
+
+	sections = append(sections, &inherited)
+
+	var helpSections []HelpSection
+
+	for _, section := range sections {
+		if len(section.Flags) > 0 {
+			section.Usages = sectionUsages(section.Flags, catalog, flags.HelpWidth(rootOptions(cmd)...), helpTheme(cmd))
+			helpSections = append(helpSections, *section)
+		}
+	}
+
+	return helpSections
+}
+
+// sectionUsages returns the usages of the options of a section, aligned together (wrapped to
+// a number of columns, and styled with a theme), with the short names of options without a
+// long one, negative flags next to their options, and the details of options (requirement,
+// choices and env variable).
+func sectionUsages(options []*pflag.Flag, catalog flags.Catalog, columns int, theme Theme) string {
+	flagSet := pflag.NewFlagSet("help", pflag.ContinueOnError)
+
+	for _, flag := range options {
+		var details []string
+
+		if required := flag.Annotations["flags"]; len(required) > 0 && required[0] == "required" {
+			details = append(details, catalog.Message(flags.MessageRequired))
+		}
+
+		if choices := flag.Annotations[choicesAnnotation]; len(choices) > 0 {
+			details = append(details, catalog.Message(flags.MessageChoices, flags.SanitizeLine(strings.Join(choices, ", "))))
+		}
+
+		if env := flag.Annotations["env"]; len(env) > 0 && env[0] != "" {
+			details = append(details, catalog.Message(flags.MessageEnv, env[0]))
+		}
+
+		detailed := *flag
+		detailed.Usage = withDetails(flag.Usage, details)
+		flagSet.AddFlag(&detailed)
+	}
+
+	return flagUsages(flagSet, columns, theme)
+}
+
+// withDetails appends details to a usage, between parentheses.
+func withDetails(usage string, details []string) string {
+	if len(details) == 0 {
+		return usage
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("%s (%s)", usage, strings.Join(details, ", ")))
+}
+
+// commandPositionals returns the positionals of a command, if it has some.
+func commandPositionals(cmd *cobra.Command) []*positional.Arg {
+	args, found := helpPositionals.Load(cmd)
+	if !found {
+		return nil
+	}
+
+	return args.(*positional.Args).Positionals()
+}
+
+// argumentName returns the placeholder of a positional: its name, or its translation.
+func argumentName(arg *positional.Arg) string {
+	if name, _ := arg.Tag.Get("positional-arg-name"); name != "" {
+		return name
+	}
+
+	return arg.Name
+}
+
+// argumentUsage returns the description of a positional (or its translation).
+func argumentUsage(arg *positional.Arg) string {
+	usage, _ := arg.Tag.Get("description")
+	if usage == "" {
+		usage, _ = arg.Tag.Get("desc")
+	}
+
+	return flags.Sanitize(usage)
+}
+
+// setGroupAnnotations marks the options of a group with its name, and
+// adds the group to those of the command, for its help usage sections.
+func setGroupAnnotations(cmd *cobra.Command, flagSet *pflag.FlagSet, name string) {
+	if name == "" {
+		return
+	}
+
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		_ = flagSet.SetAnnotation(flag.Name, groupAnnotation, []string{name})
+	})
+
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+
+	for _, group := range strings.Split(cmd.Annotations[groupsAnnotation], "\n") {
+		if group == name {
+			return
+		}
+	}
+
+	cmd.Annotations[groupsAnnotation] = strings.TrimPrefix(cmd.Annotations[groupsAnnotation]+"\n"+name, "\n")
+}
@@ -0,0 +1,184 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/stretchr/testify/assert"
+)
+
+// helpCommand is a command with groups of options and positionals, for help tests.
+type helpCommand struct {
+	Mode string `long:"mode" short:"m" choice:"fetch" choice:"push" description:"fetch or push"`
+
+	Server struct {
+		Host string `long:"host" env:"HOST" description:"server host" required:"yes"`
+	} `group:"server" namespace:"server" namespace-delimiter:"."`
+
+	Args struct {
+		Name  string   `description:"name of the remote" required:"1"`
+		Proto string   `choice:"ssh https"`
+		URLs  []string `description:"remote urls"`
+	} `positional-args:"yes"`
+}
+
+func (c *helpCommand) Execute(args []string) error { return nil }
+
+// TestHelpUsage checks that help usages show positionals with their requirements,
+// and options in a section for each of their groups, along with inherited ones.
+func TestHelpUsage(t *testing.T) {
+	t.Parallel()
+
+	root := Generate(&struct {
+		Global struct {
+			Verbose bool `long:"verbose" short:"v" description:"verbose output"`
+		} `group:"global" persistent:"yes"`
+
+		Add helpCommand `command:"add" description:"add a remote"`
+	}{}, flags.WithHelpWidth(-1))
+
+	root.InitDefaultHelpFlag()
+
+	add, _, err := root.Find([]string{"add"})
+	assert.NoError(t, err)
+
+	add.InitDefaultHelpFlag()
+	usage := add.UsageString()
+
+	test := assert.New(t)
+	test.Contains(usage, add.CommandPath()+" [flags] --server.host STRING NAME [PROTO] [URLS...]\n")
+	test.Contains(usage, "Arguments:\n"+
+		"  Name    name of the remote (required)\n"+
+		"  Proto   (choices: ssh, https)\n"+
+		"  URLs    remote urls\n")
+	test.Contains(usage, "Flags:\n"+
+		"  -h, --help          help for add\n"+
+		"  -m, --mode string   fetch or push (choices: fetch, push)\n")
+	test.Contains(usage, "Flags (server):\n"+
+		"      --server.host string   server host (required, env: $SERVER_HOST)\n")
+	test.Contains(usage, "Global Flags:\n"+
+		"  -v, --verbose   verbose output\n")
+
+	test.Contains(root.UsageString(), "Flags (global):\n"+
+		"  -v, --verbose   verbose output\n", "Persistent groups should have their section")
+}
+
+// TestHelpUsageChoices checks that help usages show the choices of options and
+// positionals matched regardless of case with the spelling values are normalized to.
+func TestHelpUsageChoices(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Level string `long:"level" choice:"Debug" choice:"debug" choice:"Info" choice-case:"insensitive"`
+
+		Args struct {
+			Proto string `choice:"SSH ssh HTTPS" choice-case:"insensitive"`
+		} `positional-args:"yes"`
+	}{}
+
+	root := Generate(&data, flags.WithHelpWidth(-1))
+	usage := root.UsageString()
+
+	test := assert.New(t)
+	test.Contains(usage, "  Proto   (choices: SSH, HTTPS)\n")
+	test.Contains(usage, "--level string   (choices: Debug, Info)\n")
+
+	_, err := ParseArgs(&data, []string{"--level", "DEBUG", "ssh"})
+	test.NoError(err)
+	test.Equal("Debug", data.Level, "Values should be normalized to the choices shown")
+	test.Equal("SSH", data.Args.Proto, "Values should be normalized to the choices shown")
+}
+
+// TestHelpUsageWrapped checks that the descriptions of options and
+// positionals are wrapped in their column to the width of the help.
+func TestHelpUsageWrapped(t *testing.T) {
+	t.Parallel()
+
+	root := Generate(&struct {
+		Output string `long:"output" short:"o" description:"the file to which the results are written, instead of the standard output"`
+
+		Args struct {
+			Files []string `description:"the files to read the inputs from, instead of the standard input"`
+		} `positional-args:"yes"`
+	}{}, flags.WithHelpWidth(50))
+
+	usage := root.UsageString()
+
+	test := assert.New(t)
+	test.Contains(usage, "Arguments:\n"+
+		"  Files   the files to read the inputs from,\n"+
+		"          instead of the standard input\n")
+	test.Contains(usage, "Flags:\n"+
+		"  -o, --output string   the file to which the\n"+
+		"                        results are written,\n"+
+		"                        instead of the standard\n"+
+		"                        output\n")
+
+	unwrapped := Generate(&struct {
+		Output string `long:"output" description:"the file to which the results are written, instead of the standard output"`
+	}{}, flags.WithHelpWidth(-1))
+
+	test.Contains(unwrapped.UsageString(), "--output string   the file to which the results are written, instead of the standard output\n")
+}
+
+// TestSynopsis checks that the usage lines of commands show their required
+// options and positionals, with the semantics of their positional tags.
+func TestSynopsis(t *testing.T) {
+	t.Parallel()
+
+	root := Generate(&struct {
+		Copy struct {
+			Recursive bool   `long:"recursive" short:"r" description:"copy directories"`
+			Mode      string `long:"mode" description:"file mode" required:"yes"`
+			Verbose   bool   `long:"verbose" short:"v"`
+
+			Args struct {
+				Sources []string `required:"1"`
+				Dest    string   `required:"1"`
+			} `positional-args:"yes"`
+		} `command:"cp"`
+	}{})
+
+	test := assert.New(t)
+
+	cp, _, err := root.Find([]string{"cp"})
+	test.NoError(err)
+	test.Equal("cp [flags] --mode STRING SOURCES... DEST", cp.Use)
+	test.Equal("cp", cp.Name())
+
+	cp.DisableFlagsInUseLine = true
+	test.Equal("cp --mode STRING SOURCES... DEST", Synopsis(cp))
+}
+
+// TestHelpTheme checks that help usages are styled with the theme
+// of commands when colors are enabled, and not styled otherwise.
+func TestHelpTheme(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Mode string      `long:"mode" short:"m" description:"fetch or push"`
+		Run  testCommand `command:"run" description:"run it"`
+	}{Mode: "fetch"}
+
+	root := Generate(&data, flags.WithColors(flags.ColorAlways), flags.WithHelpWidth(-1))
+
+	test := assert.New(t)
+	test.Contains(root.UsageString(), "\x1b[1mFlags:\x1b[0m\n")
+	test.Contains(root.UsageString(), "  \x1b[36m-m, --mode\x1b[0m \x1b[33mstring\x1b[0m   fetch or push \x1b[2m(default \"fetch\")\x1b[0m\n")
+
+	run, _, err := root.Find([]string{"run"})
+	test.NoError(err)
+
+	SetTheme(root, &Theme{Flag: "35"})
+	test.Contains(run.UsageString(), "Flags:\n", "Themes should be inherited")
+	test.Contains(run.UsageString(), "  \x1b[35m-g\x1b[0m")
+
+	SetTheme(run, &Theme{})
+	test.NotContains(run.UsageString(), "\x1b[", "Empty themes should not style usages")
+
+	uncolored := Generate(&data, flags.WithColors(flags.ColorNever), flags.WithHelpWidth(-1))
+	test.NotContains(uncolored.UsageString(), "\x1b[")
+
+	auto := Generate(&data, flags.WithEnviron(nil), flags.WithHelpWidth(-1))
+	test.NotContains(auto.UsageString(), "\x1b[", "Usages not written to terminals should not be styled")
+}
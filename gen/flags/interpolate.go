@@ -0,0 +1,134 @@
+package flags
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// interpolateAnnotation marks the string options whose values are templates,
+// executed once the command-line is parsed (`interpolate` tag).
+const interpolateAnnotation = "flags-interpolate"
+
+// interpolation is the data given to the templates of interpolated options.
+type interpolation struct {
+	// Flags are the values of the options of the command, indexed by their
+	// name and by its camel-case form (ex: "output-dir" and "OutputDir").
+	Flags map[string]string
+}
+
+// interpolateFlags makes each command of the tree having interpolated options (or whose
+// parents have some) execute their templates once parsed and resolved (eg. from the
+// environment or the sources of a Resolver), before checking its option groups.
+func interpolateFlags(cmd *cobra.Command, opts []flags.OptFunc) {
+	hasInterpolated := false
+
+	for parent := cmd; parent != nil && !hasInterpolated; parent = parent.Parent() {
+		parent.Flags().VisitAll(func(flag *pflag.Flag) {
+			if _, isSet := flag.Annotations[interpolateAnnotation]; isSet {
+				hasInterpolated = true
+			}
+		})
+	}
+
+	for _, subc := range cmd.Commands() {
+		interpolateFlags(subc, opts)
+	}
+
+	if !hasInterpolated {
+		return
+	}
+
+	preRun(cmd, func(cmd *cobra.Command, _ []string) error {
+		// Options of parents might be given before the name of the command.
+		flagSets := []*pflag.FlagSet{cmd.Flags()}
+		for parent := cmd.Parent(); parent != nil; parent = parent.Parent() {
+			flagSets = append(flagSets, parent.LocalNonPersistentFlags())
+		}
+
+		return commandErrors(cmd, interpolate(flagSets, opts))
+	})
+}
+
+// interpolate executes the templates of the interpolated options of some flag sets,
+// with the values of all their options (as parsed, not interpolated) and the
+// environment (with the env function), and sets the options to their results.
+func interpolate(flagSets []*pflag.FlagSet, opts []flags.OptFunc) error {
+	data := interpolation{Flags: make(map[string]string)}
+
+	for _, flagSet := range flagSets {
+		flagSet.VisitAll(func(flag *pflag.Flag) {
+			value := flag.Value.String()
+
+			// Repeatable options are formatted between brackets.
+			if strings.HasSuffix(flag.Value.Type(), "Slice") {
+				value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+			}
+
+			data.Flags[flag.Name] = value
+
+			if camel := camelCase(flag.Name); data.Flags[camel] == "" {
+				data.Flags[camel] = value
+			}
+		})
+	}
+
+	funcs := template.FuncMap{
+		"env": func(name string) string {
+			value, _ := flags.ScanOptions(opts...).LookupEnv(name)
+
+			return value
+		},
+	}
+
+	var err error
+
+	for _, flagSet := range flagSets {
+		flagSet.VisitAll(func(flag *pflag.Flag) {
+			if _, isSet := flag.Annotations[interpolateAnnotation]; err == nil && isSet {
+				err = interpolateFlag(flag, data, funcs)
+			}
+		})
+	}
+
+	return err
+}
+
+// interpolateFlag executes the template of an interpolated option, if its value is one.
+func interpolateFlag(flag *pflag.Flag, data interpolation, funcs template.FuncMap) error {
+	text := flag.Value.String()
+	if !strings.Contains(text, "{{") {
+		return nil
+	}
+
+	tmpl, err := template.New(flag.Name).Funcs(funcs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return optionError(flags.ErrInvalidValue, flag.Name, "", "cannot interpolate --%s: %s", flag.Name, err.Error())
+	}
+
+	var value strings.Builder
+
+	if err := tmpl.Execute(&value, data); err != nil {
+		return optionError(flags.ErrInvalidValue, flag.Name, "", "cannot interpolate --%s: %s", flag.Name, err.Error())
+	}
+
+	if err := flag.Value.Set(value.String()); err != nil {
+		return optionError(flags.ErrInvalidValue, flag.Name, "", "cannot interpolate --%s: %s", flag.Name, err.Error())
+	}
+
+	return nil
+}
+
+// camelCase returns the camel-case form of an option name (ex: "OutputDir" for "output-dir").
+func camelCase(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool { return r == '-' || r == '_' || r == '.' })
+
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+
+	return strings.Join(words, "")
+}
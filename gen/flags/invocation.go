@@ -0,0 +1,151 @@
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ErrInvocation indicates that an invocation cannot be replayed by a command tree.
+var ErrInvocation = errors.New("invalid invocation")
+
+// Invocation is a command-line parsed by a command tree, as the command it targets, the
+// values of the options set, and its arguments. It can be marshaled (eg. to JSON, to store
+// it in job queues or audit logs), and replayed by another tree generated from the same
+// command structs, possibly in another process (see Invoked and Invocation.Replay).
+type Invocation struct {
+	Command  []string            `json:"command,omitempty"`   // The path of the command, without the root one
+	Options  map[string][]string `json:"options,omitempty"`   // The values of the options set, by long name
+	Args     []string            `json:"args,omitempty"`      // The positionals and remaining arguments
+	DashArgs []string            `json:"dash_args,omitempty"` // The arguments given after a double dash
+}
+
+// Invoked returns the invocation of a command, once its command-line has been parsed (eg.
+// in one of its runners, or once its tree has been executed). The options are those set on
+// the command or on its parents, either with their names, or with their aliases, previous
+// names or negative flags. Lists and maps have a value for each of their elements.
+func Invoked(cmd *cobra.Command) Invocation {
+	var invocation Invocation
+
+	for parent := cmd; parent.HasParent(); parent = parent.Parent() {
+		invocation.Command = append([]string{parent.Name()}, invocation.Command...)
+	}
+
+	for parent := cmd; parent != nil; parent = parent.Parent() {
+		parent.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+			if len(flag.Annotations[aliasAnnotation]) > 0 {
+				return
+			}
+
+			// Options might be set on the command-line of any of the commands inheriting them.
+			if !isFlagSet(cmd.Flags(), flag) && !isFlagSet(parent.Flags(), flag) {
+				return
+			}
+
+			if invocation.Options == nil {
+				invocation.Options = make(map[string][]string)
+			}
+
+			invocation.Options[flag.Name], _ = optionValues(flag.Value)
+		})
+	}
+
+	args := cmd.Flags().Args()
+
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 && dash <= len(args) {
+		invocation.Args, invocation.DashArgs = args[:dash], args[dash:]
+	} else {
+		invocation.Args = args
+	}
+
+	return invocation
+}
+
+// Words returns the command-line of an invocation (without the program name) for a command
+// tree: each option follows the command declaring it, and lists and maps are given once for
+// each of their elements. It fails if the tree has not the command or one of the options.
+func (inv Invocation) Words(root *cobra.Command) ([]string, error) {
+	var words []string
+
+	names := sortedNames(inv.Options)
+	options := make(map[string]bool, len(names))
+
+	cmd := root
+
+	for i := 0; i <= len(inv.Command); i++ {
+		if i > 0 {
+			cmd = subcommand(cmd, inv.Command[i-1])
+			if cmd == nil {
+				return nil, fmt.Errorf("%w: unknown command %q", ErrInvocation, strings.Join(inv.Command[:i], " "))
+			}
+
+			words = append(words, cmd.Name())
+		}
+
+		for _, name := range names {
+			if options[name] || cmd.LocalFlags().Lookup(name) == nil {
+				continue
+			}
+
+			options[name] = true
+
+			for _, value := range inv.Options[name] {
+				words = append(words, "--"+name+"="+value)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if !options[name] {
+			return nil, fmt.Errorf("%w: unknown option --%s", ErrInvocation, name)
+		}
+	}
+
+	words = append(words, inv.Args...)
+
+	if len(inv.DashArgs) > 0 {
+		words = append(append(words, "--"), inv.DashArgs...)
+	}
+
+	return words, nil
+}
+
+// Replay executes an invocation on a command tree, which should be newly generated from
+// the same command structs as the tree the invocation comes from, or reset (see Reset).
+func (inv Invocation) Replay(root *cobra.Command) error {
+	words, err := inv.Words(root)
+	if err != nil {
+		return err
+	}
+
+	root.SetArgs(words)
+
+	return root.Execute()
+}
+
+// subcommand returns the subcommand of a command with a name, or nil if there is none.
+func subcommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, subc := range cmd.Commands() {
+		if subc.Name() == name {
+			return subc
+		}
+	}
+
+	return nil
+}
+
+// sortedNames returns the names of the options of an invocation, sorted.
+func sortedNames(options map[string][]string) []string {
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,82 @@
+package flags
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// invokedCommand is a command with various kinds of options and positionals.
+type invokedCommand struct {
+	Tags   []string          `long:"tag" short:"t" alias:"label" description:"tags"`
+	Env    map[string]string `long:"env" short:"e" description:"environment"`
+	Color  bool              `long:"color" negatable:"" description:"colors"`
+	Params struct {
+		Name string
+	} `positional-args:"yes"`
+}
+
+func (c *invokedCommand) Execute(args []string) error { return nil }
+
+// invokedRoot is a root command with a local option and a persistent group.
+type invokedRoot struct {
+	Verbose bool `long:"verbose" short:"v" description:"verbose"`
+	Global  struct {
+		Config string `long:"config" short:"c" description:"config file"`
+	} `group:"global" persistent:"true"`
+	Run invokedCommand `command:"run"`
+}
+
+// TestInvocation checks that the invocations of commands are marshaled
+// and replayed on other trees, giving them the same values and arguments.
+func TestInvocation(t *testing.T) {
+	t.Parallel()
+
+	source := invokedRoot{Run: invokedCommand{Color: true}}
+	root := Generate(&source)
+	root.SetArgs([]string{
+		"-v", "run", "-c", "app.yml", "--label", "a,b", "-t", "c", "-e", "k:v",
+		"--no-color", "first", "extra", "--", "--raw",
+	})
+
+	cmd, err := root.ExecuteC()
+	require.NoError(t, err)
+
+	invocation := Invoked(cmd)
+	assert.Equal(t, Invocation{
+		Command: []string{"run"},
+		Options: map[string][]string{
+			"verbose": {"true"},
+			"config":  {"app.yml"},
+			"tag":     {"a", "b", "c"},
+			"env":     {"k:v"},
+			"color":   {"false"},
+		},
+		Args:     []string{"first", "extra"},
+		DashArgs: []string{"--raw"},
+	}, invocation)
+
+	marshaled, err := json.Marshal(invocation)
+	require.NoError(t, err)
+
+	var replayed Invocation
+	require.NoError(t, json.Unmarshal(marshaled, &replayed))
+
+	target := invokedRoot{Run: invokedCommand{Color: true}}
+	replayRoot := Generate(&target)
+
+	words, err := replayed.Words(replayRoot)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"--config=app.yml", "--verbose=true", "run", "--color=false", "--env=k:v",
+		"--tag=a", "--tag=b", "--tag=c", "first", "extra", "--", "--raw",
+	}, words)
+
+	require.NoError(t, replayed.Replay(replayRoot))
+	assert.Equal(t, source, target)
+
+	replayed.Options["unknown"] = []string{"value"}
+	assert.ErrorIs(t, replayed.Replay(Generate(&invokedRoot{})), ErrInvocation)
+}
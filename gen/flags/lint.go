@@ -0,0 +1,155 @@
+package flags
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/scan"
+	"github.com/spf13/pflag"
+)
+
+// TokenKind is the kind of a command-line word, as classified by Tokenize.
+type TokenKind int
+
+const (
+	// TokenCommand is the name (or an alias) of a subcommand.
+	TokenCommand TokenKind = iota
+	// TokenFlag is a word made of one (long) or more (short) flags, possibly with a value.
+	TokenFlag
+	// TokenFlagValue is the value of the flag given in the previous word.
+	TokenFlagValue
+	// TokenUnknownFlag is a word starting with a dash, but matching no flag of its command.
+	TokenUnknownFlag
+	// TokenDash is the `--` word, after which all words are positionals.
+	TokenDash
+	// TokenPositional is a positional argument of the command.
+	TokenPositional
+)
+
+// String returns the name of the token kind, eg. for use as a highlighting class.
+func (k TokenKind) String() string {
+	switch k {
+	case TokenCommand:
+		return "command"
+	case TokenFlag:
+		return "flag"
+	case TokenFlagValue:
+		return "flag-value"
+	case TokenUnknownFlag:
+		return "unknown-flag"
+	case TokenDash:
+		return "dash"
+	case TokenPositional:
+		return "positional"
+	default:
+		return "invalid"
+	}
+}
+
+// Token is a command-line word classified by Tokenize.
+type Token struct {
+	Index   int       // Index of the word in the command-line
+	Word    string    // The word itself
+	Kind    TokenKind // What the word is to its command
+	Command []string  // Path of the command the word is given to (without the root name)
+	Flag    string    // For flag words and values, the name of the flag taking a value (or the last one)
+}
+
+// Tokenize classifies each word of a command-line as the parser would see it, without
+// parsing any value: subcommand names, flags (and the values they take from the next word),
+// unknown flags, the `--` word and positionals, along with the command they are given to and
+// the flag they bind to. This is meant for linters validating scripts, or for highlighting
+// command-lines in consoles. The data struct is only scanned: neither the command-line nor
+// the environment set values on it, but its nil command struct fields are allocated, and its
+// empty fields set from their `default` tags with WithTagDefaults, as when generating commands.
+func Tokenize(data interface{}, args []string, opts ...flags.OptFunc) ([]Token, error) {
+	root := &parser{
+		data:       data,
+		local:      newParseFlagSet(),
+		persistent: newParseFlagSet(),
+	}
+
+	if err := scan.Type(data, parseScanner(root, opts)); err != nil {
+		return nil, err
+	}
+
+	cmd, flagSet := root, root.flagSet()
+	tokens := make([]Token, 0, len(args))
+	dash, positionals := false, false
+
+	for i := 0; i < len(args); i++ {
+		token := Token{Index: i, Word: args[i], Command: cmd.path()}
+		arg := args[i]
+
+		switch {
+		case dash:
+			token.Kind = TokenPositional
+		case arg == "--":
+			token.Kind, dash = TokenDash, true
+		case len(arg) > 1 && arg[0] == '-':
+			name, _, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+			takesValue, isKnown := lookupFlagWord(flagSet, arg, name)
+
+			if !isKnown {
+				token.Kind = TokenUnknownFlag
+
+				break
+			}
+
+			token.Kind, token.Flag = TokenFlag, flagWordName(flagSet, arg, name)
+
+			// The flag value is the next word.
+			if takesValue && !hasValue && i+1 < len(args) {
+				tokens = append(tokens, token)
+				i++
+				token = Token{Index: i, Word: args[i], Kind: TokenFlagValue, Command: token.Command, Flag: token.Flag}
+			}
+		case !positionals && cmd.lookup(arg) != nil:
+			cmd = cmd.lookup(arg)
+			flagSet = cmd.flagSet()
+			token.Kind = TokenCommand
+		default:
+			token.Kind, positionals = TokenPositional, true
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+// Check returns the error that parsing a command-line would return, if any, without
+// executing or modifying anything: it is parsed onto a new (zero) value of the data type.
+func Check(data interface{}, args []string, opts ...flags.OptFunc) error {
+	dataType := reflect.TypeOf(data)
+	if dataType == nil || dataType.Kind() != reflect.Ptr {
+		return flags.ErrNotPointerToStruct
+	}
+
+	if err := flags.CheckArgs(args, opts...); err != nil {
+		return err
+	}
+
+	_, _, err := parseTarget(reflect.New(dataType.Elem()).Interface(), args, opts)
+
+	return err
+}
+
+// flagWordName returns the name of the flag given in a (known) flag word: for
+// shorthands, this is the one taking a value, or the last one of the word.
+func flagWordName(flagSet *pflag.FlagSet, arg, name string) string {
+	if strings.HasPrefix(arg, "--") {
+		return flagSet.Lookup(name).Name
+	}
+
+	var flag *pflag.Flag
+
+	for _, short := range name {
+		if flag = flagSet.ShorthandLookup(string(short)); flag.NoOptDefVal == "" {
+			break
+		}
+	}
+
+	return flag.Name
+}
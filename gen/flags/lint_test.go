@@ -0,0 +1,52 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/reeflective/flags/internal/positional"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTokenize checks that command-line words are classified as the parser sees them.
+func TestTokenize(t *testing.T) {
+	t.Parallel()
+
+	data := serverCommand{}
+	args := []string{"-v", "--profile", "prod", "dp", "--image=nginx", "--bad", "web", "deploy", "--", "-x"}
+
+	tokens, err := Tokenize(&data, args)
+	require.NoError(t, err)
+	require.Len(t, tokens, len(args))
+
+	kinds := make([]TokenKind, len(tokens))
+	for i, token := range tokens {
+		kinds[i] = token.Kind
+	}
+
+	test := assert.New(t)
+	test.Equal([]TokenKind{
+		TokenFlag, TokenFlag, TokenFlagValue, TokenCommand, TokenFlag,
+		TokenUnknownFlag, TokenPositional, TokenPositional, TokenDash, TokenPositional,
+	}, kinds)
+
+	test.Equal("verbose", tokens[0].Flag)
+	test.Equal("profile", tokens[2].Flag, "Flag values should bind to their flag")
+	test.Nil(tokens[2].Command)
+	test.Equal([]string{"deploy"}, tokens[4].Command, "Words after a command should be given to it")
+	test.Equal("image", tokens[4].Flag)
+	test.Equal("flag-value", tokens[2].Kind.String())
+	test.Equal(serverCommand{}, data, "Data should not be modified")
+}
+
+// TestCheck checks that command-lines are checked without modifying the data.
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	data := serverCommand{}
+
+	test := assert.New(t)
+	test.NoError(Check(&data, []string{"deploy", "--image", "nginx", "web"}))
+	test.ErrorIs(Check(&data, []string{"deploy", "--image", "nginx"}), positional.ErrRequired)
+	test.Equal(serverCommand{}, data, "Data should not be modified")
+}
@@ -0,0 +1,69 @@
+package flags
+
+import (
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+// RunFunc is the implementation of a command, as run by cobra once its command-line is parsed.
+type RunFunc func(cmd *cobra.Command, args []string) error
+
+// Middleware returns the implementation of a command wrapping the next one, which it may
+// call or not (eg. to time commands, log or trace them, check permissions, or recover
+// from their panics), with the same command and arguments, or with other arguments.
+type Middleware func(next RunFunc) RunFunc
+
+// middlewares are the middlewares used by commands.
+var middlewares sync.Map
+
+// Use adds middlewares around the implementations of a command and of its subcommands,
+// including those added later. The first middleware is the outermost one, and those of
+// parents wrap those of their subcommands, like persistent pre-runners run before those
+// of subcommands. Pre-runners run before all middlewares, and post-runners after them.
+func Use(cmd *cobra.Command, middleware ...Middleware) {
+	used, _ := middlewares.Load(cmd)
+	chain, _ := used.([]Middleware)
+
+	middlewares.Store(cmd, append(chain[:len(chain):len(chain)], middleware...))
+}
+
+// useMiddlewares makes the commands of a tree run through the
+// middlewares of their own and of their parents, when they are run.
+func useMiddlewares(cmd *cobra.Command) {
+	for _, subc := range cmd.Commands() {
+		useMiddlewares(subc)
+	}
+
+	var next RunFunc
+
+	switch {
+	case cmd.RunE != nil:
+		next = cmd.RunE
+	case cmd.Run != nil:
+		run := cmd.Run
+		next = func(cmd *cobra.Command, args []string) error {
+			run(cmd, args)
+
+			return nil
+		}
+	default:
+		return
+	}
+
+	cmd.Run = nil
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		run := next
+
+		for parent := cmd; parent != nil; parent = parent.Parent() {
+			used, _ := middlewares.Load(parent)
+			chain, _ := used.([]Middleware)
+
+			for i := len(chain) - 1; i >= 0; i-- {
+				run = chain[i](run)
+			}
+		}
+
+		return run(cmd, args)
+	}
+}
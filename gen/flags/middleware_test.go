@@ -0,0 +1,60 @@
+package flags
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMiddlewares checks that commands run through the middlewares of their parents,
+// then through their own, in order, and that middlewares may not run commands.
+func TestMiddlewares(t *testing.T) {
+	t.Parallel()
+
+	rootData := struct {
+		Copy copyCommand `command:"copy"`
+	}{}
+
+	root := Generate(&rootData)
+	copyCmd, _, _ := root.Find([]string{"copy"})
+
+	var calls []string
+
+	logged := func(name string) Middleware {
+		return func(next RunFunc) RunFunc {
+			return func(cmd *cobra.Command, args []string) error {
+				calls = append(calls, name+">")
+				err := next(cmd, args)
+				calls = append(calls, "<"+name)
+
+				return err
+			}
+		}
+	}
+
+	root.PersistentPreRun = func(*cobra.Command, []string) { calls = append(calls, "pre") }
+
+	Use(root, logged("a"), logged("b"))
+	Use(copyCmd, logged("c"))
+
+	root.SetArgs([]string{"copy", "src", "dst"})
+	require.NoError(t, root.Execute())
+	assert.True(t, rootData.Copy.executed)
+	assert.Equal(t, []string{"pre", "a>", "b>", "c>", "<c", "<b", "<a"}, calls)
+
+	// Middlewares might not call the next implementation.
+	errDenied := errors.New("denied")
+
+	Use(copyCmd, func(RunFunc) RunFunc {
+		return func(*cobra.Command, []string) error { return errDenied }
+	})
+
+	rootData.Copy.executed = false
+	root.SilenceErrors, root.SilenceUsage = true, true
+	root.SetArgs([]string{"copy", "src", "dst"})
+	assert.ErrorIs(t, root.Execute(), errDenied)
+	assert.False(t, rootData.Copy.executed)
+}
@@ -0,0 +1,146 @@
+package flags
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// originAnnotation stores, on options whose value has been set once generated by other
+// means than the command-line (configurations, resolvers), where it comes from.
+const originAnnotation = "flags-origin"
+
+// errFieldFound stops walking a struct once the option bound to a field is found.
+var errFieldFound = errors.New("field found")
+
+// Origin returns where the value of the option bound to a field of a struct comes from:
+// OriginCLI if given on the command-line, OriginEnv if read from its environment variable,
+// OriginConfig if read from a configuration (by ParseConfig or a ConfigSource), the name
+// of the source it was read from (see flags.WithSource and Resolver), or OriginDefault.
+//
+// The command is the one generated from the struct: the root returned by Generate(), the
+// command given to Bind, or the one returned by AddCommand. The field is the name of a field
+// of this struct, or a path of field names separated by dots for fields of nested structs (eg.
+// "Server.Port"). The origin is empty if there is no such option. Pflag's Changed only tells
+// if an option has been given on the command-line, not whether it has been set by other means.
+func Origin(cmd *cobra.Command, data interface{}, field string) string {
+	ptr := fieldPointer(data, field)
+	if ptr == nil {
+		return ""
+	}
+
+	var (
+		path []string
+		name string
+	)
+
+	err := flags.Walk(data, flags.VisitorFuncs{
+		Flag: func(cmd *flags.Command, _ *flags.Group, flag *flags.Flag) error {
+			if flag.Field != ptr {
+				return nil
+			}
+
+			path, name = cmd.Path, flag.Name
+
+			return errFieldFound
+		},
+	}, rootOptions(cmd)...)
+
+	if !errors.Is(err, errFieldFound) {
+		return ""
+	}
+
+	for _, word := range path {
+		if cmd = subcommand(cmd, word); cmd == nil {
+			return ""
+		}
+	}
+
+	return FlagOrigin(cmd, name)
+}
+
+// Changed returns true if the value of the option bound to a field of a struct has been
+// set by any means (see Origin), that is, if the field does not have its default value.
+func Changed(cmd *cobra.Command, data interface{}, field string) bool {
+	origin := Origin(cmd, data, field)
+
+	return origin != "" && origin != OriginDefault
+}
+
+// FlagOrigin is like Origin, for an option of a command (or one inherited from its parents)
+// named name. It is empty if the command has no such option. Aliases and previous names of
+// options have the origin of the latter.
+func FlagOrigin(cmd *cobra.Command, name string) string {
+	for parent := cmd; parent != nil; parent = parent.Parent() {
+		flag := parent.Flags().Lookup(name)
+		if flag == nil {
+			continue
+		}
+
+		if target := flag.Annotations[aliasAnnotation]; len(target) > 0 {
+			return FlagOrigin(cmd, target[0])
+		}
+
+		if flag.Changed || aliasChanged(cmd, flag) {
+			return OriginCLI
+		}
+
+		return valueOrigin(flag)
+	}
+
+	return ""
+}
+
+// valueOrigin returns where the value of an option not given on the command-line comes from.
+func valueOrigin(flag *pflag.Flag) string {
+	if origin := flag.Annotations[originAnnotation]; len(origin) > 0 {
+		return origin[0]
+	}
+
+	return OriginDefault
+}
+
+// fieldPointer returns the pointer to a field of a struct, by path, or nil if there is none.
+func fieldPointer(data interface{}, path string) interface{} {
+	val := reflect.ValueOf(data)
+
+	for _, name := range strings.Split(path, ".") {
+		for val.Kind() == reflect.Ptr && !val.IsNil() {
+			val = val.Elem()
+		}
+
+		if val.Kind() != reflect.Struct {
+			return nil
+		}
+
+		if val = val.FieldByName(name); !val.IsValid() {
+			return nil
+		}
+	}
+
+	if !val.CanAddr() || !val.Addr().CanInterface() {
+		return nil
+	}
+
+	return val.Addr().Interface()
+}
+
+// setOrigin records where the value set on an option once generated comes from.
+func setOrigin(flag *pflag.Flag, origin string) {
+	if flag.Annotations == nil {
+		flag.Annotations = make(map[string][]string)
+	}
+
+	flag.Annotations[originAnnotation] = []string{origin}
+}
+
+// forgetOrigins forgets the origins of the values set on the options of a flag set.
+func forgetOrigins(flagSet *pflag.FlagSet) {
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		delete(flag.Annotations, originAnnotation)
+	})
+}
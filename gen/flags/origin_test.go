@@ -0,0 +1,84 @@
+package flags
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// originSource is a source of default values for options.
+type originSource map[string]string
+
+func (s originSource) Name() string { return "vault" }
+
+func (s originSource) Resolve(key string) (string, bool) {
+	value, found := s[key]
+
+	return value, found
+}
+
+// TestOrigin checks that the origins of the values of options are
+// known by struct field, whichever way they have been set.
+func TestOrigin(t *testing.T) {
+	t.Parallel()
+
+	type originRoot struct {
+		Verbose bool   `long:"verbose" alias:"debug"`
+		User    string `long:"user" env:"APP_USER"`
+		Token   string `long:"token" source:"vault:token"`
+		Level   string `long:"level"`
+		Server  struct {
+			Host string `long:"host"`
+			Port int    `long:"port"`
+		} `group:"server" namespace:"server" namespace-delimiter:"."`
+	}
+
+	opts := []flags.OptFunc{
+		flags.WithEnviron([]string{"APP_USER=env"}),
+		flags.WithSource(originSource{"token": "secret"}),
+	}
+
+	data := &originRoot{}
+	root := Generate(data, opts...)
+	NewResolver(opts...).Bind(root)
+	root.RunE = func(*cobra.Command, []string) error { return nil }
+
+	require.NoError(t, ParseConfig(root, strings.NewReader("level = info\n[server]\nport = 8080\n")))
+
+	root.SetArgs([]string{"--debug", "--server.host", "localhost"})
+	require.NoError(t, root.Execute())
+
+	for field, origin := range map[string]string{
+		"Verbose":     OriginCLI,
+		"User":        OriginEnv,
+		"Token":       "vault",
+		"Level":       OriginConfig,
+		"Server.Host": OriginCLI,
+		"Server.Port": OriginConfig,
+	} {
+		assert.Equal(t, origin, Origin(root, data, field), field)
+		assert.True(t, Changed(root, data, field), field)
+	}
+
+	assert.Equal(t, OriginCLI, FlagOrigin(root, "verbose"))
+	assert.Equal(t, OriginCLI, FlagOrigin(root, "debug"))
+	assert.Equal(t, OriginConfig, FlagOrigin(root, "server.port"))
+	assert.Empty(t, FlagOrigin(root, "none"))
+	assert.Empty(t, Origin(root, data, "Server.None"))
+
+	// Origins are kept by tree: generating another one from the struct does not change them.
+	other := Generate(data, opts...)
+	assert.Equal(t, OriginDefault, Origin(other, data, "Level"))
+	assert.Equal(t, OriginConfig, Origin(root, data, "Level"))
+
+	// Values set once generated are forgotten with them.
+	Reset(root)
+
+	assert.Equal(t, OriginDefault, Origin(root, data, "Level"))
+	assert.Equal(t, OriginDefault, Origin(root, data, "User"), "env values should be set again when run")
+	assert.False(t, Changed(root, data, "Verbose"))
+}
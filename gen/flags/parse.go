@@ -0,0 +1,506 @@
+package flags
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/positional"
+	"github.com/reeflective/flags/internal/scan"
+	"github.com/reeflective/flags/internal/tag"
+	"github.com/spf13/pflag"
+)
+
+// parser is a command found when scanning a struct for Parse, holding
+// everything needed to parse its words without any cobra command.
+type parser struct {
+	name        string
+	aliases     []string
+	parent      *parser
+	subcommands []*parser
+	data        interface{}
+	local       *pflag.FlagSet
+	persistent  *pflag.FlagSet
+	args        *positional.Args
+	env         []*flags.Flag // Options only set from the environment
+	bound       []boundGroup  // Persistent groups bound to this command
+	copies      []groupCopy   // Persistent groups of parents, bound to other structs
+	structs     []interface{} // Command, positionals and local groups structs
+}
+
+// Parse scans the data struct for commands, options and positionals, and parses the
+// args onto it, like ParseArgs, but without ever building any cobra command: only the
+// struct state matters, as on the server side of an application executing commands
+// remotely, where the command tree (help, completions, runners) is of no use.
+//
+// The whole parsing pipeline is still run: options (then their env values), toggles,
+// unknown flags and raw arguments fields, positionals with their requirements, value
+// validators and groups requiring some of their options. It returns the words that
+// have not been parsed into flags or positional fields. Command-lines from untrusted
+// sources can be limited with the flags.MaxArgs/MaxArgLength/MaxElements options.
+func Parse(data interface{}, args []string, opts ...flags.OptFunc) ([]string, error) {
+	if err := flags.CheckArgs(args, opts...); err != nil {
+		return args, err
+	}
+
+	_, retargs, err := parseTarget(data, args, opts)
+
+	return retargs, err
+}
+
+// parseTarget parses the args onto the data struct like Parse, and also returns the
+// parser of the command they target (nil if the struct could not be scanned).
+func parseTarget(data interface{}, args []string, opts []flags.OptFunc) (*parser, []string, error) {
+	root := &parser{
+		data:       data,
+		local:      newParseFlagSet(),
+		persistent: newParseFlagSet(),
+		structs:    []interface{}{data},
+	}
+
+	if err := scan.Type(data, parseScanner(root, opts)); err != nil {
+		return nil, args, err
+	}
+
+	if err := root.fieldPositionals(opts); err != nil {
+		return nil, args, err
+	}
+
+	if err := root.generatedAliases(opts); err != nil {
+		return nil, args, err
+	}
+
+	if err := root.checkRelations(); err != nil {
+		return nil, args, err
+	}
+
+	// Find the target command, parsing its parents' flags along the way.
+	target, words, err := root.traverse(args)
+	if err != nil {
+		return target, words, err
+	}
+
+	retargs, err := target.parse(words, opts)
+
+	return target, retargs, err
+}
+
+// parseScanner returns a scan handler binding options, positionals
+// and subcommands found in a command struct to their parser.
+func parseScanner(cmd *parser, opts []flags.OptFunc) scan.Handler {
+	handler := func(val reflect.Value, sfield *reflect.StructField) (bool, error) {
+		mtag, _, err := flags.ScanOptions(opts...).FieldTag(*sfield)
+		if err != nil {
+			return true, fmt.Errorf("%w: %s", tag.ErrTag, err.Error())
+		}
+
+		// Fields used in another execution mode are not parsed.
+		if !flags.InMode(mtag, opts...) {
+			return true, nil
+		}
+
+		if pargs, _ := mtag.Get("positional-args"); len(pargs) > 0 {
+			if cmd.args, err = scanPositionals(val, mtag, opts); err != nil {
+				return true, err
+			}
+
+			cmd.structs = append(cmd.structs, initialize(val))
+
+			return true, nil
+		}
+
+		if name, _ := mtag.Get("command"); name != "" {
+			return true, cmd.subcommand(name, mtag, val, opts)
+		}
+
+		if found, err := cmd.group(mtag, val, opts); found || err != nil {
+			return found, err
+		}
+
+		flagSet, found, err := flags.ParseField(val, *sfield, opts...)
+		if err != nil || !found {
+			return found, err
+		}
+
+		cmd.env = append(cmd.env, envOnly(flagSet)...)
+
+		return true, generateTo(flagSet, cmd.local, stderr)
+	}
+
+	return handler
+}
+
+// subcommand scans a command struct, and binds it to its parent parser.
+func (cmd *parser) subcommand(name string, mtag tag.MultiTag, val reflect.Value, opts []flags.OptFunc) error {
+	aliases := mtag.GetMany("alias")
+
+	for _, used := range append([]string{name}, aliases...) {
+		if flags.IsReserved(used, opts...) {
+			return fmt.Errorf("%w: %q cannot be used by a command", flags.ErrReservedName, used)
+		}
+	}
+
+	// Options of the command might have their own env prefix.
+	opts = flags.CommandOptions(mtag, opts...)
+
+	subc := &parser{
+		name:       name,
+		aliases:    aliases,
+		parent:     cmd,
+		data:       initialize(val),
+		local:      newParseFlagSet(),
+		persistent: newParseFlagSet(),
+	}
+
+	subc.structs = []interface{}{subc.data}
+	cmd.subcommands = append(cmd.subcommands, subc)
+
+	if err := scan.Type(subc.data, parseScanner(subc, opts)); err != nil {
+		return fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
+	}
+
+	if err := subc.fieldPositionals(opts); err != nil {
+		return err
+	}
+
+	return subc.generatedAliases(opts)
+}
+
+// fieldPositionals scans the fields of the command struct tagged as positional arguments, if any.
+func (cmd *parser) fieldPositionals(opts []flags.OptFunc) error {
+	args, err := scanFieldPositionals(cmd.data, opts)
+	if err != nil {
+		return err
+	}
+
+	if args != nil {
+		cmd.args = args
+	}
+
+	return nil
+}
+
+// generatedAliases adds the aliases generated with `alias-styles` tags to the subcommands.
+func (cmd *parser) generatedAliases(opts []flags.OptFunc) error {
+	aliases, _, err := flags.SubcommandAliases(cmd.data, opts...)
+	if err != nil {
+		return err
+	}
+
+	for _, subc := range cmd.subcommands {
+		if named, found := aliases[subc.name]; found {
+			subc.aliases = named
+		}
+	}
+
+	return nil
+}
+
+// group scans a group of options (either declared or provided), or a group of commands.
+func (cmd *parser) group(mtag tag.MultiTag, val reflect.Value, opts []flags.OptFunc) (bool, error) {
+	var data interface{}
+
+	isProvider := flags.IsProvided(mtag)
+	name, isGroup := mtag.Get("group")
+	_, isCommands := mtag.Get("commands")
+
+	switch {
+	case isProvider:
+		provided, err := flags.ProvidedGroup(mtag, val)
+		if err != nil || provided == nil {
+			return true, err
+		}
+
+		data = provided
+	case isGroup && name != "":
+		data = initialize(val)
+	case isCommands:
+		return true, scan.Type(initialize(val), parseScanner(cmd, opts))
+	default:
+		return false, nil
+	}
+
+	groupOpts := flags.GroupOptions(mtag, opts...)
+
+	// Persistent groups already bound to a parent are inherited from it,
+	// and other structs of these groups are given a copy of their values.
+	persistent, _ := mtag.Get("persistent")
+	bound := boundGroup{name: groupName(mtag), prefix: flags.ScanOptions(groupOpts...).Prefix, data: data}
+
+	if persistent != "" && cmd.share(bound) {
+		return true, nil
+	}
+
+	flagSet, options, err := parseFlags(data, groupOpts...)
+	if err != nil {
+		return true, err
+	}
+
+	resolveRelations(flagSet, bound.prefix)
+
+	cmd.env = append(cmd.env, options...)
+
+	if err := setRequiredGroup(flagSet, mtag); err != nil {
+		return true, err
+	}
+
+	if err := checkDuplicates(flagSet, cmd.local, cmd.persistent); err != nil {
+		return true, err
+	}
+
+	if persistent != "" {
+		cmd.persistent.AddFlagSet(flagSet)
+		cmd.bound = append(cmd.bound, bound)
+	} else {
+		cmd.local.AddFlagSet(flagSet)
+		cmd.structs = append(cmd.structs, data)
+	}
+
+	return true, nil
+}
+
+// path returns the names of the command and of its parents, without the root one.
+func (cmd *parser) path() []string {
+	if cmd.parent == nil {
+		return nil
+	}
+
+	return append(cmd.parent.path(), cmd.name)
+}
+
+// CommandPath returns the full path of the command, like the one of cobra commands
+// generated from the same struct, for the errors concerning its options or positionals.
+func (cmd *parser) CommandPath() string {
+	return strings.Join(append([]string{os.Args[0]}, cmd.path()...), " ")
+}
+
+// share returns true if a persistent group is already bound by the command or one of its
+// parents, in which case another struct of this group is given a copy of its values.
+func (cmd *parser) share(group boundGroup) bool {
+	for parent := cmd; parent != nil; parent = parent.parent {
+		for _, bound := range parent.bound {
+			if !bound.isSameGroup(group) {
+				continue
+			}
+
+			if bound.data != group.data {
+				cmd.copies = append(cmd.copies, groupCopy{from: bound.data, to: group.data})
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// inherited returns the persistent groups of the command and of its parents, root first.
+func (cmd *parser) inherited() []interface{} {
+	if cmd == nil {
+		return nil
+	}
+
+	structs := cmd.parent.inherited()
+	for _, bound := range cmd.bound {
+		structs = append(structs, bound.data)
+	}
+
+	return structs
+}
+
+// flagSet returns all the options of the command, including inherited ones.
+func (cmd *parser) flagSet() *pflag.FlagSet {
+	flagSet := newParseFlagSet()
+	flagSet.AddFlagSet(cmd.local)
+
+	for parent := cmd; parent != nil; parent = parent.parent {
+		flagSet.AddFlagSet(parent.persistent)
+	}
+
+	return flagSet
+}
+
+// checkRelations is like checkRelations for generated commands, for the tree of a parser.
+func (cmd *parser) checkRelations() error {
+	if err := unknownRelations(cmd.flagSet()); err != nil {
+		return err
+	}
+
+	for _, subc := range cmd.subcommands {
+		if err := subc.checkRelations(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lookup returns the subcommand with the given name or alias, if any.
+func (cmd *parser) lookup(name string) *parser {
+	for _, subc := range cmd.subcommands {
+		if subc.name == name {
+			return subc
+		}
+
+		for _, alias := range subc.aliases {
+			if alias == name {
+				return subc
+			}
+		}
+	}
+
+	return nil
+}
+
+// traverse finds the target command of the args, parsing the options given to each of
+// its parents, and returns it with the words following its name on the command-line.
+func (cmd *parser) traverse(args []string) (*parser, []string, error) {
+	flagSet := cmd.flagSet()
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if arg == "--" {
+			break
+		}
+
+		// Skip flags, and the values they take from the next word.
+		if len(arg) > 1 && arg[0] == '-' {
+			name, _, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+			if takesValue, _ := lookupFlagWord(flagSet, arg, name); takesValue && !hasValue {
+				i++
+			}
+
+			continue
+		}
+
+		subc := cmd.lookup(arg)
+		if subc == nil {
+			break
+		}
+
+		if err := flagSet.Parse(args[:i]); err != nil {
+			return cmd, args, parseErrors(cmd, flagSet, err)
+		}
+
+		return subc.traverse(args[i+1:])
+	}
+
+	return cmd, args, nil
+}
+
+// parse parses the words given to the target command: options, toggles, unknown flags
+// and raw arguments, positionals, and checks the requirements of its option groups.
+func (cmd *parser) parse(words []string, opts []flags.OptFunc) ([]string, error) {
+	flagSet := cmd.flagSet()
+	known := words
+
+	if argv, err := taggedField(cmd.data, "argv", reflect.TypeOf([]string{})); err != nil {
+		return words, err
+	} else if argv.IsValid() {
+		argv.Set(reflect.ValueOf(append([]string{}, words...)))
+	}
+
+	// Unknown flags are collected instead of being errors.
+	if flags.ScanOptions(opts...).CollectUnknownFlags {
+		collected, err := taggedField(cmd.data, "unknown", reflect.TypeOf(map[string]string{}))
+		if err != nil {
+			return words, err
+		}
+
+		if collected.IsValid() {
+			var unknown map[string]string
+
+			known, unknown = splitUnknownFlags(flagSet, words)
+
+			if collected.IsNil() {
+				collected.Set(reflect.MakeMap(collected.Type()))
+			}
+
+			for key, value := range unknown {
+				collected.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(value))
+			}
+		}
+	}
+
+	if err := flagSet.Parse(known); err != nil {
+		return words, parseErrors(cmd, flagSet, err)
+	}
+
+	retargs, dash := flagSet.Args(), flagSet.ArgsLenAtDash()
+
+	// Words like +x are not positionals, but unset boolean flags.
+	if flags.ScanOptions(opts...).PlusToggles {
+		var err error
+		if retargs, dash, err = toggleFlags(flagSet, retargs, dash); err != nil {
+			return retargs, err
+		}
+	}
+
+	if cmd.args != nil {
+		var err error
+		if retargs, err = cmd.args.Parse(retargs, dash); err != nil {
+			return retargs, argErrors(cmd, err)
+		}
+	}
+
+	// Options of parents might be given before the name of the command.
+	flagSets := []*pflag.FlagSet{flagSet}
+	options := cmd.env
+
+	for parent := cmd.parent; parent != nil; parent = parent.parent {
+		flagSets = append(flagSets, parent.local)
+		options = append(options[:len(options):len(options)], parent.env...)
+	}
+
+	if err := setEnv(flagSets, options, opts); err != nil {
+		return retargs, commandErrors(cmd, err)
+	}
+
+	if err := interpolate(flagSets, opts); err != nil {
+		return retargs, commandErrors(cmd, err)
+	}
+
+	if err := validateRequiredGroups(flagSet); err != nil {
+		return retargs, commandErrors(cmd, err)
+	}
+
+	if err := validate(cmd.validaters()); err != nil {
+		return retargs, err
+	}
+
+	// Shared groups are copied with their final values.
+	for parent := cmd; parent != nil; parent = parent.parent {
+		copyGroups(parent.copies)
+	}
+
+	return retargs, nil
+}
+
+// validaters returns the structs of the command implementing flags.Validater, followed
+// by the persistent groups it inherits from its parents (or binds itself).
+func (cmd *parser) validaters() []flags.Validater {
+	var validaters []flags.Validater
+
+	structs := append(append([]interface{}{}, cmd.structs...), cmd.inherited()...)
+
+	for _, data := range structs {
+		if validater, ok := data.(flags.Validater); ok && validater != nil {
+			validaters = append(validaters, validater)
+		}
+	}
+
+	return validaters
+}
+
+// newParseFlagSet returns a flag set returning its errors without printing anything.
+func newParseFlagSet() *pflag.FlagSet {
+	flagSet := pflag.NewFlagSet(os.Args[0], pflag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+	flagSet.SetInterspersed(true)
+
+	return flagSet
+}
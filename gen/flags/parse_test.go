@@ -0,0 +1,269 @@
+package flags
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/positional"
+	"github.com/reeflective/flags/internal/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+// serverCommand is a command tree parsed on the server side, without cobra.
+type serverCommand struct {
+	Verbose bool `short:"v" long:"verbose"`
+
+	Opts struct {
+		Profile string `long:"profile"`
+	} `group:"global" persistent:"yes"`
+
+	Deploy struct {
+		Source struct {
+			Image string `long:"image"`
+			Path  string `long:"path"`
+		} `group:"source" require-one:""`
+
+		Args struct {
+			Target string   `required:"1"`
+			Hosts  []string `choice:"one two three"`
+		} `positional-args:"yes"`
+
+		Argv    []string          `argv:""`
+		Unknown map[string]string `unknown:""`
+	} `command:"deploy" alias:"dp"`
+}
+
+// TestParse checks that options, positionals and subcommands are parsed
+// without cobra commands, with the requirements of their struct tags.
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	data := serverCommand{}
+	args := []string{"-v", "--profile", "prod", "dp", "--image=nginx", "web", "one", "--", "rest"}
+
+	retargs, err := Parse(&data, args)
+
+	test := assert.New(t)
+	test.NoError(err, "Command-line should have been parsed successfully")
+	test.True(data.Verbose)
+	test.Equal("prod", data.Opts.Profile)
+	test.Equal("nginx", data.Deploy.Source.Image)
+	test.Equal("web", data.Deploy.Args.Target)
+	test.Equal([]string{"one"}, data.Deploy.Args.Hosts)
+	test.Equal([]string{"--image=nginx", "web", "one", "--", "rest"}, data.Deploy.Argv)
+	test.Equal([]string{"rest"}, retargs)
+
+	// Persistent options are inherited by subcommands.
+	data = serverCommand{}
+	_, err = Parse(&data, []string{"deploy", "--profile", "dev", "--path", ".", "web"})
+	test.NoError(err)
+	test.Equal("dev", data.Opts.Profile)
+
+	// Unknown flags are collected when the option is given.
+	data = serverCommand{}
+	_, err = Parse(&data, []string{"deploy", "--path", ".", "--region", "eu", "web"}, flags.CollectUnknownFlags())
+	test.NoError(err)
+	test.Equal(map[string]string{"region": "eu"}, data.Deploy.Unknown)
+}
+
+// TestParseFail checks that the whole validation pipeline is run without cobra.
+func TestParseFail(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+
+	_, err := Parse(&serverCommand{}, []string{"--unknown", "deploy"})
+	test.ErrorIs(err, flags.ErrParse, "Unknown flags should be errors")
+
+	_, err = Parse(&serverCommand{}, []string{"deploy", "web"})
+	test.ErrorIs(err, flags.ErrRequiredGroup, "Group requirements should be checked")
+
+	_, err = Parse(&serverCommand{}, []string{"deploy", "--image", "nginx"})
+	test.ErrorIs(err, positional.ErrRequired, "Required positionals should be checked")
+
+	_, err = Parse(&serverCommand{}, []string{"deploy", "--image", "nginx", "web", "four"})
+	test.ErrorIs(err, validation.ErrInvalidChoice, "Positional choices should be validated")
+}
+
+// TestParseErrors checks that parsing failures are structured errors, giving
+// the command, option, group or positional concerned, with or without cobra.
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+
+	var typed *flags.Error
+
+	_, err := Parse(&serverCommand{}, []string{"deploy", "--image", "nginx"})
+	test.ErrorIs(err, flags.ErrRequiredArgument)
+	test.True(errors.As(err, &typed))
+	test.Equal("Target", typed.Positional)
+	test.Equal(os.Args[0]+" deploy", typed.Command)
+
+	_, err = Parse(&serverCommand{}, []string{"deploy", "--image", "nginx", "web", "four"})
+	test.ErrorIs(err, flags.ErrInvalidValue)
+	test.True(errors.As(err, &typed))
+	test.Equal("Hosts", typed.Positional)
+	test.Equal("four", typed.Value)
+
+	_, err = Parse(&serverCommand{}, []string{"deploy", "web"})
+	test.True(errors.As(err, &typed))
+	test.Equal(flags.ErrRequiredGroup, typed.Kind)
+	test.Equal("source", typed.Group)
+
+	data := &struct {
+		Opts struct {
+			Port int    `long:"port" short:"o" check:"max=65535"`
+			Key  string `long:"key" requires:"cert"`
+			Cert string `long:"cert"`
+		} `group:"server" persistent:"yes"`
+		Serve testCommand `command:"serve"`
+	}{}
+
+	_, err = Parse(data, []string{"--port", "70000", "serve"})
+	test.ErrorIs(err, flags.ErrParse)
+	test.ErrorIs(err, flags.ErrCheck, "Errors of values should be kept")
+	test.True(errors.As(err, &typed))
+	test.Equal(flags.ErrInvalidValue, typed.Kind)
+	test.Equal("port", typed.Flag)
+	test.Equal("70000", typed.Value)
+	test.Equal(`parse error: invalid argument "70000" for "-o, --port" flag: invalid value: 70000 is greater than 65535`, err.Error())
+
+	cmd := Generate(data)
+	cmd.SetArgs([]string{"serve", "--key", "key.pem"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	err = cmd.Execute()
+	test.ErrorIs(err, flags.ErrRequiredFlag)
+	test.True(errors.As(err, &typed))
+	test.Equal("key", typed.Flag)
+	test.Equal(cmd.Name()+" serve", typed.Command)
+
+	cmd = Generate(data)
+	cmd.SetArgs([]string{"serve", "--port", "abc"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	err = cmd.Execute()
+	test.True(errors.As(err, &typed))
+	test.Equal("port", typed.Flag)
+	test.Equal("abc", typed.Value)
+
+	rendered, err := json.Marshal(typed)
+	test.NoError(err)
+	test.JSONEq(`{"kind":"invalid argument","message":`+strconv.Quote(typed.Error())+
+		`,"command":`+strconv.Quote(cmd.Name()+" serve")+`,"flag":"port","value":"abc"}`, string(rendered))
+}
+
+// TestParseLimits checks that command-lines exceeding the limits
+// set in options are rejected, with or without cobra commands.
+func TestParseLimits(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+	limits := []flags.OptFunc{flags.MaxArgs(4), flags.MaxArgLength(16)}
+
+	_, err := Parse(&serverCommand{}, []string{"deploy", "--image", "nginx", "web", "one"}, limits...)
+	test.ErrorIs(err, flags.ErrTooManyArgs)
+
+	_, err = ParseArgs(&serverCommand{}, []string{"deploy", "--image", "nginx:very-long-tag"}, limits...)
+	test.ErrorIs(err, flags.ErrArgTooLong)
+
+	// Executed commands check their own arguments.
+	data := struct {
+		Args struct {
+			Words []string
+		} `positional-args:"yes"`
+	}{}
+
+	cmd := newCommandWithArgs(&data, nil)
+	test.NoError(cmd.Args(cmd, []string{"one", "two"}))
+
+	cmd = Generate(&data, limits...)
+	test.ErrorIs(cmd.Args(cmd, []string{"one", "two", "three", "four", "five"}), flags.ErrTooManyArgs)
+}
+
+// parityCommand gathers most option kinds, to compare Parse with ParseArgs.
+type parityCommand struct {
+	Verbose []bool            `short:"v" long:"verbose"`
+	Level   string            `long:"level" env:"PARITY_LEVEL" default:"info" choice:"debug info warn"`
+	Color   bool              `long:"color" default:"true" negatable:""`
+	Labels  map[string]string `long:"label"`
+
+	Opts struct {
+		Profile string `long:"profile"`
+	} `group:"global" persistent:"yes"`
+
+	Run struct {
+		Count int `short:"c" long:"count"`
+
+		Args struct {
+			Target string   `required:"1"`
+			Rest   []string `description:"other targets"`
+		} `positional-args:"yes"`
+	} `command:"run" alias:"r"`
+}
+
+// TestParseParity checks that Parse gives the same results as ParseArgs,
+// which parses the command-line with a generated cobra tree.
+func TestParseParity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		opts []flags.OptFunc
+	}{
+		{name: "empty"},
+		{name: "options", args: []string{"-vv", "--level", "debug", "--label", "a=b", "--no-color"}},
+		{name: "defaults", args: []string{"run", "web"}},
+		{name: "environment", args: []string{"run", "web"}, opts: []flags.OptFunc{flags.WithEnviron([]string{"PARITY_LEVEL=warn"})}},
+		{name: "persistent options", args: []string{"run", "--profile", "prod", "-c", "2", "web"}},
+		{name: "command alias", args: []string{"-v", "r", "web", "db", "cache"}},
+		{name: "double dash", args: []string{"run", "web", "--", "-c"}},
+		{name: "unknown flag", args: []string{"--unknown", "run", "web"}},
+		{name: "invalid choice", args: []string{"--level", "trace"}},
+		{name: "invalid value", args: []string{"run", "--count", "two", "web"}},
+		{name: "missing positional", args: []string{"run", "-c", "1"}},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, cobraParsed := parityCommand{}, parityCommand{}
+
+			retargs, err := Parse(&parsed, test.args, test.opts...)
+			cobraRetargs, cobraErr := ParseArgs(&cobraParsed, test.args, test.opts...)
+
+			assert.Equal(t, cobraErr != nil, err != nil, "Both parsers should fail or succeed: %v, %v", err, cobraErr)
+
+			if err != nil {
+				var typed, cobraTyped *flags.Error
+
+				assert.Equal(t, errors.As(cobraErr, &cobraTyped), errors.As(err, &typed))
+
+				if typed != nil && cobraTyped != nil {
+					assert.Equal(t, cobraTyped.Kind, typed.Kind)
+				}
+
+				return
+			}
+
+			assert.Equal(t, cobraParsed, parsed)
+
+			// Lists of remaining args might be empty or nil.
+			if len(retargs) > 0 || len(cobraRetargs) > 0 {
+				assert.Equal(t, cobraRetargs, retargs)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package flags
+
+import (
+	"reflect"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/pflag"
+)
+
+// FromPflagValue returns a value of this library for a value written for pflag, so that it
+// can be used by options (eg. as the value of a flags.Flag), positionals and other backends.
+// Both interfaces are the same, but pflag.SliceValue lists are made repeatable values (see
+// flags.RepeatableFlag), which can be reset and return their elements with Get(). The type
+// name of the value is kept as is, since it is used by help usages and completions.
+func FromPflagValue(value pflag.Value) flags.Value {
+	switch typed := value.(type) {
+	case *pflagValue:
+		return typed.Value
+	case pflag.SliceValue:
+		return &sliceValue{Value: value, slice: typed}
+	default:
+		return value
+	}
+}
+
+// ToPflagValue returns a value for pflag flag sets, from a value of this library. Both
+// interfaces are the same, but repeatable values implement pflag.SliceValue, so that
+// other pflag users (eg. completions of cobra commands) can get and set their elements.
+// The type name of the value is kept as is, as well as its other optional interfaces.
+func ToPflagValue(value flags.Value) pflag.Value {
+	if typed, isSlice := value.(*sliceValue); isSlice {
+		return typed.Value
+	}
+
+	if repeatable, isList := value.(flags.RepeatableFlag); isList && repeatable.IsCumulative() {
+		return &pflagValue{Value: value}
+	}
+
+	return value
+}
+
+// sliceValue is a repeatable value of a pflag list.
+type sliceValue struct {
+	pflag.Value
+	slice pflag.SliceValue
+	reset bool
+}
+
+var (
+	_ flags.RepeatableFlag = (*sliceValue)(nil)
+	_ flags.Resetter       = (*sliceValue)(nil)
+	_ flags.Getter         = (*sliceValue)(nil)
+)
+
+// Set sets the list to the elements of the word, if it has been reset, or adds them.
+func (v *sliceValue) Set(value string) error {
+	if v.reset {
+		if err := v.slice.Replace([]string{}); err != nil {
+			return err
+		}
+
+		v.reset = false
+	}
+
+	return v.Value.Set(value)
+}
+
+func (v *sliceValue) Get() interface{} { return v.slice.GetSlice() }
+
+func (v *sliceValue) IsCumulative() bool { return true }
+
+func (v *sliceValue) Reset() { v.reset = true }
+
+// pflagValue is a pflag list of a repeatable value.
+type pflagValue struct {
+	flags.Value
+}
+
+var _ pflag.SliceValue = (*pflagValue)(nil)
+
+// Append adds an element to the list.
+func (v *pflagValue) Append(value string) error {
+	return v.Value.Set(value)
+}
+
+// Replace sets the elements of the list, as if each was given on a new command-line (thus
+// an empty list only resets the value). Lists which cannot be reset are added the elements.
+func (v *pflagValue) Replace(values []string) error {
+	if resetter, isResetter := v.Value.(flags.Resetter); isResetter {
+		resetter.Reset()
+	}
+
+	for _, value := range values {
+		if err := v.Value.Set(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSlice returns the elements of the list, as text.
+func (v *pflagValue) GetSlice() []string {
+	getter, isGetter := v.Value.(flags.Getter)
+	if !isGetter {
+		return []string{v.String()}
+	}
+
+	list := reflect.ValueOf(getter.Get())
+	if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+		return []string{v.String()}
+	}
+
+	elems := make([]string, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		elems = append(elems, elementText(list.Index(i)))
+	}
+
+	return elems
+}
+
+func (v *pflagValue) IsBoolFlag() bool {
+	boolFlag, isBool := v.Value.(flags.BoolFlag)
+
+	return isBool && boolFlag.IsBoolFlag()
+}
+
+func (v *pflagValue) IsCumulative() bool { return true }
+
+func (v *pflagValue) Reset() {
+	if resetter, isResetter := v.Value.(flags.Resetter); isResetter {
+		resetter.Reset()
+	}
+}
+
+func (v *pflagValue) Get() interface{} {
+	if getter, isGetter := v.Value.(flags.Getter); isGetter {
+		return getter.Get()
+	}
+
+	return nil
+}
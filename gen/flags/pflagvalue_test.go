@@ -0,0 +1,80 @@
+package flags
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFromPflagValue checks that pflag lists are repeatable values, which can be reset.
+func TestFromPflagValue(t *testing.T) {
+	t.Parallel()
+
+	flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	hosts := flagSet.StringSlice("hosts", []string{"default"}, "")
+
+	value := FromPflagValue(flagSet.Lookup("hosts").Value)
+	assert.Equal(t, "stringSlice", value.Type())
+
+	repeatable, isRepeatable := value.(flags.RepeatableFlag)
+	require.True(t, isRepeatable)
+	assert.True(t, repeatable.IsCumulative())
+
+	require.NoError(t, value.Set("a"))
+	require.NoError(t, value.Set("b,c"))
+	assert.Equal(t, []string{"a", "b", "c"}, *hosts)
+
+	resetter, isResetter := value.(flags.Resetter)
+	require.True(t, isResetter)
+	resetter.Reset()
+
+	require.NoError(t, value.Set("d"))
+	assert.Equal(t, []string{"d"}, *hosts)
+	assert.Equal(t, []string{"d"}, value.(flags.Getter).Get())
+
+	verbose := flagSet.Bool("verbose", false, "")
+	boolValue := FromPflagValue(flagSet.Lookup("verbose").Value)
+	assert.True(t, boolValue.(flags.BoolFlag).IsBoolFlag())
+	require.NoError(t, boolValue.Set("true"))
+	assert.True(t, *verbose)
+
+	assert.Same(t, flagSet.Lookup("hosts").Value, ToPflagValue(value))
+}
+
+// TestToPflagValue checks that repeatable values are pflag lists.
+func TestToPflagValue(t *testing.T) {
+	t.Parallel()
+
+	timeouts := []time.Duration{time.Second}
+
+	value, err := flags.NewValue(&timeouts)
+	require.NoError(t, err)
+
+	list, isList := ToPflagValue(value).(pflag.SliceValue)
+	require.True(t, isList)
+	assert.Equal(t, []string{"1s"}, list.GetSlice())
+
+	require.NoError(t, list.Replace([]string{"2s", "3s"}))
+	require.NoError(t, list.Append("4s"))
+	assert.Equal(t, []time.Duration{2 * time.Second, 3 * time.Second, 4 * time.Second}, timeouts)
+	assert.Equal(t, []string{"2s", "3s", "4s"}, list.GetSlice())
+	assert.Equal(t, value.Type(), list.(pflag.Value).Type())
+
+	flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flagSet.Var(ToPflagValue(value), "timeout", "")
+	require.NoError(t, flagSet.Parse([]string{"--timeout", "5s"}))
+	assert.Equal(t, []time.Duration{2 * time.Second, 3 * time.Second, 4 * time.Second, 5 * time.Second}, timeouts)
+
+	port := 80
+
+	value, err = flags.NewValue(&port)
+	require.NoError(t, err)
+
+	_, isList = ToPflagValue(value).(pflag.SliceValue)
+	assert.False(t, isList)
+	assert.Same(t, value, FromPflagValue(ToPflagValue(value)))
+}
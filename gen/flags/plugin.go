@@ -0,0 +1,117 @@
+package flags
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+)
+
+// pluginAnnotation stores, on plugin commands, the path of their executable.
+const pluginAnnotation = "flags-plugin"
+
+// PluginPath returns the path of the executable run by a plugin command, and true,
+// or false if the command is not a plugin (see flags.WithPlugins).
+func PluginPath(cmd *cobra.Command) (string, bool) {
+	path, isPlugin := cmd.Annotations[pluginAnnotation]
+
+	return path, isPlugin
+}
+
+// pluginCommands adds a subcommand to the root command for each executable named after it
+// found in the plugin directories, if enabled. Executables found in earlier directories take
+// precedence, like in PATH, and those named like existing commands or reserved names are ignored.
+func pluginCommands(cmd *cobra.Command, opts []flags.OptFunc) {
+	options := flags.ScanOptions(opts...)
+	if !options.Plugins {
+		return
+	}
+
+	dirs := options.PluginDirs
+	if len(dirs) == 0 {
+		path, _ := options.LookupEnv("PATH")
+		dirs = filepath.SplitList(path)
+	}
+
+	prefix := filepath.Base(cmd.Name()) + "-"
+
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name, isPlugin := pluginName(dir, entry, prefix)
+			if !isPlugin || hasSubcommand(cmd, name) || flags.IsReserved(name, opts...) {
+				continue
+			}
+
+			cmd.AddCommand(pluginCommand(name, filepath.Join(dir, entry.Name())))
+		}
+	}
+}
+
+// pluginName returns the name of the command of an executable named with a prefix.
+func pluginName(dir string, entry os.DirEntry, prefix string) (string, bool) {
+	name := strings.TrimPrefix(entry.Name(), prefix)
+	if name == entry.Name() || entry.IsDir() {
+		return "", false
+	}
+
+	// Symbolic links are followed, since plugins are often installed as links.
+	info, err := os.Stat(filepath.Join(dir, entry.Name()))
+	if err != nil || !info.Mode().IsRegular() {
+		return "", false
+	}
+
+	if runtime.GOOS == "windows" {
+		if !strings.EqualFold(filepath.Ext(name), ".exe") {
+			return "", false
+		}
+
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	} else if info.Mode().Perm()&0o111 == 0 {
+		return "", false
+	}
+
+	return name, name != ""
+}
+
+// hasSubcommand returns true if a command has a subcommand with a name or an alias.
+func hasSubcommand(cmd *cobra.Command, name string) bool {
+	for _, subc := range cmd.Commands() {
+		if subc.Name() == name || subc.HasAlias(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pluginCommand returns the command running a plugin executable with its arguments,
+// and with the input and outputs of the command. Its exit status is returned as is.
+func pluginCommand(name, path string) *cobra.Command {
+	return &cobra.Command{
+		Use:                name,
+		Short:              fmt.Sprintf("Run the %s plugin", filepath.Base(path)),
+		Annotations:        map[string]string{pluginAnnotation: path},
+		DisableFlagParsing: true,
+		SilenceUsage:       true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			plugin := exec.CommandContext(cmd.Context(), path, args...)
+			plugin.Stdin, plugin.Stdout, plugin.Stderr = cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr()
+
+			if err := plugin.Run(); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,69 @@
+//go:build !windows
+
+package flags
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePlugin writes an executable script in a directory, named after the test program.
+func writePlugin(t *testing.T, dir, name, script string, mode os.FileMode) string {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.Base(os.Args[0])+"-"+name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), mode))
+
+	return path
+}
+
+// TestPlugins checks that executables named after the root command are subcommands,
+// running with their arguments as they are, unless named like existing commands.
+func TestPlugins(t *testing.T) {
+	t.Parallel()
+
+	dir, other := t.TempDir(), t.TempDir()
+	path := writePlugin(t, dir, "hello", `echo "hello $*"; exit 3`, 0o755)
+	writePlugin(t, other, "hello", "echo shadowed", 0o755)
+	writePlugin(t, dir, "run", "echo plugin", 0o755)
+	writePlugin(t, dir, "data", "echo data", 0o644)
+
+	rootData := struct {
+		Run renderCommand `command:"run"`
+	}{}
+
+	root := Generate(&rootData, flags.WithPlugins(dir, other))
+
+	hello, _, err := root.Find([]string{"hello"})
+	require.NoError(t, err)
+
+	pluginPath, isPlugin := PluginPath(hello)
+	assert.True(t, isPlugin)
+	assert.Equal(t, path, pluginPath)
+
+	run, _, _ := root.Find([]string{"run"})
+	_, isPlugin = PluginPath(run)
+	assert.False(t, isPlugin)
+
+	for _, subc := range root.Commands() {
+		assert.NotEqual(t, "data", subc.Name())
+	}
+
+	out := &bytes.Buffer{}
+	root.SetOut(out)
+	root.SetErr(out)
+	root.SetArgs([]string{"hello", "--name", "world"})
+
+	assert.ErrorContains(t, root.Execute(), "hello: exit status 3")
+	assert.Contains(t, out.String(), "hello --name world\n")
+
+	// Plugins are only discovered when enabled.
+	_, _, err = Generate(&rootData).Find([]string{"hello"})
+	assert.Error(t, err)
+}
@@ -19,38 +19,88 @@ func positionals(cmd *cobra.Command, stag tag.MultiTag, val reflect.Value, opts
 		return false, nil
 	}
 
-	// If the generation options include a validator, use it on arguments
-	optFuncs := make([]scan.OptFunc, len(opts))
-	for i, optFunc := range opts {
-		optFuncs[i] = scan.OptFunc(optFunc)
-	}
-
 	// Scan all the fields on the struct and build the list of arguments
 	// with their own requirements, and references to their values.
 	// Return a type storing all the fields, references, and with the
 	// tools to manage, parse words and raise any errors related
-	positionals, err := positional.ScanArgs(val, stag, optFuncs...)
-	if err != nil || positionals == nil {
-		return true, fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
+	positionals, err := scanPositionals(val, stag, opts)
+	if err != nil {
+		return true, err
+	}
+
+	addValidater(cmd, initialize(val), false)
+	bindPositionals(cmd, positionals, opts)
+
+	return true, nil
+}
+
+// fieldPositionals scans the fields of a command struct tagged as positional arguments, if any.
+func fieldPositionals(cmd *cobra.Command, data interface{}, opts []flags.OptFunc) error {
+	positionals, err := scanFieldPositionals(data, opts)
+	if err != nil {
+		return err
+	}
+
+	if positionals != nil {
+		bindPositionals(cmd, positionals, opts)
+	}
+
+	return nil
+}
+
+// scanPositionals scans the fields of a struct tagged as containing positional arguments.
+// If the generation options include a validator, it is used on arguments.
+func scanPositionals(val reflect.Value, stag tag.MultiTag, opts []flags.OptFunc) (*positional.Args, error) {
+	positionals, err := positional.ScanArgs(val, stag, flags.ScanOptFuncs(opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
 	}
 
+	return positionals, nil
+}
+
+// scanFieldPositionals scans the fields of a command struct tagged as positional arguments,
+// and returns nil if there are none.
+func scanFieldPositionals(data interface{}, opts []flags.OptFunc) (*positional.Args, error) {
+	positionals, err := positional.ScanFields(reflect.Indirect(reflect.ValueOf(data)), flags.ScanOptFuncs(opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
+	}
+
+	return positionals, nil
+}
+
+// bindPositionals makes a command parse its arguments into its positionals.
+func bindPositionals(cmd *cobra.Command, positionals *positional.Args, opts []flags.OptFunc) {
+	helpPositionals.Store(cmd, positionals)
+
+	toggles := flags.ScanOptions(opts...).PlusToggles
+
 	// Finally, assemble all the parsers into our cobra Args function.
 	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		dash := cmd.ArgsLenAtDash()
+
+		// Words like +x are not positionals, but unset boolean flags.
+		if toggles {
+			var err error
+			if args, dash, err = toggleFlags(cmd.Flags(), args, dash); err != nil {
+				return err
+			}
+		}
+
 		// Apply the words on the all/some of the positional fields,
 		// returning any words that have not been parsed in fields,
 		// and an error if one of the positionals has failed.
-		retargs, err := positionals.Parse(args, cmd.ArgsLenAtDash())
+		retargs, err := positionals.Parse(args, dash)
 
 		// Once we have consumed the words we wanted, we update the
 		// command's return (non-consummed) arguments, to be passed
 		// later to the Execute(args []string) implementation.
 		defer setRemainingArgs(cmd, retargs)
 
-		// Directly return the error, which might be non-nil.
-		return err
+		// Return the error, which might be non-nil, with its positional.
+		return argErrors(cmd, err)
 	}
-
-	return true, nil
 }
 
 func setRemainingArgs(cmd *cobra.Command, retargs []string) {
@@ -67,6 +117,14 @@ func setRemainingArgs(cmd *cobra.Command, retargs []string) {
 	cmd.Annotations["flags"] = strings.Join(retargs, " ")
 }
 
+// RemainingArgs returns the arguments of a command that have not been parsed into its
+// positionals, as they are passed to the Execute(args) implementation of its struct, once
+// its command-line has been parsed. This is meant for commands bound with their own cobra
+// runners (eg. with Bind), which are given all of their words by cobra.
+func RemainingArgs(cmd *cobra.Command) []string {
+	return getRemainingArgs(cmd)
+}
+
 func getRemainingArgs(cmd *cobra.Command) []string {
 	if cmd.Annotations == nil {
 		return nil
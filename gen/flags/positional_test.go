@@ -2,11 +2,13 @@ package flags
 
 import (
 	"errors"
+	"net/netip"
 	// "os"
 	// "os/exec".
 	"strings"
 	"testing"
 
+	"github.com/reeflective/flags"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 )
@@ -405,6 +407,69 @@ func TestMixedSlicesLastHasPriority(t *testing.T) {
 	pt.Equal("single", opts.Positional.Third)
 }
 
+// TestPositionalTextUnmarshaler checks that positional arguments whose
+// types implement encoding.TextUnmarshaler are parsed with it.
+func TestPositionalTextUnmarshaler(t *testing.T) {
+	t.Parallel()
+
+	opts := struct {
+		Positional struct {
+			Addr  netip.Addr
+			Addrs []netip.Addr
+		} `positional-args:"yes" required:"yes"`
+	}{}
+
+	args := []string{"10.0.0.1", "::1", "10.0.0.2"}
+	cmd := newCommandWithArgs(&opts, args)
+	err := cmd.Args(cmd, args)
+
+	pt := assert.New(t)
+	pt.Nilf(err, "Unexpected error: %v", err)
+	pt.Equal(netip.MustParseAddr("10.0.0.1"), opts.Positional.Addr)
+	pt.Equal([]netip.Addr{netip.MustParseAddr("::1"), netip.MustParseAddr("10.0.0.2")}, opts.Positional.Addrs)
+
+	err = cmd.Args(cmd, []string{"10.0.0"})
+	pt.ErrorContains(err, "unmarshal error")
+}
+
+// TestPositionalStream checks that iterator positional fields receive their
+// words lazily, and that their minimum requirements are still enforced.
+func TestPositionalStream(t *testing.T) {
+	t.Parallel()
+
+	opts := struct {
+		Positional struct {
+			Target string
+			Files  func(yield func(string) bool) `required:"2"`
+			Last   string
+		} `positional-args:"yes" required:"yes"`
+	}{}
+
+	args := []string{"host", "a", "b", "c", "end"}
+	cmd := newCommandWithArgs(&opts, args)
+	err := cmd.Args(cmd, args)
+
+	pt := assert.New(t)
+	pt.Nilf(err, "Unexpected error: %v", err)
+	pt.Equal("host", opts.Positional.Target)
+	pt.Equal("end", opts.Positional.Last)
+
+	var files []string
+
+	opts.Positional.Files(func(file string) bool {
+		files = append(files, file)
+
+		return file != "b"
+	})
+	pt.Equal([]string{"a", "b"}, files, "the stream should stop when yield returns false")
+
+	err = cmd.Args(cmd, []string{"host", "a", "end"})
+	pt.ErrorContains(err, "`Last` was not provided")
+
+	err = cmd.Args(cmd, []string{"host", "a"})
+	pt.ErrorContains(err, "`Files (at least 2 arguments, but got only 1)` and `Last` were not provided")
+}
+
 // TestTwoInfiniteSlicesExplicitFail checks that if a struct containing
 // at least two slices that are explicitly marked infinite (no maximum),
 // will return an error next to the cobra command being returned.
@@ -503,6 +568,54 @@ func TestPositionalDoubleDashFail(t *testing.T) {
 	pt.ErrorContains(err, "`SecondList (at least 1 argument)` and `Third` were not provided")
 }
 
+// fieldsCommand has positionals declared on its own fields.
+type fieldsCommand struct {
+	Service string   `positional-arg:"yes" required:"1"`
+	Hosts   []string `positional-arg:"yes"`
+	Force   bool     `long:"force"`
+}
+
+func (c *fieldsCommand) Execute(args []string) error { return nil }
+
+// kongDeployCommand has positionals declared with kong tags.
+type kongDeployCommand struct {
+	Service string   `arg:"" help:"service to deploy"`
+	Hosts   []string `arg:"" optional:""`
+}
+
+func (c *kongDeployCommand) Execute(args []string) error { return nil }
+
+// TestPositionalFields checks that the fields of a command struct tagged as positionals
+// (either directly, or with kong tags) are parsed like those of a positional-args struct.
+func TestPositionalFields(t *testing.T) {
+	t.Parallel()
+
+	pt := assert.New(t)
+
+	native := &fieldsCommand{}
+
+	cmd := newCommandWithArgs(native, []string{"api", "--force", "a", "b"})
+	pt.NoError(cmd.Execute())
+	pt.Equal("api", native.Service)
+	pt.Equal([]string{"a", "b"}, native.Hosts)
+	pt.True(native.Force)
+
+	kong := &struct {
+		Deploy kongDeployCommand `cmd:""`
+	}{}
+
+	cmd = Generate(kong, flags.WithKongTags())
+	cmd.SilenceErrors, cmd.SilenceUsage = true, true
+
+	cmd.SetArgs([]string{"deploy", "api", "a"})
+	pt.NoError(cmd.Execute())
+	pt.Equal("api", kong.Deploy.Service)
+	pt.Equal([]string{"a"}, kong.Deploy.Hosts)
+
+	cmd.SetArgs([]string{"deploy"})
+	pt.ErrorContains(cmd.Execute(), "service")
+}
+
 //
 // Helpers --------------------------------------------------------------- //
 //
@@ -524,3 +637,31 @@ func newCommandWithArgs(data interface{}, args []string) *cobra.Command {
 
 	return cmd
 }
+
+// TestPositionalOnSet checks that hooks registered on positional fields
+// are called with a copy of their old values, for maps set in place.
+func TestPositionalOnSet(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Args struct {
+			Labels map[string]string
+		} `positional-args:"yes"`
+	}{}
+
+	cfg.Args.Labels = map[string]string{"env": "dev"}
+
+	var calls int
+	var oldLabels map[string]string
+
+	root := Generate(cfg, flags.OnSet(&cfg.Args.Labels, func(old, _ map[string]string) {
+		calls, oldLabels = calls+1, old
+	}))
+	root.RunE = func(*cobra.Command, []string) error { return nil }
+
+	root.SetArgs([]string{"app:web"})
+	assert.NoError(t, root.Execute())
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, map[string]string{"env": "dev"}, oldLabels)
+}
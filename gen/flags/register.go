@@ -0,0 +1,129 @@
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/tag"
+	"github.com/spf13/cobra"
+)
+
+// ErrCommandExists indicates that a command added to a tree has the name of an existing one.
+var ErrCommandExists = errors.New("command already exists")
+
+// AddCommand generates a subcommand of a command of a tree produced by Generate() from a
+// command struct, once the tree is generated (eg. for modules loaded while an application
+// runs). The struct tag is the one of a field declaring the subcommand in a struct, like
+// `command:"deploy" description:"deploy a service"`, and data must be a pointer to the struct.
+// The subcommand is generated with the options the tree was generated with.
+//
+// Completions of the subcommand are generated separately, with completions.AddCommand.
+func AddCommand(parent *cobra.Command, structTag string, data interface{}) (*cobra.Command, error) {
+	val := reflect.ValueOf(data)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return nil, flags.ErrNotPointerToStruct
+	}
+
+	mtag, _, err := tag.GetFieldTag(reflect.StructField{Tag: reflect.StructTag(structTag)})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", tag.ErrTag, err.Error())
+	}
+
+	name, _ := mtag.Get("command")
+	if name == "" {
+		return nil, fmt.Errorf("%w: no command name in %q", flags.ErrInvalidTag, structTag)
+	}
+
+	for _, used := range append([]string{name}, mtag.GetMany("alias")...) {
+		if hasSubcommand(parent, used) {
+			return nil, fmt.Errorf("%w: %q", ErrCommandExists, used)
+		}
+	}
+
+	opts := rootOptions(parent)
+
+	if _, err := command(parent, nil, mtag, val, opts); err != nil {
+		RemoveCommand(parent, name)
+
+		return nil, err
+	}
+
+	subc := subcommand(parent, name)
+
+	if err := checkRelations(subc); err != nil {
+		RemoveCommand(parent, name)
+
+		return nil, err
+	}
+
+	generateSubtree(subc, data, opts)
+
+	return subc, nil
+}
+
+// RemoveCommand removes a subcommand from a command, by name, and returns true if it had one.
+// The options inherited by the subcommand are not removed, even if used by no other command.
+func RemoveCommand(parent *cobra.Command, name string) bool {
+	subc := subcommand(parent, name)
+	if subc == nil {
+		return false
+	}
+
+	parent.RemoveCommand(subc)
+	Forget(subc)
+
+	return true
+}
+
+// Forget releases the state kept by this package for the commands of a tree generated by it
+// (their options, renderers, themes, middlewares, the snapshots of their structs, etc), which
+// is otherwise kept as long as the program runs: applications generating many trees (eg. one
+// for each request of a server) should forget them once done. Forgotten trees should not be
+// executed anymore, and commands removed with RemoveCommand are forgotten with it.
+func Forget(root *cobra.Command) {
+	for _, state := range []interface{ Delete(key any) }{
+		&treeOptions, &helpPositionals, &errorRenderers, &usageRenderers, &renderingUsages, &themes, &helpOutputs,
+		&snapshots, &middlewares, &preRunSteps,
+	} {
+		state.Delete(root)
+	}
+
+	validaters.Lock()
+	delete(validaters.local, root)
+	delete(validaters.persistent, root)
+	validaters.Unlock()
+
+	envOptions.Lock()
+	delete(envOptions.options, root)
+	envOptions.Unlock()
+
+	persistentGroups.Lock()
+	delete(persistentGroups.bound, root)
+	delete(persistentGroups.copies, root)
+	persistentGroups.Unlock()
+
+	for _, subc := range root.Commands() {
+		Forget(subc)
+	}
+}
+
+// generateSubtree applies to the tree of a subcommand added to a generated
+// tree the steps of the generation applied to the tree as a whole.
+func generateSubtree(cmd *cobra.Command, data interface{}, opts []flags.OptFunc) {
+	defer checkParsed(cmd, opts)
+
+	limitArgs(cmd, opts)
+	renderErrors(cmd)
+	recordUsage(cmd)
+	useMiddlewares(cmd)
+
+	if catalog := flags.ScanOptions(opts...).Catalog; len(catalog) > 0 {
+		translate(cmd, catalog)
+		translateHelp(cmd, catalog)
+	}
+
+	synopses(cmd)
+	snapshotTree(cmd, data)
+}
@@ -0,0 +1,83 @@
+package flags
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAddCommand checks that commands are added to and removed from generated
+// trees, with their options, positionals and the options of the tree.
+func TestAddCommand(t *testing.T) {
+	t.Parallel()
+
+	rootData := struct {
+		Verbose bool `long:"verbose" short:"v" description:"verbose"`
+	}{}
+
+	root := Generate(&rootData, flags.MaxArgs(4))
+	root.SetOut(&bytes.Buffer{})
+	root.SetErr(&bytes.Buffer{})
+
+	deploy := &copyCommand{}
+	subc, err := AddCommand(root, `command:"deploy" alias:"d" description:"deploy files"`, deploy)
+	require.NoError(t, err)
+	assert.Equal(t, "deploy files", subc.Short)
+	assert.Equal(t, []string{"d"}, subc.Aliases)
+
+	root.SetArgs([]string{"-v", "d", "--overwrite", "src", "dst"})
+	require.NoError(t, root.Execute())
+	assert.True(t, rootData.Verbose)
+	assert.True(t, deploy.executed)
+	assert.Equal(t, "dst", deploy.Args.Target)
+
+	// Validaters and limits of the tree apply to added commands.
+	root.SetArgs([]string{"deploy", "--overwrite", "--backup", "src", "dst"})
+	assert.ErrorContains(t, root.Execute(), "--overwrite and --backup are exclusive")
+
+	root.SetArgs([]string{"deploy", "a", "b", "c", "d", "e"})
+	assert.ErrorIs(t, root.Execute(), flags.ErrTooManyArgs)
+
+	_, err = AddCommand(root, `command:"other" alias:"deploy"`, &copyCommand{})
+	assert.ErrorIs(t, err, ErrCommandExists)
+
+	_, err = AddCommand(root, `description:"no name"`, &copyCommand{})
+	assert.ErrorIs(t, err, flags.ErrInvalidTag)
+
+	_, err = AddCommand(root, `command:"value"`, copyCommand{})
+	assert.ErrorIs(t, err, flags.ErrNotPointerToStruct)
+
+	assert.True(t, RemoveCommand(root, "deploy"))
+	assert.False(t, RemoveCommand(root, "deploy"))
+	assert.False(t, hasSubcommand(root, "deploy"))
+}
+
+// TestForget checks that the state kept for the commands of a tree is released.
+func TestForget(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Deploy copyCommand `command:"deploy"`
+	}{}
+
+	root := Generate(&data, flags.WithHelpWidth(40))
+	deploy := subcommand(root, "deploy")
+
+	SetErrorRenderer(deploy, func(_ *cobra.Command, err error) string { return err.Error() })
+	SetTheme(root, &Theme{})
+	Use(deploy, func(next RunFunc) RunFunc { return next })
+
+	Forget(root)
+
+	for _, state := range []*sync.Map{&treeOptions, &errorRenderers, &themes, &snapshots, &middlewares} {
+		for _, cmd := range []*cobra.Command{root, deploy} {
+			_, found := state.Load(cmd)
+			assert.False(t, found, "the state of forgotten commands should be released")
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+// ErrorRenderer returns the message shown for an error of the command-line of a command,
+// like an unknown flag, an invalid value or a missing positional argument. Most of them
+// are *flags.Error ones, which renderers can inspect (with errors.As, and errors.Is on
+// their Kind) to reformat, colorize or translate them, instead of matching their text.
+type ErrorRenderer func(cmd *cobra.Command, err error) string
+
+// UsageRenderer returns the usage shown for a command, given the one produced
+// by its usage template (eg. to colorize or translate some of its sections).
+type UsageRenderer func(cmd *cobra.Command, usage string) string
+
+// errorRenderers and usageRenderers are the renderers set on commands.
+var errorRenderers, usageRenderers sync.Map
+
+// renderingUsages are the commands whose usage is being produced for their renderer.
+var renderingUsages sync.Map
+
+// SetErrorRenderer sets the renderer of the errors of the command-lines given to a command
+// and to its subcommands, unless they have their own. Only the errors of flags, positionals
+// and option groups are rendered, not those returned by the implementations of commands.
+// Rendered errors still match their original ones with errors.Is and errors.As, and cobra
+// still prints them with its "Error:" prefix. A nil renderer removes the one of the command.
+func SetErrorRenderer(cmd *cobra.Command, render ErrorRenderer) {
+	if render == nil {
+		errorRenderers.Delete(cmd)
+	} else {
+		errorRenderers.Store(cmd, render)
+	}
+}
+
+// SetUsageRenderer sets the renderer of the usage of a command and of its subcommands,
+// unless they have their own: it is used both on errors and in help messages. It has no
+// effect on commands whose usage function has been replaced with cobra's SetUsageFunc.
+// A nil renderer removes the one of the command.
+func SetUsageRenderer(cmd *cobra.Command, render UsageRenderer) {
+	if render == nil {
+		usageRenderers.Delete(cmd)
+	} else {
+		usageRenderers.Store(cmd, render)
+	}
+}
+
+// renderer returns the renderer set on a command or on the closest of its parents.
+func renderer[T any](renderers *sync.Map, cmd *cobra.Command) (T, bool) {
+	for ; cmd != nil; cmd = cmd.Parent() {
+		if render, found := renderers.Load(cmd); found {
+			return render.(T), true
+		}
+	}
+
+	var none T
+
+	return none, false
+}
+
+// renderErrors makes the arguments handlers of all commands of the tree
+// return their errors rendered by the error renderer of the command, if any.
+func renderErrors(cmd *cobra.Command) {
+	for _, subc := range cmd.Commands() {
+		renderErrors(subc)
+	}
+
+	next := cmd.Args
+	if next == nil {
+		return
+	}
+
+	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		return renderError(cmd, next(cmd, args))
+	}
+}
+
+// renderError returns an error rendered by the error renderer of a command, if
+// it has one and if the error has not been rendered already, or the error as is.
+func renderError(cmd *cobra.Command, err error) error {
+	var rendered *renderedError
+	if err == nil || errors.As(err, &rendered) {
+		return err
+	}
+
+	render, found := renderer[ErrorRenderer](&errorRenderers, cmd)
+	if !found {
+		return err
+	}
+
+	return &renderedError{err: err, msg: render(cmd, err)}
+}
+
+// renderedError is an error whose message has been produced by an error renderer.
+type renderedError struct {
+	err error
+	msg string
+}
+
+func (e *renderedError) Error() string { return e.msg }
+
+func (e *renderedError) Unwrap() error { return e.err }
+
+// renderUsages makes the usages of all commands of the tree to be
+// produced by the usage renderer of the command, if any.
+func renderUsages(cmd *cobra.Command) {
+	usage := cmd.UsageFunc()
+
+	cmd.SetUsageFunc(func(cmd *cobra.Command) error {
+		render, found := renderer[UsageRenderer](&usageRenderers, cmd)
+		if _, rendering := renderingUsages.Load(cmd); !found || rendering {
+			return usage(cmd)
+		}
+
+		// The usage produced by the template is written to a buffer, then restored.
+		renderingUsages.Store(cmd, true)
+		text := cmd.UsageString()
+		renderingUsages.Delete(cmd)
+
+		_, err := fmt.Fprint(cmd.OutOrStderr(), render(cmd, text))
+
+		return err
+	})
+}
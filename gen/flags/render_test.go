@@ -0,0 +1,121 @@
+package flags
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// renderCommand is a command with a required positional argument.
+type renderCommand struct {
+	Verbose bool `long:"verbose" short:"v" description:"verbose output"`
+	Args    struct {
+		Host string `required:"yes"`
+	} `positional-args:"yes"`
+}
+
+func (r *renderCommand) Execute(args []string) error { return nil }
+
+// TestErrorRenderer checks that the errors of command-lines are rendered
+// by the closest renderer of commands, and still match their originals.
+func TestErrorRenderer(t *testing.T) {
+	t.Parallel()
+
+	rootData := struct {
+		Connect renderCommand `command:"connect"`
+		Ping    renderCommand `command:"ping"`
+	}{}
+
+	root := Generate(&rootData)
+	root.SilenceErrors, root.SilenceUsage = true, true
+
+	SetErrorRenderer(root, func(cmd *cobra.Command, err error) string {
+		var typed *flags.Error
+
+		switch {
+		case errors.Is(err, flags.ErrUnknownFlag) && errors.As(err, &typed):
+			return "option inconnue : " + typed.Flag
+		case errors.Is(err, flags.ErrRequiredArgument) && errors.As(err, &typed):
+			return "argument requis : " + typed.Positional
+		}
+
+		return err.Error()
+	})
+
+	ping, _, err := root.Find([]string{"ping"})
+	require.NoError(t, err)
+	SetErrorRenderer(ping, func(cmd *cobra.Command, err error) string {
+		return strings.ToUpper(err.Error())
+	})
+
+	test := assert.New(t)
+
+	root.SetArgs([]string{"connect", "--unknown"})
+	err = root.Execute()
+	test.EqualError(err, "option inconnue : unknown")
+	test.ErrorIs(err, flags.ErrUnknownFlag)
+
+	root.SetArgs([]string{"connect", "-x"})
+	test.EqualError(root.Execute(), "option inconnue : x")
+
+	root.SetArgs([]string{"connect"})
+	err = root.Execute()
+	test.EqualError(err, "argument requis : Host")
+	test.ErrorIs(err, flags.ErrRequiredArgument)
+
+	root.SetArgs([]string{"ping"})
+	test.EqualError(root.Execute(), "REQUIRED ARGUMENT: `HOST` WAS NOT PROVIDED")
+
+	// Without renderers, errors are returned as is.
+	SetErrorRenderer(ping, nil)
+	SetErrorRenderer(root, nil)
+
+	root.SetArgs([]string{"ping"})
+	test.EqualError(root.Execute(), "required argument: `Host` was not provided")
+}
+
+// TestUsageRenderer checks that usages are rendered by the closest
+// renderer of commands, both in help messages and on errors.
+func TestUsageRenderer(t *testing.T) {
+	t.Parallel()
+
+	rootData := struct {
+		Connect renderCommand `command:"connect"`
+	}{}
+
+	root := Generate(&rootData)
+	out := &bytes.Buffer{}
+	root.SetOut(out)
+	root.SetErr(out)
+
+	SetUsageRenderer(root, func(cmd *cobra.Command, usage string) string {
+		return strings.ReplaceAll(usage, "Usage:", "Utilisation :")
+	})
+
+	test := assert.New(t)
+
+	root.SetArgs([]string{"connect"})
+	require.Error(t, root.Execute())
+	test.Contains(out.String(), "Utilisation :\n  ")
+
+	out.Reset()
+	root.SetArgs([]string{"connect", "--help"})
+	require.NoError(t, root.Execute())
+	test.Contains(out.String(), "Utilisation :\n  ")
+	test.NotContains(out.String(), "Usage:")
+	test.Contains(out.String(), "verbose output")
+
+	// Without renderers, usages are produced by their templates.
+	SetUsageRenderer(root, nil)
+
+	out.Reset()
+	root.SetArgs([]string{"connect", "--help"})
+	require.NoError(t, root.Execute())
+	test.Contains(out.String(), "Usage:\n  ")
+}
@@ -0,0 +1,149 @@
+package flags
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// snapshots are the values of the structs bound to generated trees, by the command generated
+// from each of them: the root of a tree (or the command given to Bind), or added subcommands.
+var snapshots sync.Map
+
+// structSnapshot is the value of a struct bound to a tree when it was generated,
+// with copies of its maps, since options add their entries to them in place.
+type structSnapshot struct {
+	ptr   reflect.Value
+	value reflect.Value
+	maps  map[int]reflect.Value
+}
+
+// Reset restores the structs bound to the tree of a command to the values they had when
+// it was generated (thus with their defaults), and forgets the options set on its commands
+// and their arguments, so that no state of a command-line leaks into the next one executed
+// by the tree (eg. in a closed-loop console, or a server executing several command-lines).
+// The structs of subcommands added with AddCommand are restored to their values when added.
+//
+// Trees are not reset automatically: Reset must be called between two executions of a tree,
+// once the first one has returned. It is not safe for concurrent use with executions, and a
+// tree still cannot execute several command-lines concurrently: each of them should then be
+// executed on its own tree, generated from a copy of the data struct (see Shell).
+func Reset(cmd *cobra.Command) {
+	root := cmd.Root()
+
+	restoreTree(root)
+	resetCommands(root)
+}
+
+// restoreTree restores the structs recorded for a command and its subcommands.
+func restoreTree(cmd *cobra.Command) {
+	if structs, found := snapshots.Load(cmd); found {
+		for _, snapshot := range structs.([]structSnapshot) {
+			snapshot.restore()
+		}
+	}
+
+	for _, subc := range cmd.Commands() {
+		restoreTree(subc)
+	}
+}
+
+// snapshotTree records the values of the structs bound to the tree of a command generated from data.
+func snapshotTree(cmd *cobra.Command, data interface{}) {
+	val := reflect.ValueOf(data)
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return
+	}
+
+	snapshots.Store(cmd, snapshotStructs(val, nil, map[uintptr]bool{}))
+}
+
+// snapshotStructs appends the snapshots of a pointed struct and of
+// all the structs it points to, directly or in its nested structs.
+func snapshotStructs(ptr reflect.Value, structs []structSnapshot, seen map[uintptr]bool) []structSnapshot {
+	if seen[ptr.Pointer()] {
+		return structs
+	}
+
+	seen[ptr.Pointer()] = true
+
+	value := reflect.New(ptr.Elem().Type()).Elem()
+	value.Set(ptr.Elem())
+
+	snapshot := structSnapshot{ptr: ptr, value: value, maps: map[int]reflect.Value{}}
+
+	for i := 0; i < value.NumField(); i++ {
+		if field := value.Field(i); field.Kind() == reflect.Map && ptr.Elem().Field(i).CanSet() {
+			snapshot.maps[i] = cloneMap(field)
+		}
+	}
+
+	structs = append(structs, snapshot)
+
+	return pointedStructs(ptr.Elem(), structs, seen)
+}
+
+// pointedStructs appends the snapshots of the structs pointed to by the fields of a struct.
+func pointedStructs(val reflect.Value, structs []structSnapshot, seen map[uintptr]bool) []structSnapshot {
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+
+		switch {
+		case field.Kind() == reflect.Struct:
+			structs = pointedStructs(field, structs, seen)
+		case field.Kind() == reflect.Ptr && !field.IsNil() && field.Elem().Kind() == reflect.Struct:
+			structs = snapshotStructs(field, structs, seen)
+		}
+	}
+
+	return structs
+}
+
+// restore sets the struct back to its recorded value. Pointers to other
+// structs are restored as they were, so options remain bound to their fields.
+func (s structSnapshot) restore() {
+	s.ptr.Elem().Set(s.value)
+
+	for i, recorded := range s.maps {
+		s.ptr.Elem().Field(i).Set(cloneMap(recorded))
+	}
+}
+
+// cloneMap returns a copy of a map, or nil if it is nil.
+func cloneMap(val reflect.Value) reflect.Value {
+	if val.IsNil() {
+		return reflect.Zero(val.Type())
+	}
+
+	clone := reflect.MakeMapWithSize(val.Type(), val.Len())
+	for iter := val.MapRange(); iter.Next(); {
+		clone.SetMapIndex(iter.Key(), iter.Value())
+	}
+
+	return clone
+}
+
+// resetCommands forgets the options set on a command and
+// its subcommands, and the arguments they were given.
+func resetCommands(cmd *cobra.Command) {
+	for _, flagSet := range []*pflag.FlagSet{cmd.Flags(), cmd.PersistentFlags()} {
+		flagSet.VisitAll(func(flag *pflag.Flag) {
+			flag.Changed = false
+
+			if resetter, ok := flag.Value.(flags.Resetter); ok {
+				resetter.Reset()
+			}
+		})
+
+		forgetOrigins(flagSet)
+	}
+
+	delete(cmd.Annotations, "flags")
+
+	for _, subc := range cmd.Commands() {
+		resetCommands(subc)
+	}
+}
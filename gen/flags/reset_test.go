@@ -0,0 +1,89 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resetCommand is a command with repeatable options and positionals.
+type resetCommand struct {
+	Tags   []string          `long:"tag" short:"t" description:"tags"`
+	Labels map[string]string `long:"label" short:"l" description:"labels"`
+	Args   struct {
+		Name string
+	} `positional-args:"yes"`
+
+	args []string
+}
+
+func (r *resetCommand) Execute(args []string) error {
+	r.args = args
+
+	return nil
+}
+
+// TestReset checks that reset trees have their structs back to their generated
+// values, and that options adding to those values replace them again first.
+func TestReset(t *testing.T) {
+	t.Parallel()
+
+	rootData := struct {
+		Verbose bool          `long:"verbose" short:"v" description:"verbose"`
+		Run     *resetCommand `command:"run"`
+	}{Run: &resetCommand{Tags: []string{"default"}}}
+
+	root := Generate(&rootData)
+	run := rootData.Run
+
+	root.SetArgs([]string{"-v", "run", "-t", "one", "-l", "a:1", "first", "extra"})
+	require.NoError(t, root.Execute())
+	assert.True(t, rootData.Verbose)
+	assert.Equal(t, []string{"one"}, run.Tags)
+	assert.Equal(t, map[string]string{"a": "1"}, run.Labels)
+	assert.Equal(t, "first", run.Args.Name)
+
+	Reset(root)
+
+	assert.False(t, rootData.Verbose)
+	assert.Same(t, run, rootData.Run)
+	assert.Equal(t, []string{"default"}, run.Tags)
+	assert.Empty(t, run.Labels)
+	assert.Empty(t, run.Args.Name)
+
+	root.SetArgs([]string{"run", "-t", "two", "-l", "b:2"})
+	require.NoError(t, root.Execute())
+	assert.False(t, rootData.Verbose)
+	assert.Equal(t, []string{"two"}, run.Tags)
+	assert.Equal(t, map[string]string{"b": "2"}, run.Labels)
+	assert.Empty(t, run.Args.Name)
+	assert.Empty(t, run.args)
+}
+
+// TestResetAddedCommand checks that the structs of subcommands added
+// to a tree are restored to their values when added to it.
+func TestResetAddedCommand(t *testing.T) {
+	t.Parallel()
+
+	rootData := struct {
+		Verbose bool `long:"verbose" short:"v" description:"verbose"`
+	}{}
+
+	root := Generate(&rootData)
+
+	run := &resetCommand{Tags: []string{"default"}}
+	_, err := AddCommand(root, `command:"run"`, run)
+	require.NoError(t, err)
+
+	root.SetArgs([]string{"run", "-t", "one", "-l", "a:1", "first"})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, []string{"one"}, run.Tags)
+	assert.Equal(t, "first", run.Args.Name)
+
+	Reset(root)
+
+	assert.Equal(t, []string{"default"}, run.Tags)
+	assert.Empty(t, run.Labels)
+	assert.Empty(t, run.Args.Name)
+}
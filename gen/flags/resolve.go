@@ -0,0 +1,356 @@
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/scan"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Origins of option values, as returned by Resolver.Origin.
+const (
+	OriginDefault = "default"  // The value of the struct field, or none
+	OriginEnv     = "env"      // The environment variable of an env-tagged option
+	OriginCLI     = "cli"      // The command-line
+	OriginConfig  = "config"   // The name of configuration sources (ConfigSource)
+	OriginMirror  = "mirrored" // The value of another option (`default-from` tag)
+)
+
+// sourceAnnotation stores the source and key of the default value of
+// an option (`source` tag), in the name:key format (see flags.WithSource).
+const sourceAnnotation = "flags-source"
+
+// Source is a source of option values, like a configuration file or a remote
+// key-value store, registered to a Resolver to set options before commands run.
+type Source interface {
+	// Name identifies the source, and is returned by Resolver.Origin
+	// for the options whose value has been set by this source.
+	Name() string
+
+	// Lookup returns the values of an option of a command (several ones for repeatable
+	// options), and true if the source has some. The command is the one being executed,
+	// and the option can either be one of its own, or inherited from its parents.
+	Lookup(cmd *cobra.Command, flag *pflag.Flag) ([]string, bool, error)
+}
+
+// Resolver sets the values of the options of executed commands from ordered sources,
+// with the following precedence (lowest to highest): default values (those of the struct
+// fields), the sources of options tagged with `source` (see flags.WithSource), registered
+// sources (in their registration order), the environment (for env-tagged options) and the
+// command-line. Only the value with the highest precedence is set on each option, and where
+// it comes from is recorded on the option, as queried with Origin (or FlagOrigin).
+//
+// Options tagged with `default-from` and not set by any of the above take the resolved value
+// of the other option instead of their default one, once all options have been resolved.
+type Resolver struct {
+	mutex   sync.RWMutex
+	opts    []flags.OptFunc
+	tagged  map[string]scan.ValueSource
+	sources []Source
+}
+
+// NewResolver returns a resolver with no sources. The options should be the same ones
+// given to Generate(), since they determine how the environment is read, and the sources
+// of the options tagged with `source`.
+func NewResolver(opts ...flags.OptFunc) *Resolver {
+	return &Resolver{
+		opts:   opts,
+		tagged: flags.ScanOptions(opts...).Sources,
+	}
+}
+
+// Register adds some sources to the resolver, with a precedence higher than the
+// sources already registered (but still lower than the environment and command-line).
+func (r *Resolver) Register(sources ...Source) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.sources = append(r.sources, sources...)
+}
+
+// RegisterBefore adds a source with a precedence lower than the registered source named
+// name (eg. a remote store overridden by a local configuration file), or registers it
+// like Register if there is no such source.
+func (r *Resolver) RegisterBefore(name string, source Source) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, registered := range r.sources {
+		if registered.Name() == name {
+			r.sources = append(r.sources[:i], append([]Source{source}, r.sources[i:]...)...)
+
+			return
+		}
+	}
+
+	r.sources = append(r.sources, source)
+}
+
+// Bind makes all the commands of a tree produced by Generate() resolve their options
+// before running (with their PreRun implementations, if any, being called afterwards).
+func (r *Resolver) Bind(cmd *cobra.Command) {
+	for _, subc := range cmd.Commands() {
+		r.Bind(subc)
+	}
+
+	preRun(cmd, func(cmd *cobra.Command, _ []string) error {
+		return r.Resolve(cmd)
+	})
+}
+
+// Resolve sets the options of a command and of its parents (which might be given before
+// the command name on the command-line) not given on the command-line from the registered
+// sources, and records the origin of their values. It is called by commands bound with
+// Bind, after their command-line has been parsed.
+func (r *Resolver) Resolve(cmd *cobra.Command) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var err error
+
+	resolved := make(map[string]*pflag.Flag)
+
+	for parent := cmd; parent != nil; parent = parent.Parent() {
+		parent.Flags().VisitAll(func(flag *pflag.Flag) {
+			// Aliases and previous names of options are resolved with them.
+			if len(flag.Annotations[aliasAnnotation]) > 0 {
+				return
+			}
+
+			if err == nil && resolved[flag.Name] == nil {
+				var origin string
+
+				origin, err = r.resolve(cmd, flag)
+				resolved[flag.Name] = flag
+
+				if origin != OriginCLI && origin != OriginDefault {
+					setOrigin(flag, origin)
+				}
+			}
+		})
+	}
+
+	for _, flag := range resolved {
+		if err != nil {
+			break
+		}
+
+		err = r.mirror(cmd, flag, resolved, nil)
+	}
+
+	return err
+}
+
+// resolve sets the value of an option from the source with the highest precedence.
+func (r *Resolver) resolve(cmd *cobra.Command, flag *pflag.Flag) (string, error) {
+	if flag.Changed || aliasChanged(cmd, flag) {
+		return OriginCLI, nil
+	}
+
+	// Environment values override those of all sources.
+	if isSet, err := setFlagEnv(flag, r.opts); isSet || err != nil {
+		return OriginEnv, err
+	}
+
+	values, origin, err := r.resolveTagged(flag)
+	if err != nil {
+		return origin, err
+	}
+
+	for _, source := range r.sources {
+		found, isSet, err := source.Lookup(cmd, flag)
+		if err != nil {
+			return origin, err
+		}
+
+		if isSet {
+			values, origin = found, source.Name()
+		}
+	}
+
+	for _, value := range values {
+		if err := flag.Value.Set(value); err != nil {
+			return origin, fmt.Errorf("%w: invalid value from %s for option %s: %s",
+				flags.ErrConfig, origin, flag.Name, err.Error())
+		}
+	}
+
+	return origin, nil
+}
+
+// resolveTagged returns the value of an option from the source of its `source` tag, if any.
+func (r *Resolver) resolveTagged(flag *pflag.Flag) ([]string, string, error) {
+	binding := flag.Annotations[sourceAnnotation]
+	if len(binding) == 0 {
+		return nil, OriginDefault, nil
+	}
+
+	name, key, _ := strings.Cut(binding[0], ":")
+
+	source := r.tagged[name]
+	if source == nil {
+		return nil, OriginDefault, fmt.Errorf("%w: option %s is bound to unknown source %q",
+			flags.ErrConfig, flag.Name, name)
+	}
+
+	value, found := source.Resolve(key)
+	if !found {
+		return nil, OriginDefault, nil
+	}
+
+	return []string{value}, name, nil
+}
+
+// aliasChanged returns true if an option has been given on the command-line
+// with one of its aliases or previous names (`alias` and `renamed-from` tags).
+func aliasChanged(cmd *cobra.Command, flag *pflag.Flag) bool {
+	changed := false
+
+	// Flag sets keep the flags given on their last command-line, even once reset.
+	for parent := cmd; parent != nil && !changed; parent = parent.Parent() {
+		parent.Flags().Visit(func(alias *pflag.Flag) {
+			if target := alias.Annotations[aliasAnnotation]; alias.Changed && len(target) > 0 && target[0] == flag.Name {
+				changed = true
+			}
+		})
+	}
+
+	return changed
+}
+
+// mirror sets an option tagged with `default-from` and having no value from
+// the other option, after mirroring the latter first if needed. The chain
+// of options being mirrored is used to detect cycles between them.
+func (r *Resolver) mirror(cmd *cobra.Command, flag *pflag.Flag, resolved map[string]*pflag.Flag, chain []string) error {
+	from := flag.Annotations["default-from"]
+	if len(from) == 0 || FlagOrigin(cmd, flag.Name) != OriginDefault {
+		return nil
+	}
+
+	chain = append(chain, flag.Name)
+
+	for _, name := range chain {
+		if name == from[0] {
+			return fmt.Errorf("%w: default-from cycle between options: %s", flags.ErrInvalidTag,
+				strings.Join(append(chain, name), " -> "))
+		}
+	}
+
+	source := resolved[from[0]]
+	if source == nil {
+		return fmt.Errorf("%w: option %s defaults from unknown option %s", flags.ErrInvalidTag, flag.Name, from[0])
+	}
+
+	if err := r.mirror(cmd, source, resolved, chain); err != nil {
+		return err
+	}
+
+	value := source.Value.String()
+
+	// Repeatable options are formatted between brackets, but their values
+	// are set with a comma-separated list, and without them.
+	if strings.HasSuffix(source.Value.Type(), "Slice") {
+		value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	}
+
+	if value != "" {
+		if err := flag.Value.Set(value); err != nil {
+			return fmt.Errorf("%w: invalid value from option %s for option %s: %s",
+				flags.ErrConfig, source.Name, flag.Name, err.Error())
+		}
+	}
+
+	setOrigin(flag, OriginMirror)
+
+	return nil
+}
+
+// Origin returns where the value of an option of a command (or of one of its parents) comes
+// from, like FlagOrigin: OriginCLI, OriginEnv, OriginDefault, or the name of a source. It is
+// empty if the command has no such option.
+func (r *Resolver) Origin(cmd *cobra.Command, name string) string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return FlagOrigin(cmd, name)
+}
+
+// configSource is a source of values read from a configuration.
+type configSource struct {
+	path   string
+	reader io.Reader
+	once   sync.Once
+	values map[*pflag.Flag][]string
+	err    error
+}
+
+// ConfigSource returns a source of values read from an INI or TOML configuration file, in the
+// format described in ParseConfig. The file is only read when options are first resolved, and
+// a file that does not exist is not an error (it has no values). The source is named "config".
+func ConfigSource(path string) Source {
+	return &configSource{path: path}
+}
+
+// ConfigReaderSource is like ConfigSource, but reads the configuration from a reader.
+func ConfigReaderSource(reader io.Reader) Source {
+	return &configSource{reader: reader}
+}
+
+func (c *configSource) Name() string { return OriginConfig }
+
+func (c *configSource) Lookup(cmd *cobra.Command, flag *pflag.Flag) ([]string, bool, error) {
+	c.once.Do(func() {
+		c.values, c.err = c.load(cmd.Root())
+	})
+
+	values, found := c.values[flag]
+
+	return values, found, c.err
+}
+
+// load reads the configuration, and binds its values to the options of the command tree.
+func (c *configSource) load(root *cobra.Command) (map[*pflag.Flag][]string, error) {
+	reader := c.reader
+
+	if c.path != "" {
+		file, err := os.Open(c.path)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %s", flags.ErrConfig, err.Error())
+		}
+		defer file.Close()
+
+		reader = file
+	}
+
+	entries, err := parseConfig(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[*pflag.Flag][]string)
+
+	for _, entry := range entries {
+		target, namespace := configCommand(root, entry.section)
+
+		words := append(append([]string{}, namespace...), strings.Split(entry.key, ".")...)
+
+		flag := configFlag(target, words)
+		if flag == nil {
+			return nil, fmt.Errorf("%w: line %d: unknown option %q for command %q",
+				flags.ErrConfig, entry.line, strings.Join(words, "."), target.Name())
+		}
+
+		values[flag] = append(values[flag], entry.values...)
+	}
+
+	return values, nil
+}
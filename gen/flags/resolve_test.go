@@ -0,0 +1,221 @@
+package flags
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mapSource is a source of values stored in a map, by long name.
+type mapSource map[string]string
+
+func (m mapSource) Name() string { return "kv" }
+
+func (m mapSource) Lookup(_ *cobra.Command, flag *pflag.Flag) ([]string, bool, error) {
+	value, found := m[flag.Name]
+	if !found {
+		return nil, false, nil
+	}
+
+	return []string{value}, true, nil
+}
+
+// TestResolver checks that option values are set from their source with the
+// highest precedence before commands run, and that their origin is remembered.
+func TestResolver(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Host  string   `long:"host"`
+		Port  int      `long:"port" env:"PORT"`
+		User  string   `long:"user"`
+		Tags  []string `long:"tags"`
+		Level int      `long:"level"`
+		Debug bool     `long:"debug"`
+
+		Run testCommand `command:"run"`
+	}{Level: 1}
+
+	opts := []flags.OptFunc{flags.WithEnviron([]string{"PORT=8080"})}
+	config := "host = config\nport = 22\nuser = config\ntags = [\"a\", \"b\"]\n"
+
+	root := Generate(&data, opts...)
+
+	resolver := NewResolver(opts...)
+	resolver.Register(ConfigReaderSource(strings.NewReader(config)))
+	resolver.RegisterBefore(OriginConfig, mapSource{"host": "kv", "debug": "true"})
+	resolver.Bind(root)
+
+	root.SetArgs([]string{"--user", "cli", "run"})
+	require.NoError(t, root.Execute())
+
+	run, _, _ := root.Find([]string{"run"})
+
+	test := assert.New(t)
+	test.Equal("config", data.Host, "config should override lower sources")
+	test.Equal("config", resolver.Origin(run, "host"))
+	test.Equal(8080, data.Port, "env should override config")
+	test.Equal(OriginEnv, resolver.Origin(run, "port"))
+	test.Equal("cli", data.User, "command-line should override all sources")
+	test.Equal(OriginCLI, resolver.Origin(run, "user"))
+	test.Equal([]string{"a", "b"}, data.Tags)
+	test.True(data.Debug)
+	test.Equal("kv", resolver.Origin(run, "debug"))
+	test.Equal(1, data.Level)
+	test.Equal(OriginDefault, resolver.Origin(run, "level"))
+	test.Equal("", resolver.Origin(run, "none"))
+}
+
+// TestResolverDefaultFrom checks that options without values take those of the
+// options they default from, and that cycles between such options are detected.
+func TestResolverDefaultFrom(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Address string   `long:"address"`
+		Bind    string   `long:"bind-address" default-from:"address"`
+		Listen  string   `long:"listen" default-from:"bind-address"`
+		Peers   []string `long:"peers"`
+		Mirrors []string `long:"mirrors" default-from:"peers"`
+		Port    int      `long:"port" default-from:"proxy-port"`
+		Proxy   int      `long:"proxy-port"`
+
+		Run testCommand `command:"run"`
+	}{Port: 80, Proxy: 8080}
+
+	root := Generate(&data)
+
+	resolver := NewResolver()
+	resolver.Register(mapSource{"listen": "source"})
+	resolver.Bind(root)
+
+	root.SetArgs([]string{"--address", "cli", "--peers", "a,b", "run"})
+	require.NoError(t, root.Execute())
+
+	run, _, _ := root.Find([]string{"run"})
+
+	test := assert.New(t)
+	test.Equal("cli", data.Bind)
+	test.Equal(OriginMirror, resolver.Origin(run, "bind-address"))
+	test.Equal("source", data.Listen, "sources should override mirrored values")
+	test.Equal("kv", resolver.Origin(run, "listen"))
+	test.Equal([]string{"a", "b"}, data.Mirrors)
+	test.Equal(8080, data.Port, "default values should be mirrored")
+	test.Equal(OriginMirror, resolver.Origin(run, "port"))
+
+	cycle := struct {
+		First  string `long:"first" default-from:"second"`
+		Second string `long:"second" default-from:"first"`
+
+		Run testCommand `command:"run"`
+	}{}
+
+	root = Generate(&cycle)
+	NewResolver().Bind(root)
+
+	root.SetArgs([]string{"run"})
+	root.SilenceErrors, root.SilenceUsage = true, true
+	require.ErrorIs(t, root.Execute(), flags.ErrInvalidTag)
+}
+
+// TestResolverTaggedSources checks that the sources of options tagged with `source` are
+// only queried once resolved, with a precedence lower than the registered sources.
+func TestResolverTaggedSources(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Token string `long:"token" source:"vault:token"`
+		Host  string `long:"host" source:"vault:host"`
+		User  string `long:"user" source:"vault:user" env:"APP_USER"`
+		Port  int    `long:"port" source:"vault:port"`
+
+		Run testCommand `command:"run"`
+	}{Port: 80}
+
+	opts := []flags.OptFunc{
+		flags.WithSource(originSource{"token": "secret", "host": "vault", "user": "vault"}),
+		flags.WithEnviron([]string{"APP_USER=env"}),
+	}
+
+	root := Generate(&data, opts...)
+	assert.Empty(t, data.Token, "sources should not be queried when generating")
+
+	resolver := NewResolver(opts...)
+	resolver.Register(mapSource{"host": "kv"})
+	resolver.Bind(root)
+
+	root.SetArgs([]string{"run"})
+	require.NoError(t, root.Execute())
+
+	run, _, _ := root.Find([]string{"run"})
+
+	test := assert.New(t)
+	test.Equal("secret", data.Token)
+	test.Equal("vault", resolver.Origin(run, "token"))
+	test.Equal("kv", data.Host, "registered sources should override tagged ones")
+	test.Equal("env", data.User, "env should override tagged sources")
+	test.Equal(80, data.Port, "keys without values should keep the default one")
+	test.Equal(OriginDefault, resolver.Origin(run, "port"))
+
+	// Resolvers must be given the sources of the tree.
+	data.Token = ""
+	root = Generate(&data, opts...)
+	NewResolver().Bind(root)
+
+	root.SetArgs([]string{"run"})
+	root.SilenceErrors, root.SilenceUsage = true, true
+	require.ErrorIs(t, root.Execute(), flags.ErrConfig)
+}
+
+// TestResolverValidaters checks that structs are validated with
+// the values set by a resolver, rather than those on the command-line.
+func TestResolverValidaters(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Limits rangeOptions `group:"limits" namespace:"limits" namespace-delimiter:"-" persistent:"true"`
+
+		Run testCommand `command:"run"`
+	}{}
+
+	root := Generate(&data)
+
+	resolver := NewResolver()
+	resolver.Register(mapSource{"limits-min": "10"})
+	resolver.Bind(root)
+
+	root.SetArgs([]string{"--limits-max", "20", "run"})
+	require.NoError(t, root.Execute())
+
+	root.SetArgs([]string{"--limits-max", "5", "run"})
+	root.SilenceErrors, root.SilenceUsage = true, true
+	require.ErrorContains(t, root.Execute(), "--min (10) is greater than --max (5)")
+}
+
+// TestResolverInterpolate checks that options are interpolated with
+// the values set by a resolver, rather than those on the command-line.
+func TestResolverInterpolate(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Name   string `long:"name"`
+		Output string `long:"output" interpolate:""`
+
+		Run testCommand `command:"run"`
+	}{}
+
+	root := Generate(&data)
+
+	resolver := NewResolver()
+	resolver.Register(mapSource{"name": "report", "output": "{{.Flags.Name}}.json"})
+	resolver.Bind(root)
+
+	root.SetArgs([]string{"run"})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, "report.json", data.Output)
+}
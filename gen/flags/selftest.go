@@ -0,0 +1,152 @@
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+)
+
+// SelfTestName is the name of the hidden command returned by SelfTestCommand.
+// It is reserved by default, like all names starting with two underscores.
+const SelfTestName = "__selftest"
+
+// ErrExample indicates that an example of a command does not parse anymore.
+var ErrExample = errors.New("invalid example")
+
+// SelfTest parses all the examples (`example` tags) of the commands found in data, without
+// executing anything, and returns an error for each example failing to parse, or targeting
+// another command than the one it documents (or one of its subcommands). This keeps the
+// examples shown in help usages and documentation in sync with the commands they document.
+//
+// Examples are full command-lines, starting with the program name (optionally preceded by
+// a `$` prompt), and whose words can be quoted. Each of them is parsed on a new (zero) value
+// of the data struct, which is never modified. The options are those given to Generate().
+func SelfTest(data interface{}, opts ...flags.OptFunc) ([]error, error) {
+	dataType := reflect.TypeOf(data)
+	if dataType == nil || dataType.Kind() != reflect.Ptr {
+		return nil, flags.ErrNotPointerToStruct
+	}
+
+	var failed []error
+
+	visitor := flags.VisitorFuncs{
+		Command: func(cmd *flags.Command) error {
+			for _, example := range cmd.Examples {
+				if err := checkExample(dataType.Elem(), cmd.Path, example, opts); err != nil {
+					failed = append(failed, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	if err := flags.Walk(data, visitor, opts...); err != nil {
+		return nil, err
+	}
+
+	return failed, nil
+}
+
+// SelfTestCommand returns a hidden command running SelfTest on the data when executed,
+// printing the examples failing to parse, to be added to the root command of data:
+//
+//	rootCmd := gen.Generate(data)
+//	rootCmd.AddCommand(gen.SelfTestCommand(data))
+func SelfTestCommand(data interface{}, opts ...flags.OptFunc) *cobra.Command {
+	return &cobra.Command{
+		Use:    SelfTestName,
+		Short:  "Check that all the command examples parse",
+		Hidden: true,
+		Args:   cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			failed, err := SelfTest(data, opts...)
+			if err != nil {
+				return err
+			}
+
+			for _, err := range failed {
+				fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+			}
+
+			if len(failed) > 0 {
+				return fmt.Errorf("%w: %d example(s) failed to parse", ErrExample, len(failed))
+			}
+
+			return nil
+		},
+	}
+}
+
+// checkExample parses an example of a command onto a new value of the data type.
+func checkExample(dataType reflect.Type, path []string, example string, opts []flags.OptFunc) error {
+	words := splitWords(example)
+	if len(words) > 0 && words[0] == "$" {
+		words = words[1:]
+	}
+
+	if len(words) == 0 {
+		return fmt.Errorf("%w: %q: no program name", ErrExample, example)
+	}
+
+	target, _, err := parseTarget(reflect.New(dataType).Interface(), words[1:], opts)
+	if err != nil {
+		return fmt.Errorf("%w: %q: %s", ErrExample, example, err.Error())
+	}
+
+	targetPath := target.path()
+	if len(targetPath) < len(path) || strings.Join(targetPath[:len(path)], " ") != strings.Join(path, " ") {
+		return fmt.Errorf("%w: %q: targets command %q instead of %q", ErrExample, example,
+			strings.Join(targetPath, " "), strings.Join(path, " "))
+	}
+
+	return nil
+}
+
+// splitWords splits a command-line (an example, or a shell line) into words, removing
+// the single or double quotes around them, and the backslashes escaping characters.
+func splitWords(line string) []string {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, char := range line {
+		switch {
+		case escaped:
+			word.WriteRune(char)
+			escaped = false
+		case char == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0 && char == quote:
+			quote = 0
+		case quote != 0:
+			word.WriteRune(char)
+		case char == '\'' || char == '"':
+			quote, inWord = char, true
+		case char == ' ' || char == '\t' || char == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+			}
+
+			inWord = false
+		default:
+			word.WriteRune(char)
+			inWord = true
+		}
+	}
+
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words
+}
@@ -0,0 +1,69 @@
+package flags
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// exampleCommand is a command tree whose examples are checked by SelfTest.
+type exampleCommand struct {
+	Verbose bool `short:"v" long:"verbose"`
+
+	Deploy struct {
+		Image string `long:"image" required:"yes"`
+
+		Args struct {
+			Target string `required:"1"`
+		} `positional-args:"yes"`
+	} `command:"deploy" alias:"dp" example:"$ app deploy --image 'nginx:latest' web" example:"app dp --image=nginx web"`
+
+	Status struct{} `command:"status" example:"app -v status" example:"app deploy --image nginx web"`
+}
+
+// TestSelfTest checks that examples are parsed against the commands they document.
+func TestSelfTest(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+
+	failed, err := SelfTest(&exampleCommand{})
+	test.NoError(err)
+	test.Len(failed, 1, "Only the example targeting another command should fail")
+	test.ErrorIs(failed[0], ErrExample)
+	test.ErrorContains(failed[0], `targets command "deploy" instead of "status"`)
+
+	// Examples that do not parse anymore.
+	broken := &struct {
+		Deploy struct {
+			Image string `long:"image"`
+
+			Args struct {
+				Target string `required:"1"`
+			} `positional-args:"yes"`
+		} `command:"deploy" example:"app deploy --tag nginx web" example:"app deploy"`
+	}{}
+
+	failed, err = SelfTest(broken)
+	test.NoError(err)
+	test.Len(failed, 2)
+
+	// The hidden command reports all failing examples.
+	cmd := SelfTestCommand(broken)
+	out := &bytes.Buffer{}
+	cmd.SetErr(out)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{})
+
+	test.True(cmd.Hidden)
+	test.ErrorIs(cmd.Execute(), ErrExample)
+	test.Contains(out.String(), `"app deploy --tag nginx web"`)
+}
+
+func TestSplitWords(t *testing.T) {
+	t.Parallel()
+
+	words := splitWords(`app  --filter '{"a": 1}' "two words" esc\ aped ""`)
+	assert.Equal(t, []string{"app", "--filter", `{"a": 1}`, "two words", "esc aped", ""}, words)
+}
@@ -0,0 +1,178 @@
+package flags
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/convert"
+	"github.com/spf13/cobra"
+)
+
+// ExitName is the word stopping a shell, unless its tree has a command with this name.
+const ExitName = "exit"
+
+// Shell is a closed-loop interpreter of a command tree: it reads command-lines, splits
+// them into words (which can be quoted), and executes them on a command tree generated
+// anew for each line, from a copy of the data struct given to NewShell (thus with its
+// values as defaults). Options and positionals set by a command-line are thus never
+// seen by the next ones.
+type Shell struct {
+	Prompt string    // Prompt written before reading each line, if any
+	In     io.Reader // Lines to execute, by default os.Stdin
+	Out    io.Writer // Output of the prompt and of commands, by default os.Stdout
+	Err    io.Writer // Errors of commands, by default os.Stderr
+
+	// Setup, if not nil, is called with each new command tree, before executing
+	// a line (eg. to set renderers, themes, or persistent hooks on its commands).
+	Setup func(root *cobra.Command)
+
+	data        reflect.Value
+	opts        []flags.OptFunc
+	exitCommand bool
+}
+
+// NewShell returns a shell executing the commands found in data, which must be a pointer to
+// a struct: it is copied when the shell is created (with the maps, slices and pointers of its
+// exported fields), and never modified by the shell. The options are those given to Generate(),
+// to which flags.WithMode(flags.ModeREPL) is added, so that the builtins are hidden.
+func NewShell(data interface{}, opts ...flags.OptFunc) (*Shell, error) {
+	value, err := structValue(data)
+	if err != nil {
+		return nil, err
+	}
+
+	shell := &Shell{
+		Prompt: "> ",
+		In:     os.Stdin,
+		Out:    os.Stdout,
+		Err:    os.Stderr,
+		data:   convert.Copy(value),
+		opts:   append(opts[:len(opts):len(opts)], flags.WithMode(flags.ModeREPL)),
+	}
+
+	// Invalid command structs are reported once, instead of at each line.
+	root, err := newTree(shell.data, shell.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Forget(root)
+
+	for _, cmd := range root.Commands() {
+		shell.exitCommand = shell.exitCommand || cmd.Name() == ExitName || cmd.HasAlias(ExitName)
+	}
+
+	return shell, nil
+}
+
+// Run reads and executes command-lines until the end of the input, or until the exit
+// word (see ExitName) is read. The errors of commands do not stop the shell, since
+// they are printed by their command tree: only errors reading the input are returned.
+func (s *Shell) Run() error {
+	scanner := bufio.NewScanner(s.In)
+
+	for {
+		if s.Prompt != "" {
+			fmt.Fprint(s.Out, s.Prompt)
+		}
+
+		if !scanner.Scan() {
+			return scanner.Err()
+		}
+
+		words := splitWords(scanner.Text())
+		if len(words) == 0 {
+			continue
+		}
+
+		if words[0] == ExitName && !s.exitCommand {
+			return nil
+		}
+
+		_ = s.Execute(words...)
+	}
+}
+
+// Execute executes a command-line, already split into words, on a new command tree.
+// It is safe for concurrent use, provided the outputs of the shell are as well.
+func (s *Shell) Execute(words ...string) error {
+	return execute(s.data, words, s.opts, func(root *cobra.Command) {
+		root.SetIn(s.In)
+		root.SetOut(s.Out)
+		root.SetErr(s.Err)
+
+		if s.Setup != nil {
+			s.Setup(root)
+		}
+	})
+}
+
+// ExecuteArgs executes a command-line (without the program name) on a new command tree,
+// generated from a copy of data, which must be a pointer to a struct: its values are thus the
+// defaults of the command-line, and it is never modified (the maps, slices and pointers of its
+// exported fields are copied as well). The setup function, if not nil, is called with the tree
+// before executing the command-line (eg. to set its outputs). The options are those given to
+// Generate().
+//
+// Since each command-line has its own tree and structs, ExecuteArgs is safe for concurrent
+// use (as long as data is not modified meanwhile), like in servers executing several
+// command-lines at once (eg. in RPC handlers).
+func ExecuteArgs(data interface{}, args []string, setup func(root *cobra.Command), opts ...flags.OptFunc) error {
+	value, err := structValue(data)
+	if err != nil {
+		return err
+	}
+
+	return execute(value, args, opts, setup)
+}
+
+// structValue returns the value of a pointer to a struct (a new one if nil).
+func structValue(data interface{}) (reflect.Value, error) {
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Ptr || value.Type().Elem().Kind() != reflect.Struct {
+		return value, flags.ErrNotPointerToStruct
+	}
+
+	if value.IsNil() {
+		value = reflect.New(value.Type().Elem())
+	}
+
+	return value, nil
+}
+
+// execute executes a command-line on a new tree generated from a copy of a struct.
+func execute(data reflect.Value, args []string, opts []flags.OptFunc, setup func(*cobra.Command)) error {
+	root, err := newTree(data, opts)
+	if err != nil {
+		return err
+	}
+
+	defer Forget(root)
+
+	if setup != nil {
+		setup(root)
+	}
+
+	root.SetArgs(args)
+
+	return root.Execute()
+}
+
+// newTree returns a new command tree, generated from a copy of a struct.
+func newTree(data reflect.Value, opts []flags.OptFunc) (*cobra.Command, error) {
+	root := &cobra.Command{
+		Use:              os.Args[0],
+		Annotations:      map[string]string{},
+		TraverseChildren: true,
+	}
+
+	if err := generate(root, convert.Copy(data).Interface(), opts...); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
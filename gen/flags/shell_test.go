@@ -0,0 +1,142 @@
+package flags
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoCommand is a command with an option accumulating values.
+type echoCommand struct {
+	Tags []string `long:"tag" short:"t" description:"tags to echo"`
+}
+
+func (e *echoCommand) Execute(args []string) error { return nil }
+
+// TestShell checks that shells execute each line on a new command tree,
+// with quoted words, and that they stop on the exit word or the input end.
+func TestShell(t *testing.T) {
+	t.Parallel()
+
+	rootData := struct {
+		Echo echoCommand `command:"echo"`
+	}{}
+
+	shell, err := NewShell(&rootData)
+	require.NoError(t, err)
+
+	out := &bytes.Buffer{}
+	shell.Prompt = "$ "
+	shell.In = strings.NewReader("echo -t one --tag 'two words'\n\necho -t three\nunknown\nexit\necho -t four\n")
+	shell.Out, shell.Err = out, out
+
+	shell.Setup = func(root *cobra.Command) {
+		root.SilenceUsage = true
+		echo, _, _ := root.Find([]string{"echo"})
+		echo.PostRun = func(cmd *cobra.Command, args []string) {
+			tags, _ := cmd.Flags().GetStringSlice("tag")
+			fmt.Fprintf(cmd.OutOrStdout(), "%q\n", tags)
+		}
+	}
+
+	require.NoError(t, shell.Run())
+
+	assert.Contains(t, out.String(), "$ [\"one\" \"two words\"]\n$ $ [\"three\"]\n$ Error: ")
+	assert.NotContains(t, out.String(), "four")
+	assert.Empty(t, rootData.Echo.Tags)
+
+	// The values of the struct are the defaults of each line, and are never modified.
+	rootData.Echo.Tags = []string{"default"}
+
+	shell, err = NewShell(&rootData)
+	require.NoError(t, err)
+
+	out.Reset()
+	shell.Prompt = ""
+	shell.In = strings.NewReader("echo -t one\necho\n")
+	shell.Out, shell.Err = out, out
+	shell.Setup = func(root *cobra.Command) {
+		echo, _, _ := root.Find([]string{"echo"})
+		echo.PostRun = func(cmd *cobra.Command, args []string) {
+			tags, _ := cmd.Flags().GetStringSlice("tag")
+			fmt.Fprintf(cmd.OutOrStdout(), "%q\n", tags)
+		}
+	}
+
+	require.NoError(t, shell.Run())
+	assert.Equal(t, "[\"one\"]\n[\"default\"]\n", out.String())
+	assert.Equal(t, []string{"default"}, rootData.Echo.Tags)
+}
+
+// TestShellInvalid checks that shells are not created for invalid data.
+func TestShellInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewShell(echoCommand{})
+	assert.ErrorIs(t, err, flags.ErrNotPointerToStruct)
+}
+
+// TestExecuteArgs checks that command-lines executed concurrently
+// each have their own tree and structs, and thus their own values.
+func TestExecuteArgs(t *testing.T) {
+	t.Parallel()
+
+	type rootData struct {
+		Echo echoCommand `command:"echo"`
+	}
+
+	outs := make([]bytes.Buffer, 8)
+
+	var group sync.WaitGroup
+
+	for i := range outs {
+		group.Add(1)
+
+		go func(out *bytes.Buffer, tag string) {
+			defer group.Done()
+
+			err := ExecuteArgs(&rootData{}, []string{"echo", "--tag", tag}, func(root *cobra.Command) {
+				echo, _, _ := root.Find([]string{"echo"})
+				echo.PostRun = func(cmd *cobra.Command, args []string) {
+					tags, _ := cmd.Flags().GetStringSlice("tag")
+					fmt.Fprintf(out, "%q", tags)
+				}
+			})
+			assert.NoError(t, err)
+		}(&outs[i], fmt.Sprint(i))
+	}
+
+	group.Wait()
+
+	for i := range outs {
+		assert.Equal(t, fmt.Sprintf("[%q]", fmt.Sprint(i)), outs[i].String())
+	}
+
+	assert.ErrorIs(t, ExecuteArgs(rootData{}, nil, nil), flags.ErrNotPointerToStruct)
+
+	// The values of the struct are the defaults of each command-line, and are never modified.
+	data := &rootData{Echo: echoCommand{Tags: []string{"default"}}}
+
+	for args, expected := range map[string]string{"echo": `["default"]`, "echo --tag one": `["one"]`} {
+		out := &bytes.Buffer{}
+
+		err := ExecuteArgs(data, strings.Fields(args), func(root *cobra.Command) {
+			echo, _, _ := root.Find([]string{"echo"})
+			echo.PostRun = func(cmd *cobra.Command, _ []string) {
+				tags, _ := cmd.Flags().GetStringSlice("tag")
+				fmt.Fprintf(out, "%q", tags)
+			}
+		})
+		require.NoError(t, err)
+		assert.Equal(t, expected, out.String(), args)
+	}
+
+	assert.Equal(t, []string{"default"}, data.Echo.Tags)
+}
@@ -0,0 +1,107 @@
+package flags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+)
+
+// ErrShutdownTimeout indicates that a command canceled by a signal did not return in time.
+var ErrShutdownTimeout = errors.New("command did not stop in time")
+
+// exit exits the program when a command canceled by a signal does not return in time.
+var exit = os.Exit
+
+// signalCancel makes the commands of a tree run with a context canceled
+// by the signals given in options, if enabled (see flags.WithSignalCancel).
+func signalCancel(cmd *cobra.Command, opts []flags.OptFunc) {
+	options := flags.ScanOptions(opts...)
+	if !options.SignalCancel {
+		return
+	}
+
+	signals := options.CancelSignals
+	if len(signals) == 0 {
+		signals = []os.Signal{os.Interrupt}
+	}
+
+	// Prepended, so that middlewares of the command (and those of its
+	// subcommands) see the context they are canceled with as well.
+	used, _ := middlewares.Load(cmd)
+	chain, _ := used.([]Middleware)
+	cancel := cancelOnSignals(signals, options.ShutdownNotice, options.ShutdownTimeout)
+
+	middlewares.Store(cmd, append([]Middleware{cancel}, chain...))
+}
+
+// cancelOnSignals returns the middleware running commands with a context canceled by signals.
+// Commands run on the calling goroutine, while another one waits for their context to be
+// canceled, to print the notice and to exit the program if they do not return in time.
+func cancelOnSignals(signals []os.Signal, notice string, timeout time.Duration) Middleware {
+	return func(next RunFunc) RunFunc {
+		return func(cmd *cobra.Command, args []string) error {
+			parent := cmd.Context()
+
+			ctx, stop := signal.NotifyContext(parent, signals...)
+			defer stop()
+
+			cmd.SetContext(ctx)
+
+			done, stopped := make(chan struct{}), make(chan struct{})
+			go func() {
+				shutdown(cmd, ctx, parent, stop, done, notice, timeout)
+				close(stopped)
+			}()
+
+			err := next(cmd, args)
+			close(done)
+			<-stopped
+
+			return err
+		}
+	}
+}
+
+// shutdown waits for the context of a running command to be canceled by a signal (or for
+// the command to be done), prints the notice, and exits the program if the command is not
+// done before the timeout.
+func shutdown(cmd *cobra.Command, ctx, parent context.Context, stop func(), done <-chan struct{},
+	notice string, timeout time.Duration,
+) {
+	select {
+	case <-done:
+		if ctx.Err() == nil {
+			return
+		}
+	case <-ctx.Done():
+	}
+
+	// Further signals are not caught anymore.
+	stop()
+
+	// Commands canceled by the program itself are not shutting down.
+	if parent.Err() != nil {
+		return
+	}
+
+	if notice != "" {
+		fmt.Fprintln(cmd.ErrOrStderr(), notice)
+	}
+
+	if timeout <= 0 {
+		return
+	}
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		fmt.Fprintln(cmd.ErrOrStderr(), fmt.Errorf("%w: %s", ErrShutdownTimeout, timeout))
+		exit(1)
+	}
+}
@@ -0,0 +1,76 @@
+//go:build !windows
+
+package flags
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/reeflective/flags"
+	"github.com/stretchr/testify/assert"
+)
+
+// signaledCommand sends a signal to the program once running, and
+// waits for its context to be canceled, unless it ignores it.
+type signaledCommand struct {
+	Ignore bool `long:"ignore"`
+}
+
+func (c *signaledCommand) ExecuteContext(ctx context.Context, args []string) error {
+	if err := syscall.Kill(os.Getpid(), syscall.SIGUSR1); err != nil {
+		return err
+	}
+
+	if c.Ignore {
+		time.Sleep(100 * time.Millisecond)
+
+		return nil
+	}
+
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+// TestSignalCancel checks that signals cancel the context of commands, and that
+// the program exits when commands do not return in time once canceled.
+// Signals are sent to the whole test program, which is why it is not parallel.
+func TestSignalCancel(t *testing.T) {
+	rootData := struct {
+		Run signaledCommand `command:"run"`
+	}{}
+
+	opts := []flags.OptFunc{
+		flags.WithSignalCancel(syscall.SIGUSR1),
+		flags.WithShutdown("shutting down", 10*time.Millisecond),
+	}
+
+	root := Generate(&rootData, opts...)
+	root.SilenceUsage, root.SilenceErrors = true, true
+
+	out := &bytes.Buffer{}
+	root.SetErr(out)
+	root.SetArgs([]string{"run"})
+
+	assert.ErrorIs(t, root.Execute(), context.Canceled)
+	assert.Equal(t, "shutting down\n", out.String())
+
+	var code int
+
+	exit = func(status int) { code = status }
+	defer func() { exit = os.Exit }()
+
+	root = Generate(&rootData, opts...)
+	root.SilenceUsage, root.SilenceErrors = true, true
+	out.Reset()
+	root.SetErr(out)
+	root.SetArgs([]string{"run", "--ignore"})
+
+	assert.NoError(t, root.Execute())
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "shutting down\n"+ErrShutdownTimeout.Error()+": 10ms\n", out.String())
+}
@@ -0,0 +1,106 @@
+package flags
+
+import (
+	goflag "flag"
+	"fmt"
+	"sort"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/pflag"
+)
+
+// ParseToFlagSet parses cfg, that is a pointer to some structure, and puts its options into
+// an existing pflag.FlagSet (eg. pflag.CommandLine), for programs parsing their command-line
+// with pflag but without cobra commands. Option values are the same as with Generate(), but
+// relations between options, required ones and groups are only checked by generated commands,
+// and options are only set from their environment variables with SetEnv, once parsed.
+func ParseToFlagSet(cfg interface{}, dst *pflag.FlagSet, optFuncs ...flags.OptFunc) error {
+	_, err := parseTo(cfg, dst, optFuncs...)
+
+	return err
+}
+
+// ParseToStdFlag parses cfg, that is a pointer to some structure, and puts its options into a
+// flag.FlagSet of the standard library (eg. flag.CommandLine), for programs using the latter.
+// The standard flags have no short names: these, like aliases, previous names and negations
+// of options, are other flags of the set sharing the value of the option (eg. -v and -verbose).
+// As with ParseToFlagSet, relations between options and required ones are not checked, and
+// options are not set from their environment variables (nor are those only set from them).
+func ParseToStdFlag(cfg interface{}, dst *goflag.FlagSet, optFuncs ...flags.OptFunc) error {
+	flagSet, err := flags.ParseStruct(cfg, optFuncs...)
+	if err != nil {
+		return fmt.Errorf("%w: %s", flags.ErrParse, err.Error())
+	}
+
+	for _, srcFlag := range flagSet {
+		if srcFlag.EnvOnly {
+			continue
+		}
+
+		if err := generateStdFlag(srcFlag, dst); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateStdFlag adds the flags of an option to a standard flag set.
+func generateStdFlag(srcFlag *flags.Flag, dst *goflag.FlagSet) error {
+	isBool := false
+	if boolFlag, casted := srcFlag.Value.(flags.BoolFlag); casted {
+		isBool = boolFlag.IsBoolFlag()
+	}
+
+	names := []string{srcFlag.Short}
+	if !srcFlag.ShortOnly {
+		names = append([]string{srcFlag.Name}, names...)
+	}
+
+	values := make(map[string]pflag.Value)
+
+	for _, name := range append(names, srcFlag.Aliases...) {
+		values[name] = srcFlag.Value
+	}
+
+	for _, name := range srcFlag.RenamedFrom {
+		values[name] = &renamedValue{Value: srcFlag.Value, name: name, target: srcFlag.Name, output: dst.Output}
+	}
+
+	values[srcFlag.Negation] = &negatedValue{srcFlag.Value}
+	delete(values, "")
+
+	sorted := make([]string, 0, len(values))
+	for name := range values {
+		sorted = append(sorted, name)
+	}
+
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		if dst.Lookup(name) != nil {
+			return fmt.Errorf("%w: -%s", flags.ErrDuplicatedFlag, name)
+		}
+
+		dst.Var(&stdValue{Value: values[name], isBool: isBool}, name, srcFlag.Usage)
+	}
+
+	return nil
+}
+
+// stdValue is the value of a standard flag, which is a boolean
+// one (given without value) if the value of its option is.
+type stdValue struct {
+	pflag.Value
+	isBool bool
+}
+
+func (v *stdValue) IsBoolFlag() bool { return v.isBool }
+
+func (v *stdValue) Get() interface{} {
+	if getter, isGetter := v.Value.(flags.Getter); isGetter {
+		return getter.Get()
+	}
+
+	return v.String()
+}
@@ -0,0 +1,73 @@
+package flags
+
+import (
+	goflag "flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stdConfig is a struct of options for standalone flag sets.
+type stdConfig struct {
+	Verbose bool          `short:"v" long:"verbose" negatable:""`
+	Hosts   []string      `long:"hosts" alias:"host"`
+	Timeout time.Duration `long:"timeout" renamed-from:"wait" env:"TIMEOUT"`
+	Port    int           `long:"port" choice:"80" choice:"443" env:"PORT"`
+}
+
+// TestParseToFlagSet checks that options are put into existing pflag sets.
+func TestParseToFlagSet(t *testing.T) {
+	t.Parallel()
+
+	cfg := &stdConfig{}
+	flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+
+	require.NoError(t, ParseToFlagSet(cfg, flagSet))
+	require.NoError(t, flagSet.Parse([]string{"-v", "--hosts", "a", "--host", "b", "--port", "443"}))
+
+	assert.True(t, cfg.Verbose)
+	assert.Equal(t, []string{"a", "b"}, cfg.Hosts)
+	assert.Equal(t, 443, cfg.Port)
+
+	require.NoError(t, SetEnv(flagSet, flags.WithEnviron([]string{"PORT=80", "TIMEOUT=1s"})))
+	assert.Equal(t, 443, cfg.Port, "options given on the command-line should not be set from the environment")
+	assert.Equal(t, time.Second, cfg.Timeout)
+
+	assert.Error(t, flagSet.Parse([]string{"--port", "8080"}))
+	assert.ErrorIs(t, ParseToFlagSet(&stdConfig{}, flagSet), flags.ErrDuplicatedFlag)
+}
+
+// TestParseToStdFlag checks that options are put into standard flag
+// sets, with all their names, including short ones and negations.
+func TestParseToStdFlag(t *testing.T) {
+	t.Parallel()
+
+	cfg := &stdConfig{Port: 80}
+	flagSet := goflag.NewFlagSet("test", goflag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+
+	require.NoError(t, ParseToStdFlag(cfg, flagSet))
+	require.NoError(t, flagSet.Parse([]string{"-v", "-host", "a", "-hosts", "b", "-wait", "1s", "arg"}))
+
+	assert.True(t, cfg.Verbose)
+	assert.Equal(t, []string{"a", "b"}, cfg.Hosts)
+	assert.Equal(t, time.Second, cfg.Timeout)
+	assert.Equal(t, []string{"arg"}, flagSet.Args())
+	assert.Equal(t, "80", flagSet.Lookup("port").DefValue)
+
+	require.NoError(t, flagSet.Parse([]string{"-no-verbose"}))
+	assert.False(t, cfg.Verbose)
+
+	getter, isGetter := flagSet.Lookup("timeout").Value.(goflag.Getter)
+	require.True(t, isGetter)
+	assert.Equal(t, time.Second, getter.Get())
+
+	assert.Error(t, flagSet.Parse([]string{"-port", "8080"}))
+	assert.ErrorIs(t, ParseToStdFlag(&stdConfig{}, flagSet), flags.ErrDuplicatedFlag)
+}
@@ -0,0 +1,143 @@
+package flags
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/terminal"
+	"github.com/spf13/cobra"
+)
+
+// Theme holds the styles of the elements of help usages, as ANSI SGR parameters
+// (eg. "1" for bold, "36" for cyan, or "1;4" for bold and underlined). Elements
+// with an empty style are not styled, so that an empty theme disables colors.
+type Theme struct {
+	Header      string // Headers of the sections (eg. "Flags:")
+	Flag        string // Names of options (eg. "-m, --mode")
+	Placeholder string // Types of the values of options (eg. "string")
+	Default     string // Default values of options
+}
+
+// DefaultTheme is the theme of the help usages of commands without one (see SetTheme).
+var DefaultTheme = Theme{
+	Header:      "1",
+	Flag:        "36",
+	Placeholder: "33",
+	Default:     "2",
+}
+
+// themes are the themes set on commands.
+var themes sync.Map
+
+// SetTheme sets the theme of the help usages of a command and of its subcommands, unless
+// they have their own. Themes are only used when colors are enabled (see flags.ColorsEnabled)
+// and when help is written to a terminal, unless colors are forced with flags.ColorAlways.
+// A nil theme removes the one of the command, which then uses the one of its parents.
+func SetTheme(cmd *cobra.Command, theme *Theme) {
+	if theme == nil {
+		themes.Delete(cmd)
+	} else {
+		themes.Store(cmd, theme)
+	}
+}
+
+// helpOutputs are the outputs to which the help usages of commands are being written.
+var helpOutputs sync.Map
+
+// themeOutputs makes the help and usage functions of a tree record the output to which
+// they write while they run: cobra renders usages to a buffer before writing them (to
+// the standard output for help usages, and to the error one for usages on errors).
+func themeOutputs(cmd *cobra.Command) {
+	help, usage := cmd.HelpFunc(), cmd.UsageFunc()
+
+	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		if _, loaded := helpOutputs.LoadOrStore(cmd, cmd.OutOrStdout()); !loaded {
+			defer helpOutputs.Delete(cmd)
+		}
+
+		help(cmd, args)
+	})
+
+	cmd.SetUsageFunc(func(cmd *cobra.Command) error {
+		if _, loaded := helpOutputs.LoadOrStore(cmd, cmd.OutOrStderr()); !loaded {
+			defer helpOutputs.Delete(cmd)
+		}
+
+		return usage(cmd)
+	})
+}
+
+// helpTheme returns the theme of the help usage of a command, or an empty one if
+// its help should not be colored (disabled colors, or output not to a terminal).
+func helpTheme(cmd *cobra.Command) Theme {
+	if !colorsEnabled(cmd) {
+		return Theme{}
+	}
+
+	if flags.ScanOptions(rootOptions(cmd)...).Colors != flags.ColorAlways {
+		output, found := helpOutputs.Load(cmd)
+		if !found {
+			output = cmd.OutOrStdout()
+		}
+
+		if file, isFile := output.(*os.File); !isFile || !terminal.IsTerminal(file) {
+			return Theme{}
+		}
+	}
+
+	if theme, found := renderer[*Theme](&themes, cmd); found {
+		return *theme
+	}
+
+	return DefaultTheme
+}
+
+// styled returns a text with a style, if it is not empty.
+func styled(style, text string) string {
+	if style == "" || text == "" {
+		return text
+	}
+
+	return "\x1b[" + style + "m" + text + "\x1b[0m"
+}
+
+// helpHeader returns a header of the help usage of a command, styled with its theme.
+func helpHeader(cmd *cobra.Command, header string) string {
+	return styled(helpTheme(cmd).Header, header)
+}
+
+// styledHead returns the names of an option (and the placeholder of its value, if any) as
+// shown in help usages, with their styles: names are the leading words starting with a dash.
+func styledHead(head string, theme Theme) string {
+	if theme.Flag == "" && theme.Placeholder == "" {
+		return head
+	}
+
+	words := strings.Fields(head)
+	indent := head[:len(head)-len(strings.TrimLeft(head, " "))]
+
+	names := 0
+	for names < len(words) && strings.HasPrefix(words[names], "-") {
+		names++
+	}
+
+	styledHead := indent + styled(theme.Flag, strings.Join(words[:names], " "))
+	if names < len(words) {
+		styledHead += " " + styled(theme.Placeholder, strings.Join(words[names:], " "))
+	}
+
+	return styledHead
+}
+
+// styledUsage returns the usage of an option with its default value
+// styled, which pflag always shows at its end, between parentheses.
+func styledUsage(usage string, theme Theme) string {
+	start := strings.LastIndex(usage, "(default ")
+	if theme.Default == "" || start == -1 || !strings.HasSuffix(usage, ")") {
+		return usage
+	}
+
+	return usage[:start] + styled(theme.Default, usage[start:])
+}
@@ -0,0 +1,70 @@
+package flags
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openTerminal opens a pseudo-terminal 80 columns wide, or skips the test if there is none.
+func openTerminal(t *testing.T) *os.File {
+	t.Helper()
+
+	ptmx, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.Skip("no pseudo-terminals:", err)
+	}
+
+	t.Cleanup(func() { ptmx.Close() })
+
+	var unlock, number uint32
+
+	ioctl := func(req, arg uintptr) {
+		_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, ptmx.Fd(), req, arg)
+		require.Zero(t, errno)
+	}
+
+	ioctl(syscall.TIOCSPTLCK, uintptr(unsafe.Pointer(&unlock)))
+	ioctl(syscall.TIOCGPTN, uintptr(unsafe.Pointer(&number)))
+
+	pts, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", number), os.O_RDWR|syscall.O_NOCTTY, 0)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { pts.Close() })
+
+	size := [4]uint16{24, 80}
+	ioctl(syscall.TIOCSWINSZ, uintptr(unsafe.Pointer(&size)))
+
+	return pts
+}
+
+// TestHelpThemeOutput checks that usages are styled when the output they are
+// written to is a terminal, although cobra renders them to a buffer first.
+func TestHelpThemeOutput(t *testing.T) {
+	t.Parallel()
+
+	data := struct {
+		Mode string `long:"mode" description:"fetch or push"`
+	}{}
+
+	root := Generate(&data, flags.WithEnviron(nil), flags.WithHelpWidth(-1))
+
+	var usage string
+
+	SetUsageRenderer(root, func(_ *cobra.Command, text string) string {
+		usage = text
+		return ""
+	})
+
+	root.SetOut(openTerminal(t))
+
+	assert.NoError(t, root.Usage())
+	assert.Contains(t, usage, "\x1b[1mFlags:\x1b[0m\n")
+}
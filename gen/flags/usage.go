@@ -0,0 +1,165 @@
+package flags
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/reeflective/flags/internal/usage"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// usageStatsFile is the file in which usage statistics are persisted,
+// in the user cache directory of the application (eg. ~/.cache/<name>/).
+const usageStatsFile = "usage.json"
+
+// mostUsedCount is the maximum number of commands and options shown
+// in the "Most used" section of help usages, when usage stats are enabled.
+const mostUsedCount = 5
+
+// EnableUsageStats enables the local, opt-in counting of the commands and options used
+// by the application (no values are ever recorded), persisted in the user cache directory
+// under the application name. When enabled, help usages show the most used subcommands
+// and options of each command. This function does nothing when the DO_NOT_TRACK variable
+// is set to a non-empty value, which users can use as a privacy switch.
+func EnableUsageStats(name string) error {
+	if os.Getenv("DO_NOT_TRACK") != "" {
+		return nil
+	}
+
+	path, err := usageStatsPath(name)
+	if err != nil {
+		return err
+	}
+
+	return usage.Enable(path)
+}
+
+// DisableUsageStats stops counting the commands and options used,
+// without removing the statistics already persisted.
+func DisableUsageStats() {
+	usage.Disable()
+}
+
+// ResetUsageStats removes the usage statistics persisted for the application.
+func ResetUsageStats(name string) error {
+	path, err := usageStatsPath(name)
+	if err != nil {
+		return err
+	}
+
+	return usage.Reset(path)
+}
+
+// usageStatsPath returns the path of the usage statistics file of an application.
+func usageStatsPath(name string) (string, error) {
+	cache, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cache, filepath.Base(name), usageStatsFile), nil
+}
+
+// recordUsage makes all runnable commands of the tree count their uses, and those
+// of the options given to them, when usage stats are enabled: failing to persist them
+// never prevents a command from running. Their help usages show the most used ones.
+func recordUsage(cmd *cobra.Command) {
+	for _, subc := range cmd.Commands() {
+		recordUsage(subc)
+	}
+
+	if cmd.Parent() == nil {
+		help := cmd.HelpFunc()
+		cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+			help(cmd, args)
+			writeMostUsed(cmd.OutOrStdout(), cmd)
+		})
+	}
+
+	if next := cmd.RunE; next != nil {
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			record(cmd)
+
+			return next(cmd, args)
+		}
+	} else if next := cmd.Run; next != nil {
+		cmd.Run = func(cmd *cobra.Command, args []string) {
+			record(cmd)
+			next(cmd, args)
+		}
+	}
+}
+
+// record counts a use of a command and of the options it has been given.
+func record(cmd *cobra.Command) {
+	if !usage.Enabled() {
+		return
+	}
+
+	var used []string
+
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		used = append(used, flag.Name)
+	})
+
+	_ = usage.Record(commandPath(cmd), used)
+}
+
+// commandPath returns the names of a command and of its parents, without the root one.
+func commandPath(cmd *cobra.Command) []string {
+	if !cmd.HasParent() {
+		return nil
+	}
+
+	return append(commandPath(cmd.Parent()), cmd.Name())
+}
+
+// writeMostUsed writes the most used subcommands and options of a command, if any.
+func writeMostUsed(writer io.Writer, cmd *cobra.Command) {
+	if !usage.Enabled() {
+		return
+	}
+
+	path := commandPath(cmd)
+	subcommands, options := usage.Subcommands(path), usage.Flags(path)
+
+	if len(subcommands) == 0 && len(options) == 0 {
+		return
+	}
+
+	fmt.Fprintln(writer, "\nMost used:")
+
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	for i, used := range subcommands {
+		if i == mostUsedCount {
+			break
+		}
+
+		fmt.Fprintf(table, "  %s\t%s\n", used.Name, uses(used.Uses))
+	}
+
+	for i, used := range options {
+		if i == mostUsedCount {
+			break
+		}
+
+		fmt.Fprintf(table, "  --%s\t%s\n", used.Name, uses(used.Uses))
+	}
+
+	table.Flush()
+}
+
+// uses formats a number of uses.
+func uses(count int) string {
+	if count == 1 {
+		return "1 time"
+	}
+
+	return strconv.Itoa(count) + " times"
+}
@@ -0,0 +1,68 @@
+package flags
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUsageStats checks that uses of commands and options are counted
+// when enabled, persisted, shown in help usages, and can be reset.
+func TestUsageStats(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("DO_NOT_TRACK", "")
+
+	require.NoError(t, EnableUsageStats("app"))
+	defer DisableUsageStats()
+
+	rootData := struct {
+		Remote struct {
+			Add testCommand `command:"add"`
+			Del testCommand `command:"del"`
+		} `command:"remote"`
+	}{}
+
+	root := Generate(&rootData)
+	out := &bytes.Buffer{}
+	root.SetOut(out)
+
+	for _, args := range [][]string{{"remote", "add", "-p"}, {"remote", "del"}, {"remote", "add", "-p"}} {
+		root.SetArgs(args)
+		require.NoError(t, root.Execute())
+	}
+
+	root.SetArgs([]string{"remote", "--help"})
+	require.NoError(t, root.Execute())
+
+	test := assert.New(t)
+	test.Contains(out.String(), "Most used:\n  add  2 times\n  del  1 time\n")
+
+	out.Reset()
+	root.SetArgs([]string{"remote", "add", "--help"})
+	require.NoError(t, root.Execute())
+	test.Contains(out.String(), "Most used:\n  --opts-p  2 times\n")
+
+	// Statistics are persisted, and can be reset.
+	path := filepath.Join(os.Getenv("XDG_CACHE_HOME"), "app", usageStatsFile)
+	test.FileExists(path)
+
+	DisableUsageStats()
+	require.NoError(t, EnableUsageStats("app"))
+
+	out.Reset()
+	root.SetArgs([]string{"remote", "--help"})
+	require.NoError(t, root.Execute())
+	test.Contains(out.String(), "add  2 times", "Statistics should have been loaded again")
+
+	require.NoError(t, ResetUsageStats("app"))
+	test.NoFileExists(path)
+
+	out.Reset()
+	root.SetArgs([]string{"remote", "--help"})
+	require.NoError(t, root.Execute())
+	test.NotContains(out.String(), "Most used:")
+}
@@ -0,0 +1,81 @@
+package flags
+
+import (
+	"sync"
+
+	"github.com/reeflective/flags"
+	"github.com/spf13/cobra"
+)
+
+// validaters holds the structs implementing flags.Validater found while
+// generating commands, until they are bound to them by validateStructs.
+var validaters = struct {
+	sync.Mutex
+	local      map[*cobra.Command][]flags.Validater
+	persistent map[*cobra.Command][]flags.Validater
+}{
+	local:      map[*cobra.Command][]flags.Validater{},
+	persistent: map[*cobra.Command][]flags.Validater{},
+}
+
+// addValidater registers a command, group or positionals struct to be
+// validated by a command (and its subcommands, for persistent groups).
+func addValidater(cmd *cobra.Command, data interface{}, persistent bool) {
+	validater, ok := data.(flags.Validater)
+	if !ok || validater == nil {
+		return
+	}
+
+	validaters.Lock()
+	defer validaters.Unlock()
+
+	if persistent {
+		validaters.persistent[cmd] = append(validaters.persistent[cmd], validater)
+	} else {
+		validaters.local[cmd] = append(validaters.local[cmd], validater)
+	}
+}
+
+// validateStructs makes the commands of a tree validate their structs implementing flags.Validater,
+// and the persistent groups of their parents, once their options and positionals are parsed and
+// resolved, before their pre-runners.
+func validateStructs(cmd *cobra.Command) {
+	validaters.Lock()
+	defer validaters.Unlock()
+
+	bindValidaters(cmd, nil)
+}
+
+// bindValidaters binds the validaters of a command and of its subcommands, to which
+// the persistent ones are passed. They are not kept once bound to their commands.
+func bindValidaters(cmd *cobra.Command, inherited []flags.Validater) {
+	inherited = append(inherited[:len(inherited):len(inherited)], validaters.persistent[cmd]...)
+	structs := append(validaters.local[cmd], inherited...)
+
+	delete(validaters.local, cmd)
+	delete(validaters.persistent, cmd)
+
+	for _, subc := range cmd.Commands() {
+		bindValidaters(subc, inherited)
+	}
+
+	if len(structs) == 0 {
+		return
+	}
+
+	preRun(cmd, func(_ *cobra.Command, _ []string) error {
+		return validate(structs)
+	})
+}
+
+// validate calls the Validate method of structs, in the order
+// they have been declared, and returns the first error, if any.
+func validate(structs []flags.Validater) error {
+	for _, validater := range structs {
+		if err := validater.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+// Package man (github.com/reeflective/flags/gen/man) renders the commands, option groups
+// and positional arguments scanned from a struct into troff man pages, one per command.
+// Unlike generators working on cobra commands, it keeps the structure of option groups,
+// their namespaces, the requirements of positional arguments and choices of values.
+package man
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/pages"
+)
+
+// Header holds the information shared by the headers of all pages.
+type Header struct {
+	Section string    // Section of the manual, "1" by default
+	Date    time.Time // Date of the pages, the current date by default
+	Source  string    // Source of the program, eg. its name and version
+	Manual  string    // Title of the manual, eg. "MyApp Manual"
+}
+
+// Generate writes the man pages of all the (non-hidden) commands found in data to a
+// directory, as `<name>-<command path>.<section>` files (`<name>.<section>` for the root
+// one). The name is the one of the program, and the header can be nil. The options are
+// the same parsing options as those given to the flags.Generate() call.
+func Generate(data interface{}, name, dir string, header *Header, opts ...flags.OptFunc) error {
+	cmdPages, err := pages.Scan(data, opts)
+	if err != nil {
+		return err
+	}
+
+	header = defaultHeader(header)
+
+	for _, page := range cmdPages {
+		var buf bytes.Buffer
+
+		writePage(&buf, page, name, header)
+
+		path := filepath.Join(dir, pages.Name(name, page.Command)+"."+header.Section)
+		if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Write writes the man page of a single command, designated by its path
+// (eg. "remote", "add", or none for the root command), to a writer.
+func Write(writer io.Writer, data interface{}, name string, path []string, header *Header, opts ...flags.OptFunc) error {
+	cmdPages, err := pages.Scan(data, opts)
+	if err != nil {
+		return err
+	}
+
+	if page := pages.Find(cmdPages, path); page != nil {
+		writePage(writer, page, name, defaultHeader(header))
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: no command %q", flags.ErrParse, strings.Join(path, " "))
+}
+
+// writePage renders the man page of a command.
+func writePage(writer io.Writer, page *pages.Page, name string, header *Header) {
+	title := strings.ToUpper(pages.Name(name, page.Command))
+	command := strings.Join(append([]string{name}, page.Command.Path...), " ")
+
+	fmt.Fprintf(writer, ".TH %q %q %q %q %q\n", title, header.Section,
+		header.Date.Format("Jan 2006"), header.Source, header.Manual)
+
+	fmt.Fprintf(writer, ".SH NAME\n%s", escape(pages.Name(name, page.Command)))
+	if page.Command.Description != "" {
+		fmt.Fprintf(writer, " \\- %s", escape(page.Command.Description))
+	}
+
+	fmt.Fprintf(writer, "\n.SH SYNOPSIS\n.B %s\n%s\n", escape(command), escape(pages.Synopsis(page)))
+
+	if desc := page.Command.LongDescription; desc != "" || page.Command.Description != "" {
+		if desc == "" {
+			desc = page.Command.Description
+		}
+
+		fmt.Fprintf(writer, ".SH DESCRIPTION\n%s\n", paragraph(desc))
+	}
+
+	if len(page.Args) > 0 {
+		io.WriteString(writer, ".SH ARGUMENTS\n")
+
+		for _, arg := range page.Args {
+			fmt.Fprintf(writer, ".TP\n\\fB%s\\fR\n%s\n", escape(arg.Name), paragraph(argUsage(arg)))
+		}
+	}
+
+	writeOptions(writer, page.Options)
+
+	if len(page.Subcommands) > 0 {
+		io.WriteString(writer, ".SH COMMANDS\n")
+
+		for _, subc := range page.Subcommands {
+			fmt.Fprintf(writer, ".TP\n\\fB%s\\fR(%s)\n%s\n", escape(pages.Name(name, subc)), header.Section,
+				paragraph(subc.Description))
+		}
+	}
+
+	if parent := page.Command.Parent; parent != nil {
+		fmt.Fprintf(writer, ".SH SEE ALSO\n\\fB%s\\fR(%s)\n", escape(pages.Name(name, parent)), header.Section)
+	}
+}
+
+// writeOptions renders the options of a command, under a subsection for each of their groups.
+func writeOptions(writer io.Writer, options []pages.Option) {
+	if len(options) == 0 {
+		return
+	}
+
+	io.WriteString(writer, ".SH OPTIONS\n")
+
+	var group *flags.Group
+
+	for _, opt := range options {
+		if opt.Group != group && opt.Group != nil {
+			fmt.Fprintf(writer, ".SS %q\n", opt.Group.Name+" options")
+		}
+
+		group = opt.Group
+
+		var names []string
+		if opt.Flag.Short != "" {
+			names = append(names, `\fB\-`+escape(opt.Flag.Short)+`\fR`)
+		}
+
+		if opt.Flag.Name != "" && !opt.Flag.ShortOnly {
+			names = append(names, `\fB\-\-`+escape(opt.Flag.Name)+`\fR`)
+		}
+
+		usage := strings.Join(names, ", ")
+		if boolFlag, isBool := opt.Flag.Value.(flags.BoolFlag); !isBool || !boolFlag.IsBoolFlag() {
+			usage += `=\fI` + escape(opt.Flag.Value.Type()) + `\fR`
+		}
+
+		fmt.Fprintf(writer, ".TP\n%s\n%s\n", usage, paragraph(flagUsage(opt.Flag)))
+	}
+}
+
+// argUsage returns the description of a positional argument, with its requirements and choices.
+func argUsage(arg *flags.Positional) string {
+	details := []string{arg.Usage}
+
+	if requirement := pages.Requirement(arg); requirement != "" {
+		details = append(details, requirement)
+	}
+
+	if len(arg.Choices) > 0 {
+		details = append(details, "Choices: "+strings.Join(arg.Choices, ", ")+".")
+	}
+
+	return strings.TrimSpace(strings.Join(details, "\n"))
+}
+
+// flagUsage returns the description of an option, with its default value, choices and env variable.
+func flagUsage(flag *flags.Flag) string {
+	details := []string{flag.Usage}
+
+	if flag.Required {
+		details = append(details, "Required.")
+	}
+
+	if flag.Deprecated {
+		details = append(details, "Deprecated.")
+	}
+
+	if len(flag.DefValue) > 0 {
+		details = append(details, "Default: "+strings.Join(flag.DefValue, ", ")+".")
+	}
+
+	if len(flag.Choices) > 0 {
+		details = append(details, "Choices: "+strings.Join(flag.Choices, ", ")+".")
+	}
+
+	if flag.EnvName != "" {
+		details = append(details, "Environment: $"+flag.EnvName+".")
+	}
+
+	return strings.TrimSpace(strings.Join(details, "\n"))
+}
+
+// defaultHeader returns a copy of the header, with default values where needed.
+func defaultHeader(header *Header) *Header {
+	filled := Header{}
+	if header != nil {
+		filled = *header
+	}
+
+	if filled.Section == "" {
+		filled.Section = "1"
+	}
+
+	if filled.Date.IsZero() {
+		filled.Date = time.Now()
+	}
+
+	return &filled
+}
+
+// paragraph escapes a text, and makes each of its lines a new line in the page.
+func paragraph(text string) string {
+	lines := strings.Split(escape(text), "\n")
+
+	return strings.Join(lines, "\n.br\n")
+}
+
+// escape escapes the characters interpreted by troff.
+func escape(text string) string {
+	text = strings.ReplaceAll(text, `\`, `\e`)
+	text = strings.ReplaceAll(text, "-", `\-`)
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			lines[i] = `\&` + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
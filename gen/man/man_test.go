@@ -0,0 +1,60 @@
+package man
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type remoteCommand struct {
+	Server struct {
+		Host string `long:"host" description:"server host"`
+	} `group:"server" namespace:"server" namespace-delimiter:"." persistent:"yes"`
+
+	Add struct {
+		Args struct {
+			Name  string   `description:"name of the remote" required:"1"`
+			Proto string   `choice:"ssh https"`
+			URLs  []string `description:"remote urls"`
+		} `positional-args:"yes"`
+		Mode string `short:"m" long:"mode" choice:"fetch" choice:"push" default:"fetch"`
+	} `command:"add" description:"add a remote" long-description:"Add a remote.\n.Lines are escaped."`
+}
+
+// TestGenerate checks that pages are written for all commands, with their
+// groups, namespaced options, positional requirements and choices.
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	header := &Header{Date: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), Manual: "App Manual"}
+
+	err := Generate(&remoteCommand{}, "app", dir, header)
+	require.NoError(t, err)
+
+	root, err := os.ReadFile(filepath.Join(dir, "app.1"))
+	require.NoError(t, err)
+
+	add, err := os.ReadFile(filepath.Join(dir, "app-add.1"))
+	require.NoError(t, err)
+
+	test := assert.New(t)
+	test.Contains(string(root), `.TH "APP" "1" "Apr 2023" "" "App Manual"`)
+	test.Contains(string(root), ".SS \"server options\"\n.TP\n\\fB\\-\\-server.host\\fR=\\fIstring\\fR\nserver host\n")
+	test.Contains(string(root), ".SH COMMANDS\n.TP\n\\fBapp\\-add\\fR(1)\nadd a remote\n")
+
+	page := string(add)
+	test.Contains(page, ".B app add\n[OPTIONS] NAME [PROTO] [URLS...]\n")
+	test.Contains(page, "Add a remote.\n.br\n\\&.Lines are escaped.")
+	test.Contains(page, "\\fBName\\fR\nname of the remote\n.br\nRequired.\n")
+	test.Contains(page, "Choices: ssh, https.")
+	test.Contains(page, "\\fB\\-m\\fR, \\fB\\-\\-mode\\fR=\\fIstring\\fR\n")
+	test.Contains(page, "Default: fetch.\n.br\nChoices: fetch, push.")
+	test.Contains(page, "\\-\\-server.host", "Persistent options should be inherited")
+	test.True(strings.HasSuffix(page, ".SH SEE ALSO\n\\fBapp\\fR(1)\n"))
+}
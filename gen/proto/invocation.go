@@ -0,0 +1,145 @@
+package proto
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/reeflective/flags"
+	genflags "github.com/reeflective/flags/gen/flags"
+	"github.com/reeflective/flags/internal/pages"
+)
+
+// ErrMessage indicates that a message cannot be turned into an invocation of a command.
+var ErrMessage = errors.New("invalid message")
+
+// Invocation returns the invocation of the command with a path (none for the root command),
+// from a message of its type: either one generated from the definitions written by Write (eg.
+// by protoc), or any struct with the same fields, named after them in camel case (eg. DryRun).
+// The invocation is then replayed by a tree generated from data (see genflags.Invocation).
+//
+// As with proto3 scalar fields, options whose fields have their zero value are not set,
+// and keep their default values. Positionals are given up to the last one not empty.
+func Invocation(data interface{}, path []string, message interface{}, opts ...flags.OptFunc) (genflags.Invocation, error) {
+	invocation := genflags.Invocation{Command: path}
+
+	val := reflect.ValueOf(message)
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return invocation, fmt.Errorf("%w: %T is not a struct", ErrMessage, message)
+	}
+
+	cmdPages, err := pages.Scan(data, opts)
+	if err != nil {
+		return invocation, err
+	}
+
+	page := pages.Find(cmdPages, path)
+	if page == nil {
+		return invocation, fmt.Errorf("%w: no command %q", ErrMessage, strings.Join(path, " "))
+	}
+
+	optNames, argNames, err := fieldNames(page)
+	if err != nil {
+		return invocation, err
+	}
+
+	for i, opt := range page.Options {
+		field, found := messageField(val, optNames[i])
+		if !found || field.IsZero() {
+			continue
+		}
+
+		if invocation.Options == nil {
+			invocation.Options = make(map[string][]string)
+		}
+
+		invocation.Options[opt.Flag.Name] = fieldWords(field)
+	}
+
+	last := 0
+
+	for i, arg := range page.Args {
+		field, found := messageField(val, argNames[i])
+		if !found {
+			return invocation, fmt.Errorf("%w: no field for the positional %s", ErrMessage, arg.Name)
+		}
+
+		invocation.Args = append(invocation.Args, fieldWords(field)...)
+
+		if !field.IsZero() {
+			last = len(invocation.Args)
+		}
+	}
+
+	invocation.Args = invocation.Args[:last]
+
+	return invocation, nil
+}
+
+// messageField returns the field of a message for a field name of its definition:
+// either tagged with it (as generated by protoc), or named after it in camel case.
+func messageField(message reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < message.NumField(); i++ {
+		field := message.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if field.Name == goName(name) || strings.Contains(","+field.Tag.Get("protobuf")+",", ",name="+name+",") {
+			val := message.Field(i)
+			for val.Kind() == reflect.Ptr && !val.IsNil() {
+				val = val.Elem()
+			}
+
+			return val, true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// goName returns the name of a field in camel case, as protoc does (eg. "DryRun").
+func goName(name string) string {
+	var camel strings.Builder
+
+	for _, part := range strings.Split(name, "_") {
+		if part != "" {
+			camel.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+
+	return camel.String()
+}
+
+// fieldWords returns the words of the value of a message field, as given on the
+// command-line: one for each element of lists, and one for each entry of maps.
+func fieldWords(field reflect.Value) []string {
+	switch field.Kind() {
+	case reflect.Slice, reflect.Array:
+		words := make([]string, 0, field.Len())
+		for i := 0; i < field.Len(); i++ {
+			words = append(words, fmt.Sprint(field.Index(i).Interface()))
+		}
+
+		return words
+	case reflect.Map:
+		words := make([]string, 0, field.Len())
+		for iter := field.MapRange(); iter.Next(); {
+			words = append(words, fmt.Sprintf("%v:%v", iter.Key(), iter.Value()))
+		}
+
+		sort.Strings(words)
+
+		return words
+	case reflect.Ptr, reflect.Invalid:
+		return nil
+	default:
+		return []string{fmt.Sprint(field.Interface())}
+	}
+}
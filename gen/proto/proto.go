@@ -0,0 +1,258 @@
+// Package proto (github.com/reeflective/flags/gen/proto) renders the commands scanned from
+// a struct into protobuf (proto3) message definitions, one message per command, with a field
+// for each of its options (including those inherited from the persistent groups of its parents)
+// and positional arguments. Messages of these types (eg. received by a server from its clients)
+// are then turned back into invocations of their commands with Invocation.
+//
+// Fields are numbered in declaration order (options first, then positionals): as for any
+// schema, adding or reordering options and positionals changes the numbers of the next ones.
+package proto
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/pages"
+)
+
+// Extension is the extension of the file written by Generate.
+const Extension = ".proto"
+
+// Generate writes the protobuf definitions of the messages of all the (non-hidden)
+// commands found in data to a file, in a protobuf package. The options are the same
+// parsing options as those given to the flags.Generate() call.
+func Generate(data interface{}, pkg, path string, opts ...flags.OptFunc) error {
+	var buf bytes.Buffer
+
+	if err := Write(&buf, data, pkg, opts...); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// Write writes the protobuf definitions of the messages of all the (non-hidden)
+// commands found in data to a writer, in a protobuf package (omitted if empty).
+func Write(writer io.Writer, data interface{}, pkg string, opts ...flags.OptFunc) error {
+	cmdPages, err := pages.Scan(data, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(writer, `syntax = "proto3";`)
+
+	if pkg != "" {
+		fmt.Fprintf(writer, "\npackage %s;\n", pkg)
+	}
+
+	for _, page := range cmdPages {
+		if _, _, err := fieldNames(page); err != nil {
+			return err
+		}
+	}
+
+	for _, page := range cmdPages {
+		fmt.Fprintln(writer)
+		writeMessage(writer, page)
+	}
+
+	return nil
+}
+
+// MessageName returns the name of the message of a command, given its path (eg.
+// "RemoteAddCommand" for the "remote add" command, and "RootCommand" for the root one).
+func MessageName(path []string) string {
+	if len(path) == 0 {
+		return "RootCommand"
+	}
+
+	var name strings.Builder
+
+	for _, word := range path {
+		for _, part := range nameParts(word) {
+			name.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+
+	return name.String() + "Command"
+}
+
+// writeMessage renders the message of a command.
+func writeMessage(writer io.Writer, page *pages.Page) {
+	writeComment(writer, "", page.Command.Description)
+	fmt.Fprintf(writer, "message %s {\n", MessageName(page.Command.Path))
+
+	optNames, argNames, _ := fieldNames(page)
+	number := 0
+
+	for i, opt := range page.Options {
+		number++
+		writeComment(writer, "  ", opt.Flag.Usage)
+		fmt.Fprintf(writer, "  %s %s = %d;\n", fieldType(valueType(opt.Flag.Value)), optNames[i], number)
+	}
+
+	for i, arg := range page.Args {
+		number++
+		writeComment(writer, "  ", arg.Usage)
+		fmt.Fprintf(writer, "  %s %s = %d;\n", fieldType(arg.Value.Type()), argNames[i], number)
+	}
+
+	fmt.Fprintln(writer, "}")
+}
+
+// writeComment renders a description as a comment, if not empty.
+func writeComment(writer io.Writer, indent, text string) {
+	if text == "" {
+		return
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		fmt.Fprintln(writer, strings.TrimRight(indent+"// "+line, " "))
+	}
+}
+
+// FieldName returns the name of the field of an option or of a positional in the
+// message of its command, in snake case (eg. "dry_run" for the --dry-run option).
+func FieldName(name string) string {
+	return strings.ToLower(strings.Join(nameParts(name), "_"))
+}
+
+// fieldNames returns the names of the fields of the options and positionals of a command in its
+// message. Positionals named like an option (or another positional) have their name suffixed with
+// "_arg" (eg. "target_arg"), while options having the same field name (eg. --dry-run and --dry.run)
+// cannot have their messages generated, and return flags.ErrDuplicatedFlag.
+func fieldNames(page *pages.Page) (options, args []string, err error) {
+	used := make(map[string]string)
+
+	for _, opt := range page.Options {
+		name := FieldName(opt.Flag.Name)
+		if other, found := used[name]; found {
+			return nil, nil, fmt.Errorf("%w: options %s and %s have the same message field %s",
+				flags.ErrDuplicatedFlag, other, opt.Flag.Name, name)
+		}
+
+		used[name] = opt.Flag.Name
+		options = append(options, name)
+	}
+
+	for _, arg := range page.Args {
+		name := FieldName(arg.Name)
+		for used[name] != "" {
+			name += "_arg"
+		}
+
+		used[name] = arg.Name
+		args = append(args, name)
+	}
+
+	return options, args, nil
+}
+
+// nameParts splits a name into its words, separated by any character
+// which is not a letter or a digit, or in camel case (eg. "serverURL").
+func nameParts(name string) []string {
+	var (
+		parts []string
+		part  []rune
+	)
+
+	runes := []rune(name)
+
+	for i, char := range runes {
+		switch {
+		case !unicode.IsLetter(char) && !unicode.IsDigit(char):
+			if len(part) > 0 {
+				parts, part = append(parts, string(part)), nil
+			}
+
+			continue
+		case unicode.IsUpper(char) && len(part) > 0:
+			previous := runes[i-1]
+			// Acronyms end before a capitalized word, but not before a plural (eg. "URLs").
+			nextLower := i+2 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsLower(runes[i+2])
+
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextLower) {
+				parts, part = append(parts, string(part)), nil
+			}
+		}
+
+		part = append(part, char)
+	}
+
+	if len(part) > 0 {
+		parts = append(parts, string(part))
+	}
+
+	return parts
+}
+
+// valueType returns the type of the variable of an option, or
+// the one of a string for values not giving access to it.
+func valueType(value flags.Value) reflect.Type {
+	if getter, isGetter := value.(flags.Getter); isGetter {
+		if typ := reflect.TypeOf(getter.Get()); typ != nil {
+			return typ
+		}
+	}
+
+	return reflect.TypeOf("")
+}
+
+// fieldType returns the protobuf type of a field for a Go type: lists are repeated fields,
+// maps of scalar types are maps, and values with a text form (durations, times, IPs, etc)
+// and those of any other type are strings, as given on the command-line.
+func fieldType(typ reflect.Type) string {
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array:
+		if elem := typ.Elem(); elem.Kind() != reflect.Slice && elem.Kind() != reflect.Map {
+			return "repeated " + scalarType(elem)
+		}
+	case reflect.Map:
+		if key := scalarType(typ.Key()); key != "double" && key != "float" {
+			return fmt.Sprintf("map<%s, %s>", key, scalarType(typ.Elem()))
+		}
+	}
+
+	return scalarType(typ)
+}
+
+// textTypes are the interfaces of types whose values are given as text.
+var textTypes = []reflect.Type{
+	reflect.TypeOf((*fmt.Stringer)(nil)).Elem(),
+	reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem(),
+}
+
+// scalarType returns the protobuf scalar type for a Go type.
+func scalarType(typ reflect.Type) string {
+	for _, textType := range textTypes {
+		if typ.Implements(textType) || reflect.PtrTo(typ).Implements(textType) {
+			return "string"
+		}
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int64:
+		return "int64"
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return "int32"
+	case reflect.Uint, reflect.Uint64:
+		return "uint64"
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return "uint32"
+	case reflect.Float32:
+		return "float"
+	case reflect.Float64:
+		return "double"
+	default:
+		return "string"
+	}
+}
@@ -0,0 +1,193 @@
+package proto
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/reeflective/flags"
+	genflags "github.com/reeflective/flags/gen/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type deployCommand struct {
+	Global struct {
+		Config string `short:"c" long:"config" description:"config file"`
+	} `group:"global" persistent:"true"`
+
+	Deploy deploySubcommand `command:"deploy" description:"deploy a service"`
+}
+
+func (d *deployCommand) Execute(args []string) error { return nil }
+
+type deploySubcommand struct {
+	DryRun  bool              `long:"dry-run" description:"only show changes"`
+	Timeout time.Duration     `long:"timeout"`
+	Tags    []string          `short:"t" long:"tag" description:"image tags"`
+	Labels  map[string]string `long:"label"`
+	Replica int               `long:"replicas"`
+	Args    struct {
+		Service string   `description:"service to deploy"`
+		Hosts   []string `description:"target hosts"`
+	} `positional-args:"yes"`
+}
+
+func (d *deploySubcommand) Execute(args []string) error { return nil }
+
+// deployMessage is a message of the deploy command, as generated by protoc.
+type deployMessage struct {
+	state   struct{}
+	Config  string            `protobuf:"bytes,1,opt,name=config,proto3"`
+	DryRun  bool              `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3"`
+	Timeout string            `protobuf:"bytes,3,opt,name=timeout,proto3"`
+	Tag     []string          `protobuf:"bytes,4,rep,name=tag,proto3"`
+	Label   map[string]string `protobuf:"bytes,5,rep,name=label,proto3"`
+	Service string
+	Hosts   []string
+}
+
+// TestWrite checks that messages are written for all commands,
+// with fields of protobuf types for their options and positionals.
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Write(&buf, &deployCommand{}, "deploy.v1"))
+
+	assert.Equal(t, `syntax = "proto3";
+
+package deploy.v1;
+
+message RootCommand {
+  // config file
+  string config = 1;
+}
+
+// deploy a service
+message DeployCommand {
+  // only show changes
+  bool dry_run = 1;
+  string timeout = 2;
+  // image tags
+  repeated string tag = 3;
+  map<string, string> label = 4;
+  int64 replicas = 5;
+  // config file
+  string config = 6;
+  // service to deploy
+  string service = 7;
+  // target hosts
+  repeated string hosts = 8;
+}
+`, buf.String())
+}
+
+// TestGenerate checks that definitions are written to files.
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "deploy"+Extension)
+	require.NoError(t, Generate(&deployCommand{}, "", path))
+
+	definitions, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(definitions), "message DeployCommand {\n")
+	assert.NotContains(t, string(definitions), "package")
+}
+
+// TestNames checks the names of messages and fields.
+func TestNames(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "RootCommand", MessageName(nil))
+	assert.Equal(t, "RemoteAddCommand", MessageName([]string{"remote", "add"}))
+	assert.Equal(t, "GetPodsCommand", MessageName([]string{"get-pods"}))
+	assert.Equal(t, "dry_run", FieldName("dry-run"))
+	assert.Equal(t, "server_url", FieldName("serverURL"))
+	assert.Equal(t, "urls", FieldName("URLs"))
+	assert.Equal(t, "http_proxy", FieldName("HTTPProxy"))
+}
+
+// copyCommand has a positional named like one of its options.
+type copyCommand struct {
+	Target string `long:"target"`
+	Args   struct {
+		Target string
+	} `positional-args:"yes"`
+}
+
+func (c *copyCommand) Execute(args []string) error { return nil }
+
+// TestFieldNameCollisions checks that positionals named like options have their field name
+// suffixed, and that options having the same field name cannot have their messages written.
+func TestFieldNameCollisions(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Write(&buf, &copyCommand{}, ""))
+	assert.Contains(t, buf.String(), "string target = 1;\n  string target_arg = 2;\n")
+
+	message := struct {
+		Target    string
+		TargetArg string
+	}{Target: "option", TargetArg: "arg"}
+
+	invocation, err := Invocation(&copyCommand{}, nil, message)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"target": {"option"}}, invocation.Options)
+	assert.Equal(t, []string{"arg"}, invocation.Args)
+
+	err = Write(&buf, &struct {
+		DryRun  bool `long:"dry-run"`
+		DryRun2 bool `long:"dry_run"`
+	}{}, "")
+	require.ErrorIs(t, err, flags.ErrDuplicatedFlag)
+}
+
+// TestInvocation checks that messages are replayed on their commands.
+func TestInvocation(t *testing.T) {
+	t.Parallel()
+
+	message := &deployMessage{
+		Config:  "app.yml",
+		DryRun:  true,
+		Timeout: "1m",
+		Tag:     []string{"a", "b"},
+		Label:   map[string]string{"env": "prod"},
+		Hosts:   []string{"one", "two"},
+	}
+
+	invocation, err := Invocation(&deployCommand{}, []string{"deploy"}, message)
+	require.NoError(t, err)
+	assert.Equal(t, genflags.Invocation{
+		Command: []string{"deploy"},
+		Options: map[string][]string{
+			"config":  {"app.yml"},
+			"dry-run": {"true"},
+			"timeout": {"1m"},
+			"tag":     {"a", "b"},
+			"label":   {"env:prod"},
+		},
+		Args: []string{"", "one", "two"},
+	}, invocation)
+
+	data := &deployCommand{}
+	require.NoError(t, invocation.Replay(genflags.Generate(data)))
+	assert.Equal(t, "app.yml", data.Global.Config)
+	assert.True(t, data.Deploy.DryRun)
+	assert.Equal(t, time.Minute, data.Deploy.Timeout)
+	assert.Equal(t, []string{"a", "b"}, data.Deploy.Tags)
+	assert.Equal(t, map[string]string{"env": "prod"}, data.Deploy.Labels)
+	assert.Equal(t, []string{"one", "two"}, data.Deploy.Args.Hosts)
+
+	_, err = Invocation(&deployCommand{}, []string{"unknown"}, message)
+	assert.ErrorIs(t, err, ErrMessage)
+
+	_, err = Invocation(&deployCommand{}, []string{"deploy"}, "message")
+	assert.ErrorIs(t, err, ErrMessage)
+}
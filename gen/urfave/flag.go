@@ -0,0 +1,130 @@
+package urfave
+
+import (
+	"strconv"
+
+	"github.com/reeflective/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// optionFlag is the urfave/cli flag of an option, setting the value of its struct field.
+// Boolean options (and counters) are given without value, like with the cobra generator.
+type optionFlag struct {
+	*cli.GenericFlag
+	isBool bool
+	env    []string
+}
+
+var _ cli.DocGenerationFlag = (*optionFlag)(nil)
+
+// optionFlags returns the flags of an option: the option itself, with its
+// short name, aliases and previous names, and its negation, if any.
+func optionFlags(flag *flags.Flag, category string) []cli.Flag {
+	isBool := false
+	if boolFlag, casted := flag.Value.(flags.BoolFlag); casted {
+		isBool = boolFlag.IsBoolFlag()
+	}
+
+	name, aliases := flag.Name, append([]string{flag.Short}, flag.Aliases...)
+	if flag.ShortOnly {
+		name, aliases = flag.Short, flag.Aliases
+	}
+
+	option := &optionFlag{
+		GenericFlag: &cli.GenericFlag{
+			Name:     name,
+			Aliases:  nonEmpty(append(aliases, flag.RenamedFrom...)),
+			Usage:    flag.Usage,
+			Category: category,
+			Required: flag.Required,
+			Hidden:   flag.Hidden,
+			Value:    &sharedValue{Value: flag.Value, isBool: isBool},
+		},
+		isBool: isBool,
+	}
+
+	// Values of the environment are set before running commands (see
+	// command.setEnv), but variables are shown in help usages, like defaults.
+	if flag.Env {
+		option.env = []string{flag.EnvName}
+	}
+
+	if flag.Negation == "" {
+		return []cli.Flag{option}
+	}
+
+	negation := &optionFlag{
+		GenericFlag: &cli.GenericFlag{
+			Name:     flag.Negation,
+			Usage:    flag.Usage,
+			Category: category,
+			Hidden:   true,
+			Value:    &negatedValue{flag.Value},
+		},
+		isBool: true,
+	}
+
+	return []cli.Flag{option, negation}
+}
+
+// TakesValue returns false for boolean options, which are given without value.
+func (f *optionFlag) TakesValue() bool { return !f.isBool }
+
+// GetEnvVars returns the environment variable of the option, if any.
+func (f *optionFlag) GetEnvVars() []string { return f.env }
+
+// String returns the help usage of the flag.
+func (f *optionFlag) String() string { return cli.FlagStringer(f) }
+
+// sharedMarker is the value copied by urfave/cli from the
+// names of an option given on the command-line to the others.
+const sharedMarker = "\x00shared"
+
+// sharedValue is the value of all the names of an option. Once the command-line parsed,
+// urfave/cli sets the names not given with the value of the others, which would set the
+// option twice (eg. appending again to a slice): this value ignores these copies.
+type sharedValue struct {
+	flags.Value
+	isBool bool
+}
+
+func (v *sharedValue) Set(value string) error {
+	if value == sharedMarker {
+		return nil
+	}
+
+	return v.Value.Set(value)
+}
+
+func (v *sharedValue) Serialize() string { return sharedMarker }
+
+func (v *sharedValue) IsBoolFlag() bool { return v.isBool }
+
+// negatedValue is the value of the negative flag of a boolean option.
+type negatedValue struct {
+	flags.Value
+}
+
+func (v *negatedValue) Set(value string) error {
+	negated, err := strconv.ParseBool(value)
+	if err != nil {
+		return err
+	}
+
+	return v.Value.Set(strconv.FormatBool(!negated))
+}
+
+func (v *negatedValue) IsBoolFlag() bool { return true }
+
+// nonEmpty returns the non-empty names of a list.
+func nonEmpty(names []string) []string {
+	var kept []string
+
+	for _, name := range names {
+		if name != "" {
+			kept = append(kept, name)
+		}
+	}
+
+	return kept
+}
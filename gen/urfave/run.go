@@ -0,0 +1,118 @@
+package urfave
+
+import (
+	"github.com/reeflective/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// setRuns binds the pre/run/post implementations of a command struct to its command.
+// Commands without implementation and with subcommands show their help, like others.
+func (c *command) setRuns(data interface{}) {
+	if runner, ok := data.(flags.PreRunnerE); ok && runner != nil {
+		c.cmd.Before = func(ctx *cli.Context) error { return runner.PreRunE(ctx.Args().Slice()) }
+	} else if runner, ok := data.(flags.PreRunner); ok && runner != nil {
+		c.cmd.Before = func(ctx *cli.Context) error {
+			runner.PreRun(ctx.Args().Slice())
+
+			return nil
+		}
+	}
+
+	// Options are set from the environment before any pre-runner.
+	before := c.cmd.Before
+
+	c.cmd.Before = func(ctx *cli.Context) error {
+		if err := c.setEnv(ctx); err != nil {
+			return err
+		}
+
+		if before != nil {
+			return before(ctx)
+		}
+
+		return nil
+	}
+
+	switch runner := data.(type) {
+	case flags.CommanderContext:
+		c.cmd.Action = c.action(func(ctx *cli.Context, args []string) error {
+			return runner.ExecuteContext(ctx.Context, args)
+		})
+	case flags.Commander:
+		c.cmd.Action = c.action(func(_ *cli.Context, args []string) error {
+			return runner.Execute(args)
+		})
+	case flags.RunnerE:
+		c.cmd.Action = c.action(func(_ *cli.Context, args []string) error {
+			return runner.RunE(args)
+		})
+	case flags.Runner:
+		c.cmd.Action = c.action(func(_ *cli.Context, args []string) error {
+			runner.Run(args)
+
+			return nil
+		})
+	default:
+		// Commands with positionals still check them, even with nothing to run.
+		if c.args != nil {
+			c.cmd.Action = c.action(func(*cli.Context, []string) error { return nil })
+		}
+	}
+
+	if runner, ok := data.(flags.PostRunnerE); ok && runner != nil {
+		c.cmd.After = func(ctx *cli.Context) error { return runner.PostRunE(ctx.Args().Slice()) }
+	} else if runner, ok := data.(flags.PostRunner); ok && runner != nil {
+		c.cmd.After = func(ctx *cli.Context) error {
+			runner.PostRun(ctx.Args().Slice())
+
+			return nil
+		}
+	}
+}
+
+// action returns the action of a command running an implementation with the arguments
+// not parsed into its positionals, once the latter are parsed and the structs of the
+// command checking their values together have done so.
+func (c *command) action(run func(ctx *cli.Context, args []string) error) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		args := ctx.Args().Slice()
+
+		if c.args != nil {
+			retargs, err := c.args.Parse(args, -1)
+			if err != nil {
+				return err
+			}
+
+			args = retargs
+		}
+
+		for _, validater := range c.validaters {
+			if err := validater.Validate(); err != nil {
+				return err
+			}
+		}
+
+		return run(ctx, args)
+	}
+}
+
+// setEnv sets the options of the command not given on its command-line from their
+// environment variables, if set, as well as the options only set from the environment.
+func (c *command) setEnv(ctx *cli.Context) error {
+	for _, option := range c.env {
+		name := option.Name
+		if option.ShortOnly {
+			name = option.Short
+		}
+
+		if !option.EnvOnly && (ctx.IsSet(name) || (option.Negation != "" && ctx.IsSet(option.Negation))) {
+			continue
+		}
+
+		if _, err := option.SetEnv(c.opts...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
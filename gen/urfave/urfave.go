@@ -0,0 +1,189 @@
+// Package urfave (github.com/reeflective/flags/gen/urfave) generates urfave/cli (v2)
+// applications and commands from the same structs as those of the cobra generator: the
+// commands, options and positionals scanned from them keep the semantics of their tags
+// (names, aliases, environment variables, requirements, choices, etc), and commands run
+// the implementations of their structs (flags.Commander and the other runner interfaces).
+//
+// As urfave/cli has no persistent options, the options of persistent groups are only those
+// of the command declaring them (thus given before the names of its subcommands), and help
+// usages, completions and errors are those of urfave/cli.
+package urfave
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/reeflective/flags"
+	"github.com/reeflective/flags/internal/positional"
+	"github.com/reeflective/flags/internal/scan"
+	"github.com/reeflective/flags/internal/tag"
+	"github.com/urfave/cli/v2"
+)
+
+// Generate returns an urfave/cli application for the commands, options and positionals
+// found in data, which is a pointer to the struct of the root command. The application
+// is named after the program, and the options are the same parsing options as those
+// given to the flags.Generate() call.
+func Generate(data interface{}, opts ...flags.OptFunc) (*cli.App, error) {
+	root, err := generate(data, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cli.App{
+		Name:        filepath.Base(os.Args[0]),
+		Usage:       root.Usage,
+		Description: root.Description,
+		ArgsUsage:   root.ArgsUsage,
+		Flags:       root.Flags,
+		Commands:    root.Subcommands,
+		Before:      root.Before,
+		Action:      root.Action,
+		After:       root.After,
+	}, nil
+}
+
+// Commands returns the urfave/cli commands of the subcommands found in data,
+// for applications adding them to their own (eg. to cli.App.Commands).
+func Commands(data interface{}, opts ...flags.OptFunc) ([]*cli.Command, error) {
+	root, err := generate(data, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return root.Subcommands, nil
+}
+
+// generate returns the command of the root struct, with all its subcommands.
+func generate(data interface{}, opts []flags.OptFunc) (*cli.Command, error) {
+	model, err := flags.Scan(data, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := newCommand(model, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return root.cmd, nil
+}
+
+// command is a generated command, with the positionals, the options set from
+// the environment and the structs checking their values together of its struct.
+type command struct {
+	cmd        *cli.Command
+	args       *positional.Args
+	env        []*flags.Flag
+	validaters []flags.Validater
+	opts       []flags.OptFunc
+}
+
+// newCommand returns the urfave/cli command of a command model, with its subcommands.
+func newCommand(model *flags.Command, opts []flags.OptFunc) (*command, error) {
+	generated := &command{
+		cmd: &cli.Command{
+			Name:        model.Name,
+			Aliases:     model.Aliases,
+			Usage:       model.Description,
+			Description: model.LongDescription,
+			Hidden:      model.Hidden,
+		},
+		opts: opts,
+	}
+
+	if err := generated.positionals(model.Data, opts); err != nil {
+		return nil, err
+	}
+
+	if validater, ok := model.Data.(flags.Validater); ok && validater != nil {
+		generated.validaters = append(generated.validaters, validater)
+	}
+
+	for _, grp := range model.Groups {
+		if validater, ok := grp.Data.(flags.Validater); ok && validater != nil {
+			generated.validaters = append(generated.validaters, validater)
+		}
+	}
+
+	for _, opt := range model.Options {
+		var category string
+		if opt.Group != nil {
+			category = opt.Group.Name
+		}
+
+		generated.option(opt.Flag, category)
+	}
+
+	generated.setRuns(model.Data)
+
+	for _, sub := range model.Subcommands {
+		subcommand, err := newCommand(sub, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		generated.cmd.Subcommands = append(generated.cmd.Subcommands, subcommand.cmd)
+	}
+
+	return generated, nil
+}
+
+// option adds the flags of an option to the command, unless it is only set from
+// the environment: options tagged with `env` are set from it before running.
+func (c *command) option(flag *flags.Flag, category string) {
+	if flag.Env {
+		c.env = append(c.env, flag)
+	}
+
+	if !flag.EnvOnly {
+		c.cmd.Flags = append(c.cmd.Flags, optionFlags(flag, category)...)
+	}
+}
+
+// positionals scans the positional arguments of a command struct, if it has some:
+// either the fields of its positional-args struct, or its fields tagged as such.
+func (c *command) positionals(data interface{}, opts []flags.OptFunc) error {
+	val := reflect.ValueOf(data).Elem()
+	options := flags.ScanOptions(opts...)
+
+	for i := 0; i < val.NumField() && c.args == nil; i++ {
+		mtag, skip, err := options.FieldTag(val.Type().Field(i))
+		if err != nil {
+			return fmt.Errorf("%w: %s", tag.ErrTag, err.Error())
+		}
+
+		if _, isArgs := mtag.Get("positional-args"); !isArgs || skip || !flags.InMode(mtag, opts...) {
+			continue
+		}
+
+		if c.args, err = positional.ScanArgs(val.Field(i), mtag, flags.ScanOptFuncs(opts...)...); err != nil {
+			return fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
+		}
+
+		if validater, ok := val.Field(i).Addr().Interface().(flags.Validater); ok && validater != nil {
+			c.validaters = append(c.validaters, validater)
+		}
+	}
+
+	if c.args == nil {
+		args, err := positional.ScanFields(val, flags.ScanOptFuncs(opts...)...)
+		if err != nil || args == nil {
+			return err
+		}
+
+		c.args = args
+	}
+
+	var usage []string
+	for _, arg := range c.args.Positionals() {
+		usage = append(usage, "<"+arg.Name+">")
+	}
+
+	c.cmd.ArgsUsage = strings.Join(usage, " ")
+
+	return nil
+}
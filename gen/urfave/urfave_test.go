@@ -0,0 +1,133 @@
+package urfave
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/reeflective/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errPort = errors.New("invalid port")
+
+type rootCommand struct {
+	Verbose bool   `short:"v" long:"verbose" negatable:""`
+	Profile string `long:"profile" env:"APP_PROFILE" description:"profile to use"`
+
+	Deploy deployCommand `command:"deploy" alias:"d" description:"deploy a service"`
+}
+
+type deployCommand struct {
+	Server struct {
+		Host string `long:"host" required:"true"`
+		Port int    `long:"port"`
+	} `group:"server" namespace:"server" namespace-delimiter:"-"`
+	Tags []string `short:"t" long:"tag"`
+	Mode string   `long:"mode" choice:"fast" choice:"safe"`
+
+	Args struct {
+		Service string `description:"service to deploy" required:"yes"`
+	} `positional-args:"yes"`
+
+	ctx  context.Context
+	args []string
+}
+
+func (d *deployCommand) Validate() error {
+	if d.Server.Port <= 0 {
+		return errPort
+	}
+
+	return nil
+}
+
+func (d *deployCommand) ExecuteContext(ctx context.Context, args []string) error {
+	d.ctx, d.args = ctx, args
+
+	return nil
+}
+
+// newRoot returns a root command, with the default values of its options.
+func newRoot() *rootCommand {
+	root := &rootCommand{}
+	root.Deploy.Server.Port = 80
+
+	return root
+}
+
+// TestGenerate checks that applications run commands with the values of their
+// options and positionals, as given on the command-line or in the environment.
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	data := newRoot()
+	app, err := Generate(data, flags.WithEnviron([]string{"APP_PROFILE=prod"}))
+	require.NoError(t, err)
+
+	app.Writer = &bytes.Buffer{}
+
+	require.NoError(t, app.Run([]string{"app", "-v", "d", "--server-host", "example.com",
+		"-t", "a", "-t", "b", "api", "extra"}))
+
+	assert.True(t, data.Verbose)
+	assert.Equal(t, "prod", data.Profile)
+	assert.Equal(t, "example.com", data.Deploy.Server.Host)
+	assert.Equal(t, 80, data.Deploy.Server.Port)
+	assert.Equal(t, []string{"a", "b"}, data.Deploy.Tags)
+	assert.Equal(t, "api", data.Deploy.Args.Service)
+	assert.Equal(t, []string{"extra"}, data.Deploy.args)
+	assert.NotNil(t, data.Deploy.ctx)
+
+	require.NoError(t, app.Run([]string{"app", "--no-verbose"}))
+	assert.False(t, data.Verbose)
+}
+
+// TestGenerateErrors checks that requirements, choices and validations are enforced.
+func TestGenerateErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][]string{
+		{"app", "deploy", "api"},
+		{"app", "deploy", "--server-host", "a"},
+		{"app", "deploy", "--server-host", "a", "--mode", "slow", "api"},
+		{"app", "deploy", "--server-host", "a", "--server-port", "0", "api"},
+	} {
+		app, err := Generate(newRoot())
+		require.NoError(t, err)
+
+		app.Writer, app.ErrWriter = &bytes.Buffer{}, &bytes.Buffer{}
+		assert.Error(t, app.Run(args), "%v", args)
+	}
+
+	_, err := Generate(rootCommand{})
+	assert.ErrorIs(t, err, flags.ErrNotPointerToStruct)
+}
+
+// TestCommands checks that commands are generated for existing applications,
+// with help usages showing the options with their environment variables.
+func TestCommands(t *testing.T) {
+	t.Parallel()
+
+	commands, err := Commands(newRoot())
+	require.NoError(t, err)
+	require.Len(t, commands, 1)
+
+	assert.Equal(t, "deploy", commands[0].Name)
+	assert.Equal(t, []string{"d"}, commands[0].Aliases)
+	assert.Equal(t, "<Service>", commands[0].ArgsUsage)
+
+	app, err := Generate(newRoot())
+	require.NoError(t, err)
+
+	out := &bytes.Buffer{}
+	app.Writer = out
+
+	require.NoError(t, app.Run([]string{"app", "--help"}))
+	assert.Contains(t, out.String(), "--profile value")
+	assert.Contains(t, out.String(), "$APP_PROFILE")
+	assert.Contains(t, out.String(), "--verbose, -v ")
+	assert.NotContains(t, out.String(), "--no-verbose")
+}
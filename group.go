@@ -0,0 +1,171 @@
+package flags
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/reeflective/flags/internal/scan"
+	"github.com/reeflective/flags/internal/tag"
+)
+
+// Provided returns the concrete value stored in an interface struct field
+// tagged as `group-provider`, so that it can be scanned for options like
+// any other group. The value is nil (with no error) when no provider has
+// been set, and an error is returned if the provider is not a pointer to
+// a struct, since options could not be bound to its fields otherwise.
+func Provided(val reflect.Value) (interface{}, error) {
+	if val.Kind() != reflect.Interface {
+		return nil, fmt.Errorf("%w: group provider must be an interface field", ErrInvalidTag)
+	}
+
+	if val.IsNil() {
+		return nil, nil
+	}
+
+	provided := val.Elem()
+	if provided.Kind() != reflect.Ptr || provided.IsNil() || provided.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: group provider (%s)", ErrNotPointerToStruct, provided.Type())
+	}
+
+	return provided.Interface(), nil
+}
+
+// groups are the factories of the groups of options registered with RegisterGroup, by name.
+var groups = struct {
+	sync.RWMutex
+	factories map[string]func() interface{}
+}{factories: map[string]func() interface{}{}}
+
+// RegisterGroup registers a group of options under a name, so that commands of other
+// packages can use it with a `use-group:"name"` tag on an interface field, without
+// having to import its package. This is meant for groups shared across an application
+// (eg. logging, output or authentication options), registered in init functions.
+// The factory must return a new pointer to the group struct each time it is called.
+// RegisterGroup panics if the factory is nil, or if the name is already registered.
+func RegisterGroup(name string, factory func() interface{}) {
+	groups.Lock()
+	defer groups.Unlock()
+
+	if factory == nil {
+		panic("flags: RegisterGroup factory is nil for group " + name)
+	}
+
+	if _, registered := groups.factories[name]; registered {
+		panic("flags: RegisterGroup called twice for group " + name)
+	}
+
+	groups.factories[name] = factory
+}
+
+// IsProvided returns true if a struct field holds a group of options provided at runtime,
+// either by the application (`group-provider`) or by a registered group (`use-group`).
+func IsProvided(mtag tag.MultiTag) bool {
+	_, isProvider := mtag.Get("group-provider")
+	_, isUsed := mtag.Get("use-group")
+
+	return isProvider || isUsed
+}
+
+// ProvidedGroup returns the group of options held by a struct field provided at runtime: the
+// value set by the application on `group-provider` fields (see Provided), or for `use-group`
+// fields, a new instance of the group registered under the tag name, which is set on the field
+// (unless already set) so that commands can access its values. All generators scanning the
+// field thus use the same instance.
+func ProvidedGroup(mtag tag.MultiTag, val reflect.Value) (interface{}, error) {
+	name, isUsed := mtag.Get("use-group")
+	if !isUsed || (val.Kind() == reflect.Interface && !val.IsNil()) {
+		return Provided(val)
+	}
+
+	if val.Kind() != reflect.Interface {
+		return nil, fmt.Errorf("%w: used group %q must be an interface field", ErrInvalidTag, name)
+	}
+
+	groups.RLock()
+	factory := groups.factories[name]
+	groups.RUnlock()
+
+	if factory == nil {
+		return nil, fmt.Errorf("%w: no group registered as %q", ErrInvalidTag, name)
+	}
+
+	group := reflect.ValueOf(factory())
+	if !group.IsValid() {
+		return nil, fmt.Errorf("%w: group %q factory returned nil", ErrNotPointerToStruct, name)
+	}
+
+	if !group.Type().AssignableTo(val.Type()) {
+		return nil, fmt.Errorf("%w: group %q (%s) cannot be used as %s", ErrInvalidTag, name, group.Type(), val.Type())
+	}
+
+	val.Set(group)
+
+	return Provided(val)
+}
+
+// GroupOptions returns the parsing options to use when scanning the options of a group,
+// given its struct tag and the options of its parent: the group `namespace` is appended
+// to the current flags prefix, and its `env-namespace` (or its upper-cased namespace if
+// none) to the current env prefix, each with their own delimiters. Namespaces are thus
+// composed across nested/parent groups.
+func GroupOptions(mtag tag.MultiTag, optFuncs ...OptFunc) []OptFunc {
+	current := ScanOptions(optFuncs...)
+	options := append([]OptFunc{}, optFuncs...)
+	prefix := current.Prefix
+
+	namespace, _ := mtag.Get("namespace")
+	if namespace != "" {
+		delim, _ := mtag.Get("namespace-delimiter")
+		prefix += namespace + delim
+		options = append(options, Prefix(prefix))
+	}
+
+	return envNamespace(mtag, current, prefix, strings.ToUpper(namespace), options)
+}
+
+// CommandOptions returns the parsing options used to scan a command struct,
+// with the prefix of its `env-namespace` tag added to the environment variables
+// of its options (and those of its subcommands), like for groups of options.
+func CommandOptions(mtag tag.MultiTag, optFuncs ...OptFunc) []OptFunc {
+	options := append([]OptFunc{}, optFuncs...)
+	current := ScanOptions(optFuncs...)
+
+	return envNamespace(mtag, current, current.Prefix, "", options)
+}
+
+// envNamespace adds the prefix of an `env-namespace` tag (or the default one), if any, to the
+// environment variables prefix, after the part of the current flags prefix not yet in it. This
+// env namespace then stands for the flags prefix of the group (or command) in its env names.
+func envNamespace(mtag tag.MultiTag, current scan.Opts, prefix, defaultNS string, options []OptFunc) []OptFunc {
+	envNamespace, isSet := mtag.Get("env-namespace")
+	if !isSet {
+		envNamespace = defaultNS
+	}
+
+	if envNamespace == "" {
+		return options
+	}
+
+	delim, isSet := mtag.Get("env-namespace-delimiter")
+	if !isSet {
+		delim = current.EnvDivider
+	}
+
+	scoped := flagToEnv(strings.TrimPrefix(current.Prefix, current.EnvScope), current.FlagDivider, current.EnvDivider)
+
+	return append(options, EnvPrefix(current.EnvPrefix+scoped+envNamespace+delim), envScope(prefix))
+}
+
+// envScope sets the part of the flags prefix accounted for in the env prefix.
+func envScope(prefix string) OptFunc {
+	return func(opt *scan.Opts) { opt.EnvScope = prefix }
+}
+
+// isGroup returns true if the struct tag marks a group of options.
+func isGroup(mtag tag.MultiTag) bool {
+	_, isGroup := mtag.Get("group")
+
+	return isGroup
+}
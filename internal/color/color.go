@@ -0,0 +1,47 @@
+// Package color decides whether generated output (help usages, completions) may be
+// colored, following the conventions of the environment of the user: NO_COLOR
+// (https://no-color.org), CLICOLOR and CLICOLOR_FORCE (https://bixense.com/clicolors),
+// and TERM for terminals without color support.
+package color
+
+// Modes overriding the detection from the environment.
+const (
+	Auto   = "auto"
+	Always = "always"
+	Never  = "never"
+)
+
+// Enabled returns true if output may be colored in a given mode, where Auto (or an
+// empty mode) decides from the environment variables returned by lookupEnv:
+//   - NO_COLOR, when non-empty, disables colors.
+//   - CLICOLOR_FORCE, when non-empty and not "0", enables them.
+//   - CLICOLOR set to "0" disables them.
+//   - TERM set to "dumb" disables them.
+//
+// Colors are enabled otherwise.
+func Enabled(mode string, lookupEnv func(key string) (string, bool)) bool {
+	switch mode {
+	case Always:
+		return true
+	case Never:
+		return false
+	}
+
+	if value, _ := lookupEnv("NO_COLOR"); value != "" {
+		return false
+	}
+
+	if value, _ := lookupEnv("CLICOLOR_FORCE"); value != "" && value != "0" {
+		return true
+	}
+
+	if value, isSet := lookupEnv("CLICOLOR"); isSet && value == "0" {
+		return false
+	}
+
+	if term, _ := lookupEnv("TERM"); term == "dumb" {
+		return false
+	}
+
+	return true
+}
@@ -1,6 +1,7 @@
 package convert
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"reflect"
@@ -20,7 +21,7 @@ const (
 // Internal errors.
 var (
 	errStringer    = errors.New("type assertion to `fmt.Stringer` failed")
-	errUnmarshaler = errors.New("type assertion to `flags.Unmarshaler` failed")
+	errUnmarshaler = errors.New("type assertion to an unmarshaler failed")
 )
 
 // ErrConvertion is used to notify that converting
@@ -169,8 +170,10 @@ func convertMarshal(val reflect.Value) (bool, string, error) {
 
 func convertUnmarshal(val string, retval reflect.Value) (bool, error) {
 	// Use any unmarshalling implementation found on the concrete type.
-	if unm, found := typeIsUnmarshaller(retval); found && unm != nil {
-		return convertWithUnmarshaler(val, retval, unm)
+	if retval.Kind() == reflect.Ptr {
+		if unmarshal := typeUnmarshaler(retval); unmarshal != nil {
+			return convertWithUnmarshaler(val, retval)
+		}
 	}
 
 	// Or recursively call ourselves with embedded types
@@ -185,26 +188,19 @@ func convertUnmarshal(val string, retval reflect.Value) (bool, error) {
 	return false, nil
 }
 
-func convertWithUnmarshaler(val string, retval reflect.Value, unm unmarshaler) (bool, error) {
-	// If we have an existing value, just use it
-	if !retval.IsNil() {
-		if err := unm.UnmarshalFlag(val); err != nil {
-			return true, fmt.Errorf("unmarshal error: %w", err)
-		}
-
-		return true, nil
+func convertWithUnmarshaler(val string, retval reflect.Value) (bool, error) {
+	// If we don't have an existing value, we need to assign a new one.
+	if retval.IsNil() {
+		retval.Set(reflect.New(retval.Type().Elem()))
 	}
 
-	// Else we need to re-assign from the new value
-	retval.Set(reflect.New(retval.Type().Elem()))
-
-	unm, found := retval.Interface().(unmarshaler)
-	if !found {
+	unmarshal := typeUnmarshaler(retval)
+	if unmarshal == nil {
 		return false, fmt.Errorf("convert marshal: %w", errUnmarshaler)
 	}
 
 	// And finally perform the custom unmarshaling
-	if err := unm.UnmarshalFlag(val); err != nil {
+	if err := unmarshal(val); err != nil {
 		return true, fmt.Errorf("unmarshal error: %w", err)
 	}
 
@@ -410,16 +406,23 @@ func convertMapStr(val reflect.Value, options tag.MultiTag) (string, error) {
 // 3) Other helpers ------------------------------------------------------------------------ //
 //
 
-func typeIsUnmarshaller(retval reflect.Value) (unmarshaler, bool) {
-	if retval.Type().NumMethod() == 0 || retval.CanInterface() {
-		return nil, false
+// typeUnmarshaler returns the function unmarshaling a string onto the value, if its type
+// implements either flags.Unmarshaler, encoding.TextUnmarshaler or encoding.BinaryUnmarshaler.
+func typeUnmarshaler(retval reflect.Value) func(string) error {
+	if retval.Type().NumMethod() == 0 || !retval.CanInterface() {
+		return nil
 	}
 
-	if unm, isImplemented := retval.Interface().(unmarshaler); isImplemented {
-		return unm, true
+	switch unm := retval.Interface().(type) {
+	case unmarshaler:
+		return unm.UnmarshalFlag
+	case encoding.TextUnmarshaler:
+		return func(val string) error { return unm.UnmarshalText([]byte(val)) }
+	case encoding.BinaryUnmarshaler:
+		return func(val string) error { return unm.UnmarshalBinary([]byte(val)) }
+	default:
+		return nil
 	}
-
-	return nil, false
 }
 
 func getBase(options tag.MultiTag, base int) (int, error) {
@@ -0,0 +1,72 @@
+package convert
+
+import "reflect"
+
+// Copy returns a copy of a value which does not share the elements of its maps, slices,
+// arrays and pointers (nor those of the exported fields of its structs) with it, so that
+// setting the value in place does not modify the copy. Pointers to the same data in the
+// value point to the same data in the copy.
+func Copy(value reflect.Value) reflect.Value {
+	return deepCopy(value, make(map[pointer]reflect.Value))
+}
+
+// pointer identifies the data pointed to, already copied.
+type pointer struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+func deepCopy(value reflect.Value, copies map[pointer]reflect.Value) reflect.Value {
+	dup := reflect.New(value.Type()).Elem()
+	dup.Set(value)
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return dup
+		}
+
+		ptr := pointer{value.Pointer(), value.Type()}
+		if done, found := copies[ptr]; found {
+			return done
+		}
+
+		dup = reflect.New(value.Type().Elem())
+		copies[ptr] = dup
+		dup.Elem().Set(deepCopy(value.Elem(), copies))
+
+	case reflect.Map:
+		if value.IsNil() {
+			return dup
+		}
+
+		dup = reflect.MakeMapWithSize(value.Type(), value.Len())
+		for iter := value.MapRange(); iter.Next(); {
+			dup.SetMapIndex(iter.Key(), deepCopy(iter.Value(), copies))
+		}
+
+	case reflect.Slice:
+		if value.IsNil() {
+			return dup
+		}
+
+		dup = reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			dup.Index(i).Set(deepCopy(value.Index(i), copies))
+		}
+
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			dup.Index(i).Set(deepCopy(value.Index(i), copies))
+		}
+
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if dup.Field(i).CanSet() {
+				dup.Field(i).Set(deepCopy(value.Field(i), copies))
+			}
+		}
+	}
+
+	return dup
+}
@@ -0,0 +1,256 @@
+package locale
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// ErrGroupSeparator indicates that a number has group separators not separating thousands.
+var ErrGroupSeparator = errors.New("misplaced group separator")
+
+// Format holds the conventions used by a language to write numbers,
+// and the words it uses for the units of durations.
+type Format struct {
+	Decimal string            // Decimal separator
+	Groups  []string          // Separators of thousands groups
+	Units   map[string]string // Localized duration unit words, mapped to Go units
+}
+
+// formats is indexed by base language (ISO 639-1) code.
+var formats = map[string]Format{
+	"en": {
+		Decimal: ".",
+		Groups:  []string{","},
+		Units: map[string]string{
+			"hour": "h", "hours": "h", "hr": "h", "hrs": "h",
+			"minute": "m", "minutes": "m", "min": "m", "mins": "m",
+			"second": "s", "seconds": "s", "sec": "s", "secs": "s",
+			"millisecond": "ms", "milliseconds": "ms",
+		},
+	},
+	"de": {
+		Decimal: ",",
+		Groups:  []string{".", " "},
+		Units: map[string]string{
+			"stunde": "h", "stunden": "h", "std": "h",
+			"minute": "m", "minuten": "m", "min": "m",
+			"sekunde": "s", "sekunden": "s", "sek": "s",
+			"millisekunde": "ms", "millisekunden": "ms",
+		},
+	},
+	"fr": {
+		Decimal: ",",
+		Groups:  []string{" ", "\u00a0", "\u202f"},
+		Units: map[string]string{
+			"heure": "h", "heures": "h",
+			"minute": "m", "minutes": "m", "min": "m",
+			"seconde": "s", "secondes": "s", "sec": "s",
+			"milliseconde": "ms", "millisecondes": "ms",
+		},
+	},
+	"es": {
+		Decimal: ",",
+		Groups:  []string{".", " "},
+		Units: map[string]string{
+			"hora": "h", "horas": "h",
+			"minuto": "m", "minutos": "m", "min": "m",
+			"segundo": "s", "segundos": "s", "seg": "s",
+			"milisegundo": "ms", "milisegundos": "ms",
+		},
+	},
+	"it": {
+		Decimal: ",",
+		Groups:  []string{"."},
+		Units: map[string]string{
+			"ora": "h", "ore": "h",
+			"minuto": "m", "minuti": "m", "min": "m",
+			"secondo": "s", "secondi": "s", "sec": "s",
+			"millisecondo": "ms", "millisecondi": "ms",
+		},
+	},
+	"pt": {
+		Decimal: ",",
+		Groups:  []string{".", " "},
+		Units: map[string]string{
+			"hora": "h", "horas": "h",
+			"minuto": "m", "minutos": "m", "min": "m",
+			"segundo": "s", "segundos": "s", "seg": "s",
+			"milissegundo": "ms", "milissegundos": "ms",
+		},
+	},
+	"nl": {
+		Decimal: ",",
+		Groups:  []string{"."},
+		Units: map[string]string{
+			"uur": "h", "uren": "h",
+			"minuut": "m", "minuten": "m", "min": "m",
+			"seconde": "s", "seconden": "s", "sec": "s",
+			"milliseconde": "ms", "milliseconden": "ms",
+		},
+	},
+}
+
+// goUnits are the units accepted by time.ParseDuration, which are always valid.
+var goUnits = map[string]bool{"ns": true, "us": true, "µs": true, "ms": true, "s": true, "m": true, "h": true}
+
+// durationPart matches a number followed by a unit word, at the start of a duration.
+var durationPart = regexp.MustCompile(`^([+-]?[0-9]+(?:[.,][0-9]+)?)\s*([^\s0-9.,+-]+)[\s,]*`)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Lookup returns the number and duration format used by a language, if known.
+func Lookup(lang language.Tag) (Format, bool) {
+	if lang == language.Und {
+		return Format{}, false
+	}
+
+	base, _ := lang.Base()
+	format, found := formats[base.String()]
+
+	return format, found
+}
+
+// Normalizer returns a function rewriting values written in the format of a language into
+// the format expected by Go parsers, for number and duration types. It returns nil if the
+// language is not known, or if the type has no localized format. Slices and maps are not
+// normalized, since their own separators might conflict with decimal ones. Numbers whose
+// group separators are misplaced are errors (see Format.Number).
+func Normalizer(typ reflect.Type, lang language.Tag) func(val string) (string, error) {
+	format, found := Lookup(lang)
+	if !found {
+		return nil
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ == durationType {
+		return func(val string) (string, error) { return format.Duration(val), nil }
+	}
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return format.Number
+	default:
+		return nil
+	}
+}
+
+// Number removes group separators from a number and replaces its decimal separator with a dot.
+// Group separators must separate thousands in the integer part: a separator not followed by
+// exactly three digits (eg. "1,5" in English, probably a decimal number) is an error.
+func (f Format) Number(val string) (string, error) {
+	val = strings.TrimSpace(val)
+
+	integer, fraction, isDecimal := strings.Cut(val, f.Decimal)
+
+	digits, grouped := f.ungroup(integer)
+	if !grouped || f.hasGroups(fraction) {
+		return val, fmt.Errorf("%w in %q", ErrGroupSeparator, val)
+	}
+
+	if isDecimal {
+		digits += "." + fraction
+	}
+
+	return digits, nil
+}
+
+// ungroup removes the group separators from the integer part of a number, and returns false
+// if they are misplaced: groups after the first one (which may be signed) have three digits.
+func (f Format) ungroup(integer string) (string, bool) {
+	groups := []string{integer}
+
+	for _, separator := range f.Groups {
+		var split []string
+		for _, group := range groups {
+			split = append(split, strings.Split(group, separator)...)
+		}
+
+		groups = split
+	}
+
+	if len(groups) == 1 {
+		return integer, true
+	}
+
+	for i, group := range groups {
+		if i == 0 {
+			group = strings.TrimLeft(group, "+-")
+		}
+
+		if !isDigits(group) || (i > 0 && len(group) != 3) || len(group) > 3 {
+			return integer, false
+		}
+	}
+
+	return strings.Join(groups, ""), true
+}
+
+// hasGroups returns true if a value contains any group separator.
+func (f Format) hasGroups(val string) bool {
+	for _, separator := range f.Groups {
+		if strings.Contains(val, separator) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDigits returns true if a value is made of (at least one) decimal digits only.
+func isDigits(val string) bool {
+	if val == "" {
+		return false
+	}
+
+	for _, char := range val {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Duration rewrites a duration made of numbers followed by (localized) unit words, like
+// "1,5 Stunden" or "2 heures 30 minutes", into a Go duration. The value is returned as
+// is if any of its parts cannot be understood, so that parsing it reports the error.
+func (f Format) Duration(val string) string {
+	rest := strings.TrimSpace(val)
+	if rest == "" {
+		return val
+	}
+
+	var duration strings.Builder
+
+	for rest != "" {
+		part := durationPart.FindStringSubmatch(rest)
+		if part == nil {
+			return val
+		}
+
+		unit := strings.ToLower(part[2])
+		if localized, found := f.Units[unit]; found {
+			unit = localized
+		} else if !goUnits[unit] {
+			return val
+		}
+
+		duration.WriteString(strings.Replace(part[1], ",", ".", 1))
+		duration.WriteString(unit)
+
+		rest = rest[len(part[0]):]
+	}
+
+	return duration.String()
+}
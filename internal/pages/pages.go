@@ -0,0 +1,133 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/reeflective/flags"
+)
+
+// Page holds everything documented for a command: its options, positional
+// arguments and subcommands, as found when walking a command struct.
+type Page struct {
+	Command     *flags.Command
+	Options     []Option
+	Args        []*flags.Positional
+	Subcommands []*flags.Command
+}
+
+// Option is an option documented in a page, with its group if any.
+type Option struct {
+	Group *flags.Group
+	Flag  *flags.Flag
+}
+
+// Scan scans the data struct and gathers the contents of each (non-hidden) command page,
+// the root command first. Hidden options are skipped, and commands inherit the options of
+// their parents' persistent groups. Options are in declaration order, inherited ones last.
+func Scan(data interface{}, opts []flags.OptFunc) ([]*Page, error) {
+	root, err := flags.Scan(data, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanPages(root, nil), nil
+}
+
+// scanPages returns the pages of a command and of its subcommands,
+// with the options of the persistent groups of its parents.
+func scanPages(cmd *flags.Command, inherited []Option) []*Page {
+	if cmd.Hidden {
+		return nil
+	}
+
+	page := &Page{Command: cmd, Args: cmd.Positionals}
+
+	var persistent []Option
+
+	for _, opt := range cmd.Options {
+		if opt.Flag.Hidden || opt.Flag.EnvOnly {
+			continue
+		}
+
+		page.Options = append(page.Options, Option(opt))
+
+		if opt.Group != nil && opt.Group.Persistent {
+			persistent = append(persistent, Option(opt))
+		}
+	}
+
+	page.Options = append(page.Options, inherited...)
+	inherited = append(persistent, inherited...)
+	pages := []*Page{page}
+
+	for _, sub := range cmd.Subcommands {
+		if !sub.Hidden {
+			page.Subcommands = append(page.Subcommands, sub)
+		}
+
+		pages = append(pages, scanPages(sub, inherited)...)
+	}
+
+	return pages
+}
+
+// Find returns the page of the command with the given path (none for the root command).
+func Find(pages []*Page, path []string) *Page {
+	for _, page := range pages {
+		if strings.Join(page.Command.Path, " ") == strings.Join(path, " ") {
+			return page
+		}
+	}
+
+	return nil
+}
+
+// Name returns the name of the page of a command, eg. `app-remote-add`.
+func Name(name string, cmd *flags.Command) string {
+	return strings.Join(append([]string{name}, cmd.Path...), "-")
+}
+
+// Synopsis returns the usage line of a command, after its name.
+func Synopsis(page *Page) string {
+	var words []string
+
+	if len(page.Options) > 0 {
+		words = append(words, "[OPTIONS]")
+	}
+
+	if len(page.Subcommands) > 0 {
+		words = append(words, "COMMAND")
+	}
+
+	for _, arg := range page.Args {
+		word := strings.ToUpper(arg.Name)
+		if arg.Maximum < 0 || arg.Maximum > 1 {
+			word += "..."
+		}
+
+		if arg.Minimum == 0 {
+			word = "[" + word + "]"
+		}
+
+		words = append(words, word)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// Requirement describes the number of words required by a positional argument.
+func Requirement(arg *flags.Positional) string {
+	switch {
+	case arg.Maximum < 0 && arg.Minimum > 0:
+		return fmt.Sprintf("At least %d required.", arg.Minimum)
+	case arg.Maximum > 1 && arg.Minimum == arg.Maximum:
+		return fmt.Sprintf("Exactly %d required.", arg.Minimum)
+	case arg.Maximum > 1:
+		return fmt.Sprintf("Between %d and %d.", arg.Minimum, arg.Maximum)
+	case arg.Minimum > 0:
+		return "Required."
+	default:
+		return ""
+	}
+}
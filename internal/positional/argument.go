@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/reeflective/flags/internal/convert"
+	"github.com/reeflective/flags/internal/scan"
 	"github.com/reeflective/flags/internal/tag"
 )
 
@@ -15,6 +16,18 @@ import (
 // given its minimum amount of positional words to use.
 var ErrRequired = errors.New("required argument")
 
+// ArgError is an error of a positional argument, either because it has not been
+// given enough words, or because one of them could not be parsed onto its field.
+type ArgError struct {
+	Arg  string // The name of the positional argument
+	Word string // The word that could not be parsed, if any
+	Err  error
+}
+
+func (e *ArgError) Error() string { return e.Err.Error() }
+
+func (e *ArgError) Unwrap() error { return e.Err }
+
 // WordConsumer is a function that has access to the array of positional slots,
 // giving a few functions to manipulate the list of words we want to parse.
 // As well, the current positional argument is a parameter, which is the only
@@ -43,6 +56,34 @@ type Arg struct {
 	Tag       tag.MultiTag  // struct tag
 	Value     reflect.Value // A reference to the field value itself
 	Validator func(val string) error
+	Normalize func(val string) (string, error) // Replaces words with their canonical spelling
+	Choices   []string                         // Allowed words, with their canonical spelling
+	Hook      scan.SetHook                     // Called when the value of the field has changed
+	streamed  int                              // Number of words given to a stream field
+}
+
+// streamType is the type of positional fields receiving their words lazily,
+// through an iterator, instead of having them all converted into a slice.
+var streamType = reflect.TypeOf(func(yield func(string) bool) {})
+
+// IsStream returns true if the type is the one of a stream positional
+// field, that is, func(yield func(string) bool) or a type defined from it.
+func IsStream(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Func && typ.ConvertibleTo(streamType)
+}
+
+// isList returns true if the positional field can hold several words.
+func isList(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Slice || typ.Kind() == reflect.Map || IsStream(typ)
+}
+
+// len returns the number of words parsed onto a list positional field.
+func (arg *Arg) len() int {
+	if IsStream(arg.Value.Type()) {
+		return arg.streamed
+	}
+
+	return arg.Value.Len()
 }
 
 // Args contains an entire list of positional argument "slots" (struct fields)
@@ -71,6 +112,9 @@ type Args struct {
 	// This consumer is called for each positional slot, either
 	// sequentially (normal parsing) or concurrently (useful for completions)
 	consumer WordConsumer
+
+	// Translations of the messages of errors, if any.
+	catalog map[string]string
 }
 
 // Parse acceps a list of command-line words to be ALL parsed as positional
@@ -98,7 +142,11 @@ func (args *Args) Parse(words []string, dash int) (retargs []string, err error)
 
 		// Either the positional argument has not had enough words
 		if errors.Is(err, ErrRequired) {
-			return retargs, args.positionalRequiredErr(*arg)
+			if err := args.positionalRequiredErr(*arg); err != nil {
+				return retargs, &ArgError{Arg: arg.Name, Err: err}
+			}
+
+			return retargs, nil
 		}
 
 		// Or we have failed to parse the word onto the struct field
@@ -125,6 +173,13 @@ func (args *Args) Parse(words []string, dash int) (retargs []string, err error)
 // which positional argument to complete, and optionally to ensure that the
 // previously completed ones do not raise conversion errors.
 func (args *Args) ParseConcurrent(words []string) {
+	args.ParseConcurrentWith(words, args.consumer)
+}
+
+// ParseConcurrentWith is like ParseConcurrent, but runs a word consumer given for this
+// parsing only, instead of the one set with WithWordConsumer, so that concurrent calls
+// (eg. completions run in parallel) can each collect their own results.
+func (args *Args) ParseConcurrentWith(words []string, consumer WordConsumer) {
 	workers := &sync.WaitGroup{}
 
 	for _, arg := range args.slots {
@@ -132,6 +187,7 @@ func (args *Args) ParseConcurrent(words []string) {
 		// work on the same word list while doing different things.
 		argsC := args.copyArgs()
 		argsC.words = words
+		argsC.consumer = consumer
 
 		workers.Add(1)
 
@@ -174,6 +230,7 @@ func (args *Args) copyArgs() *Args {
 		done:        0,
 		parsed:      0,
 		consumer:    args.consumer,
+		catalog:     args.catalog,
 	}
 }
 
@@ -181,6 +238,14 @@ func (args *Args) copyArgs() *Args {
 // their struct fields, and returns once its own requirements are satisfied and/or the
 // next positional arguments require words to be passed along.
 func (args *Args) consumeWords(self *Args, arg *Arg, dash int) error {
+	// Streams only keep a reference to the words they consume.
+	stream := IsStream(arg.Value.Type())
+	words := self.words
+
+	if stream {
+		defer func() { arg.stream(words[:self.parsed]) }()
+	}
+
 	// As long as we've got a word, and nothing told us to quit.
 	for !self.Empty() {
 		// If we have reached the maximum number of args we accept.
@@ -205,17 +270,31 @@ func (args *Args) consumeWords(self *Args, arg *Arg, dash int) error {
 		// of arguments, we are cleared to consume one.
 		next := args.Pop()
 
+		if arg.Normalize != nil {
+			normalized, err := arg.Normalize(next)
+			if err != nil {
+				return &ArgError{Arg: arg.Name, Word: next, Err: err}
+			}
+
+			next = normalized
+		}
+
 		// If the positional slot has a validator function,
 		// run it before trying to convert the value.
 		if arg.Validator != nil {
 			if err := arg.Validator(next); err != nil {
-				return err
+				return &ArgError{Arg: arg.Name, Word: next, Err: err}
 			}
 		}
+		// Streams are given their words once they are all consumed.
+		if stream {
+			continue
+		}
+
 		// Parse the string value onto its native type, returning any errors.
 		// We also break this loop immediately if we are not parsing onto a list.
-		if err := convert.Value(next, arg.Value, arg.Tag); err != nil {
-			return fmt.Errorf("%w: %s", convert.ErrConvertion, err.Error())
+		if err := arg.convert(next); err != nil {
+			return &ArgError{Arg: arg.Name, Word: next, Err: fmt.Errorf("%w: %s", convert.ErrConvertion, err.Error())}
 		} else if arg.Value.Type().Kind() != reflect.Slice {
 			return nil
 		}
@@ -233,6 +312,57 @@ func (args *Args) consumeWords(self *Args, arg *Arg, dash int) error {
 	return nil
 }
 
+// convert parses a word onto the argument field, and notifies
+// any hook registered for it if its value has changed.
+func (arg *Arg) convert(word string) error {
+	if arg.Hook == nil {
+		return convert.Value(word, arg.Value, arg.Tag)
+	}
+
+	// Maps and slices are updated in place, so we keep a copy of their elements.
+	old := convert.Copy(arg.Value)
+
+	if err := convert.Value(word, arg.Value, arg.Tag); err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(old.Interface(), arg.Value.Interface()) {
+		arg.Hook(old.Interface(), arg.Value.Interface())
+	}
+
+	return nil
+}
+
+// stream sets a stream field to an iterator over the words it has consumed,
+// which are normalized as they are yielded, and notifies any hook registered for it.
+func (arg *Arg) stream(words []string) {
+	normalize := arg.Normalize
+
+	iterate := func(yield func(string) bool) {
+		for _, word := range words {
+			if normalize != nil {
+				if normalized, err := normalize(word); err == nil {
+					word = normalized
+				}
+			}
+
+			if !yield(word) {
+				return
+			}
+		}
+	}
+
+	old := reflect.New(arg.Value.Type()).Elem()
+	old.Set(arg.Value)
+
+	arg.streamed = len(words)
+	arg.Value.Set(reflect.ValueOf(iterate).Convert(arg.Value.Type()))
+
+	if arg.Hook != nil {
+		arg.Hook(old.Interface(), arg.Value.Interface())
+	}
+}
+
 //
 // Error check/build/format code ----------------------------------------------------------------------
 //
@@ -246,17 +376,16 @@ func (args *Args) checkRequirementsFinal() error {
 	}
 
 	current := slots[len(slots)-1]
-	isSlice := current.Value.Type().Kind() == reflect.Slice || current.Value.Type().Kind() == reflect.Map
+	isSlice := isList(current.Value.Type())
 
 	// This is for retrocompatibility with jessevdk/go-flags, so that
 	// any remaining slot being a list with a specified maximum value
 	// cannot accept more than that, and will error out instead of
 	// silently passing the excess args onto the Execute() parameters.
-	if isSlice && current.Value.Len() == current.Maximum && len(args.words) > 0 {
-		overweight := argHasTooMany(*current, len(args.words))
-		msgErr := fmt.Sprintf("%s was not provided", overweight)
+	if isSlice && current.len() == current.Maximum && len(args.words) > 0 {
+		overweight := args.argHasTooMany(current, len(args.words))
 
-		return fmt.Errorf("%w: %s", ErrRequired, msgErr)
+		return &ArgError{Arg: current.Name, Err: &requiredError{args.message(MessageRequired, overweight)}}
 	}
 
 	return nil
@@ -266,16 +395,12 @@ func (args *Args) checkRequirementsFinal() error {
 // when they fail, to compute a precise error message on argument requirements.
 func (args *Args) positionalRequiredErr(arg Arg) error {
 	if names := args.getRequiredNames(arg); len(names) > 0 {
-		var msg string
-
 		if len(names) == 1 {
-			msg = fmt.Sprintf("%s was not provided", names[0])
-		} else {
-			msg = fmt.Sprintf("%s and %s were not provided",
-				strings.Join(names[:len(names)-1], ", "), names[len(names)-1])
+			return &requiredError{args.message(MessageRequired, names[0])}
 		}
 
-		return fmt.Errorf("%w: %s", ErrRequired, msg)
+		return &requiredError{args.message(MessageRequiredMany,
+			strings.Join(names[:len(names)-1], ", "), names[len(names)-1])}
 	}
 
 	return nil
@@ -297,7 +422,7 @@ func (args *Args) getRequiredNames(current Arg) (names []string) {
 		}
 
 		// If the positional is a single slot, we need its name
-		if arg.Value.Type().Kind() != reflect.Slice {
+		if !isList(arg.Value.Type()) {
 			names = append(names, "`"+arg.Name+"`")
 
 			continue
@@ -305,8 +430,8 @@ func (args *Args) getRequiredNames(current Arg) (names []string) {
 
 		// If we have less words to parse than
 		// the minimum required by this argument.
-		if arg.Value.Len() < arg.Minimum {
-			names = append(names, argHasNotEnough(*arg))
+		if arg.len() < arg.Minimum {
+			names = append(names, args.argHasNotEnough(arg))
 
 			continue
 		}
@@ -316,44 +441,31 @@ func (args *Args) getRequiredNames(current Arg) (names []string) {
 }
 
 // makes a correct sentence when we don't have enough args.
-func argHasNotEnough(arg Arg) string {
-	var arguments string
-
+func (args *Args) argHasNotEnough(arg *Arg) string {
 	if arg.Minimum > 1 {
-		arguments = "arguments, but got only " + fmt.Sprintf("%d", arg.Value.Len())
-	} else {
-		arguments = "argument"
+		return args.message(MessageAtLeast, arg.Name, arg.Minimum, arg.len())
 	}
 
-	argRequired := "`" + arg.Name + " (at least " + fmt.Sprintf("%d",
-		arg.Minimum) + " " + arguments + ")`"
-
-	return argRequired
+	return args.message(MessageAtLeastOne, arg.Name, arg.Minimum)
 }
 
 // makes a correct sentence when we have too much args.
-func argHasTooMany(arg Arg, added int) string {
+func (args *Args) argHasTooMany(arg *Arg, added int) string {
 	// The argument might be explicitly disabled...
 	if arg.Maximum == 0 {
-		return "`" + arg.Name + " (zero arguments)`"
+		return args.message(MessageZero, arg.Name)
 	}
 
 	// Or just build the list accordingly.
-	var parsed string
-
 	if arg.Maximum > 1 {
-		parsed = "arguments, but got " + fmt.Sprintf("%d", arg.Value.Len()+added)
-	} else {
-		parsed = "argument"
+		return args.message(MessageAtMost, arg.Name, arg.Maximum, arg.len()+added)
 	}
 
-	hasTooMany := "`" + arg.Name + " (at most " + fmt.Sprintf("%d", arg.Maximum) + " " + parsed + ")`"
-
-	return hasTooMany
+	return args.message(MessageAtMostOne, arg.Name, arg.Maximum)
 }
 
 func isRequired(p *Arg) bool {
-	return (p.Value.Type().Kind() != reflect.Slice && (p.Minimum > 0)) || // Both must be true
+	return (!isList(p.Value.Type()) && (p.Minimum > 0)) || // Both must be true
 		p.Minimum != -1 || p.Maximum != -1 // And either of these
 }
 
@@ -0,0 +1,46 @@
+package positional
+
+import "fmt"
+
+// Keys of the messages of positional errors, which can be translated
+// with the catalog given in options (see flags.Catalog).
+const (
+	MessageRequired     = "#required-argument"
+	MessageRequiredMany = "#required-arguments"
+	MessageAtLeastOne   = "#at-least-argument"
+	MessageAtLeast      = "#at-least-arguments"
+	MessageAtMostOne    = "#at-most-argument"
+	MessageAtMost       = "#at-most-arguments"
+	MessageZero         = "#zero-arguments"
+)
+
+// Messages are the default texts of the messages of positional errors.
+var Messages = map[string]string{
+	MessageRequired:     "required argument: %s was not provided",
+	MessageRequiredMany: "required argument: %s and %s were not provided",
+	MessageAtLeastOne:   "`%s (at least %d argument)`",
+	MessageAtLeast:      "`%s (at least %d arguments, but got only %d)`",
+	MessageAtMostOne:    "`%s (at most %d argument)`",
+	MessageAtMost:       "`%s (at most %d arguments, but got %d)`",
+	MessageZero:         "`%s (zero arguments)`",
+}
+
+// message returns a message formatted with its arguments,
+// translated with the catalog of the positionals, if any.
+func (args *Args) message(key string, values ...interface{}) string {
+	text := Messages[key]
+	if translated := args.catalog[key]; translated != "" {
+		text = translated
+	}
+
+	return fmt.Sprintf(text, values...)
+}
+
+// requiredError is an ErrRequired error, whose message might be translated.
+type requiredError struct {
+	msg string
+}
+
+func (e *requiredError) Error() string { return e.msg }
+
+func (e *requiredError) Is(target error) bool { return target == ErrRequired }
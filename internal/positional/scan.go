@@ -24,7 +24,7 @@ func ScanArgs(val reflect.Value, stag tag.MultiTag, opts ...scan.OptFunc) (*Args
 	opt := scan.DefOpts().Apply(opts...)
 
 	// Holds our positional slots and manages them
-	args := &Args{allRequired: reqAll, noTags: true}
+	args := &Args{allRequired: reqAll, noTags: true, catalog: opt.Catalog}
 
 	// Each positional field is scanned for its number requirements,
 	// and underlying value to be used by the command's arg handlers/converters.
@@ -40,6 +40,44 @@ func ScanArgs(val reflect.Value, stag tag.MultiTag, opts ...scan.OptFunc) (*Args
 		}
 	}
 
+	return args.ready()
+}
+
+// ScanFields scans the fields of a command struct tagged `positional-arg` as positional
+// arguments, in declaration order, like the fields of a positional-args struct. It returns
+// nil if there are no such fields, and an error if one of them cannot be scanned.
+func ScanFields(val reflect.Value, opts ...scan.OptFunc) (*Args, error) {
+	stype := val.Type()
+	opt := scan.DefOpts().Apply(opts...)
+	args := &Args{noTags: true, catalog: opt.Catalog}
+
+	for fieldCount := 0; fieldCount < stype.NumField(); fieldCount++ {
+		field := stype.Field(fieldCount)
+
+		mtag, skip, err := opt.FieldTag(field)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", scan.ErrScan, err)
+		}
+
+		if _, isArg := mtag.Get("positional-arg"); skip || !isArg {
+			continue
+		}
+
+		if err := args.scanArg(field, val.Field(fieldCount), false, opt); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(args.slots) == 0 {
+		return nil, nil
+	}
+
+	return args.ready()
+}
+
+// ready adjusts the requirements of the positionals once all of
+// them are scanned, and returns them ready to parse words.
+func (args *Args) ready() (*Args, error) {
 	// Depending on our position and type, we reset the maximum
 	// number of words allowed for this argument, and update the
 	// counter that will be used by handlers to sync their use
@@ -60,7 +98,7 @@ func ScanArgs(val reflect.Value, stag tag.MultiTag, opts ...scan.OptFunc) (*Args
 
 // scanArg scans a single struct field as positional argument, and sets everything related to it.
 func (args *Args) scanArg(field reflect.StructField, value reflect.Value, reqAll bool, opt scan.Opts) error {
-	ptag, name, err := parsePositionalTag(field)
+	ptag, name, err := parsePositionalTag(field, opt)
 	if err != nil {
 		return err
 	}
@@ -83,6 +121,7 @@ func (args *Args) scanArg(field reflect.StructField, value reflect.Value, reqAll
 		StartMin: args.totalMin,
 		StartMax: args.totalMax,
 		Value:    value,
+		Hook:     opt.Hook(value),
 	}
 
 	args.slots = append(args.slots, arg)
@@ -104,16 +143,24 @@ func (args *Args) scanArg(field reflect.StructField, value reflect.Value, reqAll
 		choices = append(choices, strings.Split(choice, " ")...)
 	}
 
-	if validator := validation.Bind(value, field, choices, opt); validator != nil {
+	validator, err := validation.Bind(value, field, choices, opt)
+	if err != nil {
+		return err
+	}
+
+	if validator != nil {
 		arg.Validator = validator
 	}
 
+	arg.Normalize = validation.Normalizer(field, choices, opt)
+	arg.Choices = validation.NormalizedChoices(choices, validation.ChoiceInsensitive(field, opt))
+
 	return nil
 }
 
 // parsePositionalTag extracts and fully parses a struct (positional) field tag.
-func parsePositionalTag(field reflect.StructField) (tag.MultiTag, string, error) {
-	tag, _, err := tag.GetFieldTag(field)
+func parsePositionalTag(field reflect.StructField, opt scan.Opts) (tag.MultiTag, string, error) {
+	tag, _, err := opt.FieldTag(field)
 	if err != nil {
 		return tag, field.Name, fmt.Errorf("%w: %s", scan.ErrScan, err)
 	}
@@ -138,7 +185,7 @@ func positionalReqs(val reflect.Value, mtag tag.MultiTag, all bool) (min, max in
 	}
 
 	// When the argument field is not a slice, we have to adjust for some defaults
-	isSlice := val.Type().Kind() == reflect.Slice || val.Type().Kind() == reflect.Map
+	isSlice := isList(val.Type())
 	if !isSlice {
 		max = 1
 	}
@@ -198,8 +245,7 @@ func parseArgsNumRequired(fieldTag tag.MultiTag) (required, maximum int, set boo
 func (args *Args) adjustMaximums() error {
 	for _, arg := range args.slots {
 		val := arg.Value
-		isSlice := val.Type().Kind() == reflect.Slice ||
-			val.Type().Kind() == reflect.Map
+		isSlice := isList(val.Type())
 
 		// First, the maximum index at which we should start
 		// parsing words can never be smaller than the minimum one
@@ -1,9 +1,13 @@
 package scan
 
 import (
+	"os"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/reeflective/flags/internal/tag"
+	"golang.org/x/text/language"
 )
 
 const (
@@ -15,6 +19,10 @@ const (
 	DefaultFlatten     = true
 )
 
+// DefaultReservedNames are the names of the internal commands of cobra (shell completion
+// requests) and carapace, and the `__` prefix of future internals (marked by a final `*`).
+var DefaultReservedNames = []string{"__complete", "__completeNoDesc", "_carapace", "__*"}
+
 // ValidateFunc describes a validation func, that takes string val for flag from command line,
 // field that's associated with this flag in structure cfg. Also works for positional arguments.
 // Should return error if validation fails.
@@ -26,6 +34,21 @@ type ValidateFunc func(val string, field reflect.StructField, cfg interface{}) e
 // for completer implementations, bind to viper configurations, etc.
 type FlagFunc func(flag string, tag tag.MultiTag, val reflect.Value) error
 
+// SetHook is called with the previous and new values of a struct
+// field, each time this field is set from the command-line.
+type SetHook func(old, new interface{})
+
+// ValueSource is a named source of default values for options tagged with `source`.
+type ValueSource interface {
+	Name() string
+	Resolve(key string) (string, bool)
+}
+
+// TagFunc converts the tag of a struct field, eg. from the tags of another library
+// into those of this one, before the field is scanned. It returns true if the field
+// must not be scanned.
+type TagFunc func(field reflect.StructField, mtag *tag.MultiTag) (skip bool)
+
 // OptFunc sets values in opts structure.
 type OptFunc func(opt *Opts)
 
@@ -40,6 +63,83 @@ type Opts struct {
 	ParseAll    bool
 	Validator   ValidateFunc
 	FlagFunc    FlagFunc
+	Hooks       map[interface{}]SetHook
+
+	// Part of the flags prefix already accounted
+	// for in the env prefix (by env namespaces).
+	EnvScope string
+
+	// Choices matching
+	ChoiceCaseInsensitive bool
+
+	// Localized number and duration values
+	Locale language.Tag
+
+	// Command-line words like +x unset boolean flags
+	PlusToggles bool
+
+	// Aliases of options are shown in help usages
+	ShowAliases bool
+
+	// Whether output is colored ("auto", "always" or "never")
+	Colors string
+
+	// Width of help usages (0: detected, negative: not wrapped)
+	HelpWidth int
+
+	// A hidden command installs completion scripts
+	CompletionInstall bool
+
+	// Executables named `<app>-<command>` are subcommands,
+	// found in directories (PATH directories if none).
+	Plugins    bool
+	PluginDirs []string
+
+	// Signals canceling the context of commands, with the notice
+	// printed when canceling it, and how long commands have to return.
+	SignalCancel    bool
+	CancelSignals   []os.Signal
+	ShutdownNotice  string
+	ShutdownTimeout time.Duration
+
+	// Execution frontend (eg. "repl" or "cli"),
+	// to filter fields tagged with another mode.
+	Mode string
+
+	// Unknown flags are collected instead of being errors
+	CollectUnknownFlags bool
+
+	// Commands are only generated to parse command-lines,
+	// without the implementations of their structs.
+	ParseOnly bool
+
+	// Environment snapshot used instead of the process one
+	Environ Environ
+
+	// Environment lookup function used instead of the process one
+	EnvLookup func(key string) (string, bool)
+
+	// Sources of default values, by name
+	Sources map[string]ValueSource
+
+	// Translations of descriptions and placeholders, by message key
+	Catalog map[string]string
+
+	// Completion functions of completion backends, by directive name
+	Completers map[string]interface{}
+
+	// Names (or prefixes, ending with *) user commands cannot use
+	ReservedNames []string
+
+	// Limits of the command-line (0: unlimited)
+	MaxArgs      int
+	MaxArgLength int
+	MaxElements  int
+
+	// Conversion of the tags of struct fields, and whether
+	// default values in tags set the fields which are empty.
+	TagFunc     TagFunc
+	TagDefaults bool
 }
 
 func (o Opts) Apply(optFuncs ...OptFunc) Opts {
@@ -50,6 +150,67 @@ func (o Opts) Apply(optFuncs ...OptFunc) Opts {
 	return o
 }
 
+// Hook returns the set hook registered for the given struct field value, if any.
+func (o Opts) Hook(value reflect.Value) SetHook {
+	if len(o.Hooks) == 0 || !value.CanAddr() {
+		return nil
+	}
+
+	return o.Hooks[value.Addr().Interface()]
+}
+
+// FieldTag returns the parsed tag of a struct field, like tag.GetFieldTag,
+// once converted by the tag function of the options, if any.
+func (o Opts) FieldTag(field reflect.StructField) (tag.MultiTag, bool, error) {
+	mtag, skip, err := tag.GetFieldTag(field)
+	if err != nil || o.TagFunc == nil || field.Tag == "" || (field.PkgPath != "" && !field.Anonymous) {
+		return mtag, skip, err
+	}
+
+	if o.TagFunc(field, &mtag) {
+		return mtag, true, nil
+	}
+
+	return mtag, skip, nil
+}
+
+// LookupEnv returns the value of an environment variable, either from the
+// lookup function or the environment snapshot if one is set, or from the process one.
+func (o Opts) LookupEnv(key string) (string, bool) {
+	if o.EnvLookup != nil {
+		return o.EnvLookup(key)
+	}
+
+	if o.Environ == nil {
+		return os.LookupEnv(key)
+	}
+
+	val, found := o.Environ[key]
+
+	return val, found
+}
+
+// Environ is a snapshot of environment variables, indexed by name.
+// It is built once and shared by all the options of a parsing run.
+type Environ map[string]string
+
+// NewEnviron indexes a list of "key=value" strings, in the format of os.Environ().
+// As with the process environment, later values of a variable override earlier ones.
+func NewEnviron(env []string) Environ {
+	environ := make(Environ, len(env))
+
+	for _, entry := range env {
+		key, val, found := strings.Cut(entry, "=")
+		if !found || key == "" {
+			continue
+		}
+
+		environ[key] = val
+	}
+
+	return environ
+}
+
 func CopyOpts(val Opts) OptFunc { return func(opt *Opts) { *opt = val } }
 
 func DefOpts() Opts {
@@ -59,5 +220,7 @@ func DefOpts() Opts {
 		FlagDivider: DefaultFlagDivider,
 		EnvDivider:  DefaultEnvDivider,
 		Flatten:     DefaultFlatten,
+
+		ReservedNames: DefaultReservedNames,
 	}
 }
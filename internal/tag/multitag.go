@@ -58,8 +58,8 @@ func GetFieldTag(field reflect.StructField) (MultiTag, bool, error) {
 		return mtag, false, err
 	}
 
-	// Skip fields with the no-flag tag
-	if noFlag, _ := mtag.Get("no-flag"); noFlag != "" {
+	// Skip fields with the no-flag tag, unless they are set from the environment.
+	if noFlag, _ := mtag.Get("no-flag"); noFlag != "" && !IsEnvOnly(mtag) {
 		return mtag, true, nil
 	}
 
@@ -104,6 +104,12 @@ func (x *MultiTag) SetMany(key string, value []string) {
 	c[key] = value
 }
 
+// Delete removes a key from the cache.
+func (x *MultiTag) Delete(key string) {
+	c := x.cached()
+	delete(c, key)
+}
+
 func (x *MultiTag) scan() (map[string][]string, error) {
 	val := x.value
 
@@ -233,3 +239,12 @@ func (x *MultiTag) cached() map[string][]string {
 
 	return x.cache
 }
+
+// IsEnvOnly returns true if the field tag has both the no-flag and env tags: its
+// value is then only set from the environment, without any command-line flag.
+func IsEnvOnly(mtag MultiTag) bool {
+	noFlag, _ := mtag.Get("no-flag")
+	_, hasEnv := mtag.Get("env")
+
+	return noFlag != "" && hasEnv
+}
@@ -0,0 +1,34 @@
+// Package terminal finds the width of the terminal to which help usages are written,
+// following the conventions of the environment of the user: the COLUMNS variable, when
+// set to a positive number, has priority over the size of the terminal itself.
+package terminal
+
+import (
+	"os"
+	"strconv"
+)
+
+// Width returns the width to which output should be wrapped: the override when positive,
+// none (0) when negative, and otherwise the one found from the environment variables
+// returned by lookupEnv (COLUMNS) or from the terminal of the standard output, if any.
+func Width(override int, lookupEnv func(key string) (string, bool)) int {
+	switch {
+	case override > 0:
+		return override
+	case override < 0:
+		return 0
+	}
+
+	if value, _ := lookupEnv("COLUMNS"); value != "" {
+		if columns, err := strconv.Atoi(value); err == nil && columns > 0 {
+			return columns
+		}
+	}
+
+	return size(os.Stdout)
+}
+
+// IsTerminal returns true if a file is a terminal (with a known size).
+func IsTerminal(file *os.File) bool {
+	return size(file) > 0
+}
@@ -0,0 +1,12 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd && !dragonfly && !windows
+
+package terminal
+
+import "os"
+
+// size returns 0 on systems where the size of terminals is not queried
+// (eg. solaris, aix, plan9 or js/wasm): the width is only known from the
+// COLUMNS variable, if it is set.
+func size(*os.File) int {
+	return 0
+}
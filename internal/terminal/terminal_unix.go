@@ -0,0 +1,26 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package terminal
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// winsize is the size of a terminal, as returned by the TIOCGWINSZ ioctl.
+type winsize struct {
+	rows, cols, xpixels, ypixels uint16
+}
+
+// size returns the number of columns of the terminal of a file, or 0 if it is not one.
+func size(file *os.File) int {
+	var size winsize
+
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, file.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&size)))
+	if errno != 0 {
+		return 0
+	}
+
+	return int(size.cols)
+}
@@ -0,0 +1,39 @@
+//go:build windows
+
+package terminal
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// getConsoleScreenBufferInfo queries the size of the console of a handle.
+var getConsoleScreenBufferInfo = syscall.NewLazyDLL("kernel32.dll").NewProc("GetConsoleScreenBufferInfo")
+
+// coord and smallRect are the COORD and SMALL_RECT structures of the console API.
+type (
+	coord     struct{ x, y int16 }
+	smallRect struct{ left, top, right, bottom int16 }
+)
+
+// consoleScreenBufferInfo is the CONSOLE_SCREEN_BUFFER_INFO structure of the console API.
+type consoleScreenBufferInfo struct {
+	size              coord
+	cursorPosition    coord
+	attributes        uint16
+	window            smallRect
+	maximumWindowSize coord
+}
+
+// size returns the number of columns of the console window of a file, or 0 if it is not one.
+func size(file *os.File) int {
+	var info consoleScreenBufferInfo
+
+	ok, _, _ := getConsoleScreenBufferInfo.Call(file.Fd(), uintptr(unsafe.Pointer(&info)))
+	if ok == 0 {
+		return 0
+	}
+
+	return int(info.window.right - info.window.left + 1)
+}
@@ -0,0 +1,192 @@
+// Package usage counts how many times commands and their options are used, in a
+// file persisted across runs, so that generators can show the most used ones first.
+package usage
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Count is the number of times a command or an option has been used.
+type Count struct {
+	Name string
+	Uses int
+}
+
+// counts are the usage statistics, as persisted in their file.
+type counts struct {
+	Commands map[string]int            `json:"commands"` // By command path, without the root name
+	Flags    map[string]map[string]int `json:"flags"`    // By command path, then flag name
+}
+
+// stats holds the usage statistics, and the file they are persisted
+// in. Its path is empty (and nothing is counted) until Enable().
+var stats struct {
+	sync.RWMutex
+	path   string
+	counts counts
+}
+
+// Enable loads the statistics persisted in a file (if it exists),
+// and counts the commands and options recorded from now on.
+func Enable(path string) error {
+	stats.Lock()
+	defer stats.Unlock()
+
+	loaded := counts{}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &loaded); err != nil {
+			return err
+		}
+	}
+
+	stats.path, stats.counts = path, loaded
+
+	return nil
+}
+
+// Disable stops counting commands and options, without removing their file.
+func Disable() {
+	stats.Lock()
+	defer stats.Unlock()
+
+	stats.path, stats.counts = "", counts{}
+}
+
+// Enabled returns true if commands and options are being counted.
+func Enabled() bool {
+	stats.RLock()
+	defer stats.RUnlock()
+
+	return stats.path != ""
+}
+
+// Reset removes the statistics persisted in a file, and clears
+// those loaded in memory if they have been loaded from this file.
+func Reset(path string) error {
+	stats.Lock()
+	defer stats.Unlock()
+
+	if stats.path == path {
+		stats.counts = counts{}
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// Record counts a use of a command (designated by its path) and of some of its
+// options, and persists the statistics. It does nothing if counting is disabled.
+func Record(command []string, flags []string) error {
+	stats.Lock()
+	defer stats.Unlock()
+
+	if stats.path == "" {
+		return nil
+	}
+
+	key := strings.Join(command, " ")
+
+	if stats.counts.Commands == nil {
+		stats.counts.Commands = make(map[string]int)
+	}
+
+	stats.counts.Commands[key]++
+
+	if len(flags) > 0 {
+		if stats.counts.Flags == nil {
+			stats.counts.Flags = make(map[string]map[string]int)
+		}
+
+		if stats.counts.Flags[key] == nil {
+			stats.counts.Flags[key] = make(map[string]int)
+		}
+
+		for _, flag := range flags {
+			stats.counts.Flags[key][flag]++
+		}
+	}
+
+	data, err := json.Marshal(stats.counts)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(stats.path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(stats.path, data, 0o600)
+}
+
+// Subcommands returns the subcommands of a command which have been used (either
+// directly or through their own subcommands), the most used first, by name.
+func Subcommands(command []string) []Count {
+	stats.RLock()
+	defer stats.RUnlock()
+
+	prefix := strings.Join(command, " ")
+	if prefix != "" {
+		prefix += " "
+	}
+
+	uses := make(map[string]int)
+
+	for key, count := range stats.counts.Commands {
+		if key == "" || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		name, _, _ := strings.Cut(key[len(prefix):], " ")
+		uses[name] += count
+	}
+
+	used := make([]Count, 0, len(uses))
+	for name, count := range uses {
+		used = append(used, Count{Name: name, Uses: count})
+	}
+
+	return rank(used)
+}
+
+// Flags returns the options of a command which have been used, the most used first.
+func Flags(command []string) []Count {
+	stats.RLock()
+	defer stats.RUnlock()
+
+	flags := stats.counts.Flags[strings.Join(command, " ")]
+	used := make([]Count, 0, len(flags))
+
+	for name, uses := range flags {
+		used = append(used, Count{Name: name, Uses: uses})
+	}
+
+	return rank(used)
+}
+
+// rank sorts counts by decreasing uses, then by name.
+func rank(used []Count) []Count {
+	sort.Slice(used, func(i, j int) bool {
+		if used[i].Uses != used[j].Uses {
+			return used[i].Uses > used[j].Uses
+		}
+
+		return used[i].Name < used[j].Name
+	})
+
+	return used
+}
@@ -0,0 +1,252 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/reeflective/flags/internal/tag"
+)
+
+var (
+	// ErrCheck indicates that a value does not pass one of the checks of its `check` tag.
+	ErrCheck = errors.New("invalid value")
+
+	// ErrInvalidCheck indicates that a `check` tag is malformed, or refers to an unknown check.
+	ErrInvalidCheck = errors.New("invalid check")
+)
+
+// CheckFunc is a check registered by name, and used in `check` tags. It is called
+// with each value given to the field, and with the argument of the check, if any
+// (eg. "arg" for `check:"name=arg"`).
+type CheckFunc func(value, arg string) error
+
+// checks are the custom checks registered by name.
+var checks = struct {
+	sync.RWMutex
+	funcs map[string]CheckFunc
+}{funcs: make(map[string]CheckFunc)}
+
+// builtinChecks are the checks always available in `check` tags.
+var builtinChecks = map[string]bool{
+	"min": true, "max": true, "regexp": true, "file": true, "dir": true, "exists": true, "url": true,
+}
+
+// RegisterCheck registers a check usable by name in `check` tags. It panics if
+// the name is empty, already registered or the one of a builtin check.
+func RegisterCheck(name string, check CheckFunc) {
+	checks.Lock()
+	defer checks.Unlock()
+
+	switch {
+	case name == "" || check == nil:
+		panic("flags: RegisterCheck needs a name and a check function")
+	case builtinChecks[name] || checks.funcs[name] != nil:
+		panic(fmt.Sprintf("flags: check %q is already registered", name))
+	}
+
+	checks.funcs[name] = check
+}
+
+// Checks returns a function running all checks declared in the `check` tags of a field on
+// a value, or nil if the field has none. Checks are separated by commas, and a `regexp` check
+// takes the remainder of its tag, so that its expression may contain commas.
+func Checks(field reflect.StructField) (func(val string) error, error) {
+	mtag := tag.NewMultiTag(string(field.Tag))
+	if err := mtag.Parse(); err != nil {
+		return nil, err
+	}
+
+	var rules []func(val string) error
+
+	for _, checkTag := range mtag.GetMany("check") {
+		for checkTag != "" {
+			var rule string
+
+			if strings.HasPrefix(checkTag, "regexp=") {
+				rule, checkTag = checkTag, ""
+			} else {
+				rule, checkTag, _ = strings.Cut(checkTag, ",")
+			}
+
+			name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+
+			check, err := newCheck(name, arg, field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%w: field %s: %s", ErrInvalidCheck, field.Name, err.Error())
+			}
+
+			rules = append(rules, check)
+		}
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	run := func(val string) error {
+		for _, check := range rules {
+			if err := check(val); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return run, nil
+}
+
+// newCheck returns the check of the given name and argument for values of a field type.
+func newCheck(name, arg string, typ reflect.Type) (func(val string) error, error) {
+	switch name {
+	case "min", "max":
+		return boundCheck(name, arg, typ)
+	case "regexp":
+		expr, err := regexp.Compile(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(val string) error {
+			if !expr.MatchString(val) {
+				return fmt.Errorf("%w: %s does not match %s", ErrCheck, val, arg)
+			}
+
+			return nil
+		}, nil
+	case "file", "dir", "exists":
+		return func(val string) error { return checkPath(name, val) }, nil
+	case "url":
+		return checkURL, nil
+	}
+
+	checks.RLock()
+	custom := checks.funcs[name]
+	checks.RUnlock()
+
+	if custom == nil {
+		return nil, fmt.Errorf("unknown check %q", name)
+	}
+
+	return func(val string) error { return custom(val, arg) }, nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// boundCheck returns a min or max check, comparing numbers and durations by
+// value and strings by length. Values that cannot be parsed are left to the
+// conversion of the field, which reports a more accurate error.
+func boundCheck(name, arg string, typ reflect.Type) (func(val string) error, error) {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = typ.Elem()
+	}
+
+	var parse func(val string) (float64, error)
+
+	switch {
+	case typ == durationType:
+		parse = func(val string) (float64, error) {
+			d, err := time.ParseDuration(val)
+
+			return float64(d), err
+		}
+	case typ.Kind() >= reflect.Int && typ.Kind() <= reflect.Int64:
+		parse = func(val string) (float64, error) {
+			i, err := strconv.ParseInt(val, 0, 64)
+
+			return float64(i), err
+		}
+	case typ.Kind() >= reflect.Uint && typ.Kind() <= reflect.Uintptr:
+		parse = func(val string) (float64, error) {
+			u, err := strconv.ParseUint(val, 0, 64)
+
+			return float64(u), err
+		}
+	case typ.Kind() == reflect.Float32 || typ.Kind() == reflect.Float64:
+		parse = func(val string) (float64, error) { return strconv.ParseFloat(val, 64) }
+	case typ.Kind() == reflect.String:
+		return lengthCheck(name, arg)
+	default:
+		return nil, fmt.Errorf("%s check on %s values", name, typ)
+	}
+
+	bound, err := parse(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s bound %q for %s values", name, arg, typ)
+	}
+
+	check := func(val string) error {
+		num, err := parse(val)
+
+		switch {
+		case err != nil:
+			return nil
+		case name == "min" && num < bound:
+			return fmt.Errorf("%w: %s is less than %s", ErrCheck, val, arg)
+		case name == "max" && num > bound:
+			return fmt.Errorf("%w: %s is greater than %s", ErrCheck, val, arg)
+		}
+
+		return nil
+	}
+
+	return check, nil
+}
+
+// lengthCheck returns a min or max check on the number of characters of a value.
+func lengthCheck(name, arg string) (func(val string) error, error) {
+	bound, err := strconv.Atoi(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s length %q", name, arg)
+	}
+
+	check := func(val string) error {
+		length := utf8.RuneCountInString(val)
+
+		switch {
+		case name == "min" && length < bound:
+			return fmt.Errorf("%w: %s is shorter than %d characters", ErrCheck, val, bound)
+		case name == "max" && length > bound:
+			return fmt.Errorf("%w: %s is longer than %d characters", ErrCheck, val, bound)
+		}
+
+		return nil
+	}
+
+	return check, nil
+}
+
+// checkPath checks that a value is the path of an existing file, directory, or either.
+func checkPath(kind, val string) error {
+	info, err := os.Stat(val)
+
+	switch {
+	case err != nil:
+		return fmt.Errorf("%w: %s does not exist", ErrCheck, val)
+	case kind == "file" && info.IsDir():
+		return fmt.Errorf("%w: %s is a directory", ErrCheck, val)
+	case kind == "dir" && !info.IsDir():
+		return fmt.Errorf("%w: %s is not a directory", ErrCheck, val)
+	}
+
+	return nil
+}
+
+// checkURL checks that a value is an absolute URL.
+func checkURL(val string) error {
+	u, err := url.Parse(val)
+	if err != nil || u.Scheme == "" || (u.Host == "" && u.Opaque == "") {
+		return fmt.Errorf("%w: %s is not a valid URL", ErrCheck, val)
+	}
+
+	return nil
+}
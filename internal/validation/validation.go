@@ -2,9 +2,11 @@ package validation
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 
+	"github.com/reeflective/flags/internal/locale"
 	"github.com/reeflective/flags/internal/scan"
 )
 
@@ -21,18 +23,33 @@ type ValueValidator interface {
 }
 
 // Bind builds a validation function including all validation routines (builtin or user-defined) available.
-func Bind(value reflect.Value, field reflect.StructField, choices []string, opt scan.Opts) func(val string) error {
-	if opt.Validator == nil && len(choices) == 0 {
-		return nil
+// It returns an error if the `check` tags of the field are invalid.
+func Bind(value reflect.Value, field reflect.StructField, choices []string, opt scan.Opts) (func(val string) error, error) {
+	check, err := Checks(field)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Validator == nil && len(choices) == 0 && check == nil {
+		return nil, nil
 	}
 
+	insensitive := ChoiceInsensitive(field, opt)
+
 	validation := func(argValue string) error {
 		allValues := strings.Split(argValue, ",")
 
 		// The validation is performed on each individual item of a (potential) array
 		for _, val := range allValues {
 			if len(choices) > 0 {
-				if err := validateChoice(val, choices); err != nil {
+				if err := validateChoice(val, choices, insensitive); err != nil {
+					return err
+				}
+			}
+
+			// Builtin and registered checks of the field
+			if check != nil {
+				if err := check(val); err != nil {
 					return err
 				}
 			}
@@ -55,25 +72,108 @@ func Bind(value reflect.Value, field reflect.StructField, choices []string, opt
 		return nil
 	}
 
-	return validation
+	return validation, nil
+}
+
+// Normalizer returns a function replacing each value given to a field with its canonical
+// spelling: values written in the format of the locale set in options are rewritten in the
+// format expected by Go parsers, and (comma-separated) values are replaced with the spelling
+// of the choice they match, if choices are matched regardless of case. Values which cannot
+// be rewritten (eg. numbers with misplaced group separators) are errors.
+func Normalizer(field reflect.StructField, choices []string, opt scan.Opts) func(val string) (string, error) {
+	localize := locale.Normalizer(field.Type, opt.Locale)
+	choose := choiceNormalizer(field, choices, opt)
+
+	switch {
+	case localize == nil && choose == nil:
+		return nil
+	case localize == nil:
+		return func(val string) (string, error) { return choose(val), nil }
+	case choose == nil:
+		return localize
+	default:
+		return func(val string) (string, error) {
+			val, err := localize(val)
+
+			return choose(val), err
+		}
+	}
+}
+
+// NormalizedChoices returns the choices of a field as its values are normalized to, for help
+// and completions: if they are matched regardless of case, the choices only differing by case
+// from a previous one are removed, since the values matching them are spelled as the latter.
+func NormalizedChoices(choices []string, insensitive bool) []string {
+	if !insensitive {
+		return choices
+	}
+
+	normalized := make([]string, 0, len(choices))
+
+	for _, choice := range choices {
+		if !stringInSlice(choice, normalized, true) {
+			normalized = append(normalized, choice)
+		}
+	}
+
+	return normalized
+}
+
+// choiceNormalizer returns a function replacing each (comma-separated) value given to a field
+// with the spelling of the choice it matches, if choices are matched regardless of case.
+func choiceNormalizer(field reflect.StructField, choices []string, opt scan.Opts) func(val string) string {
+	if len(choices) == 0 || !ChoiceInsensitive(field, opt) {
+		return nil
+	}
+
+	normalize := func(argValue string) string {
+		values := strings.Split(argValue, ",")
+
+		for i, val := range values {
+			for _, choice := range choices {
+				if strings.EqualFold(val, choice) {
+					values[i] = choice
+
+					break
+				}
+			}
+		}
+
+		return strings.Join(values, ",")
+	}
+
+	return normalize
+}
+
+// ChoiceInsensitive returns true if the choices of a field are matched regardless
+// of case, either because of its `choice-case` tag, or because of global options.
+func ChoiceInsensitive(field reflect.StructField, opt scan.Opts) bool {
+	switch field.Tag.Get("choice-case") {
+	case "insensitive":
+		return true
+	case "sensitive":
+		return false
+	default:
+		return opt.ChoiceCaseInsensitive
+	}
 }
 
 // validateChoice checks the given value(s) is among valid choices.
-func validateChoice(val string, choices []string) error {
+func validateChoice(val string, choices []string, insensitive bool) error {
 	values := strings.Split(val, ",")
 
 	for _, value := range values {
-		if !stringInSlice(value, choices) {
-			return ErrInvalidChoice
+		if !stringInSlice(value, choices, insensitive) {
+			return fmt.Errorf("%w: %q (valid choices: %s)", ErrInvalidChoice, value, strings.Join(choices, ", "))
 		}
 	}
 
 	return nil
 }
 
-func stringInSlice(a string, list []string) bool {
+func stringInSlice(a string, list []string, insensitive bool) bool {
 	for _, b := range list {
-		if b == a {
+		if b == a || (insensitive && strings.EqualFold(a, b)) {
 			return true
 		}
 	}
@@ -0,0 +1,74 @@
+package flags
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/reeflective/flags/internal/tag"
+)
+
+// parseJSON returns a value for fields tagged with `flagtype:"json"`, which are
+// parsed as a single JSON document instead of being scanned for nested options.
+func parseJSON(value reflect.Value, mtag tag.MultiTag) Value {
+	if flagType, _ := mtag.Get("flagtype"); flagType != "json" || !value.CanAddr() {
+		return nil
+	}
+
+	return &jsonValue{value: value}
+}
+
+// parseTagged parses a field value, unless its tags require a specific value type.
+func parseTagged(value reflect.Value, mtag tag.MultiTag, optFuncs ...OptFunc) ([]*Flag, Value, error) {
+	if val := parseJSON(value, mtag); val != nil {
+		return nil, val, nil
+	}
+
+	return parseVal(value, optFuncs...)
+}
+
+// -- JSON Value.
+type jsonValue struct {
+	value reflect.Value
+}
+
+var (
+	_ Value  = (*jsonValue)(nil)
+	_ Getter = (*jsonValue)(nil)
+)
+
+// Set unmarshals a JSON document onto a fresh value: the document
+// replaces the field value, instead of being merged into it.
+func (v *jsonValue) Set(s string) error {
+	parsed := reflect.New(v.value.Type())
+
+	if err := json.Unmarshal([]byte(s), parsed.Interface()); err != nil {
+		return err
+	}
+
+	v.value.Set(parsed.Elem())
+
+	return nil
+}
+
+func (v *jsonValue) Get() interface{} {
+	if v != nil && v.value.IsValid() {
+		return v.value.Interface()
+	}
+
+	return nil
+}
+
+func (v *jsonValue) String() string {
+	if v == nil || !v.value.IsValid() || v.value.IsZero() {
+		return ""
+	}
+
+	data, err := json.Marshal(v.value.Interface())
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+func (v *jsonValue) Type() string { return "json" }
@@ -0,0 +1,165 @@
+package flags
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/reeflective/flags/internal/scan"
+	"github.com/reeflective/flags/internal/tag"
+)
+
+// kongTags converts the tags of a struct field declared for alecthomas/kong into
+// the tags of this library (see WithKongTags), and returns true if the field must
+// not be scanned (`kong:"-"`). Tags of this library on the field are left as is.
+func kongTags(field reflect.StructField, mtag *tag.MultiTag) bool {
+	if combined, isSet := mtag.Get("kong"); isSet {
+		if combined == "-" {
+			return true
+		}
+
+		for _, option := range kongOptions(combined) {
+			mtag.SetMany(option[0], append(mtag.GetMany(option[0]), option[1]))
+		}
+	}
+
+	if help, isSet := mtag.Get("help"); isSet {
+		if _, described := mtag.Get("description"); !described {
+			mtag.Set("description", help)
+		}
+	}
+
+	if aliases, isSet := mtag.Get("aliases"); isSet {
+		mtag.SetMany("alias", strings.Split(aliases, ","))
+	}
+
+	if enum, isSet := mtag.Get("enum"); isSet {
+		choices := strings.Split(enum, ",")
+		for i, choice := range choices {
+			choices[i] = strings.TrimSpace(choice)
+		}
+
+		mtag.Set("choice", strings.Join(choices, " "))
+	}
+
+	_, isCommand := mtag.Get("cmd")
+	_, isArg := mtag.Get("arg")
+	_, isEmbed := mtag.Get("embed")
+	_, isArgs := mtag.Get("positional-args")
+	_, isNative := mtag.Get("command")
+
+	name, named := mtag.Get("name")
+	if !named {
+		name = camelToFlag(field.Name, scan.DefaultFlagDivider)
+	}
+
+	switch {
+	case isArgs || isNative:
+		// Commands and positionals declared with the tags of this library.
+	case isCommand:
+		mtag.Set("command", name)
+	case isArg:
+		kongArg(mtag, name)
+	case isEmbed:
+		kongEmbed(mtag)
+	default:
+		kongFlag(field, mtag, name, named)
+	}
+
+	return false
+}
+
+// kongArg converts the tags of a positional argument, which
+// is required unless optional, or having a default value.
+func kongArg(mtag *tag.MultiTag, name string) {
+	mtag.Set("positional-arg", "yes")
+	mtag.Set("positional-arg-name", name)
+
+	_, optional := mtag.Get("optional")
+	_, defaulted := mtag.Get("default")
+
+	if !optional && !defaulted {
+		mtag.Set("required", "1")
+	}
+}
+
+// kongEmbed converts the tags of a struct embedded in a command: its options
+// are those of the command, with the prefixes of its `prefix` and `envprefix`.
+func kongEmbed(mtag *tag.MultiTag) {
+	if _, isGroup := mtag.Get("group"); !isGroup {
+		mtag.Set("group", "")
+	}
+
+	if prefix, _ := mtag.Get("prefix"); prefix != "" {
+		mtag.Set("namespace", prefix)
+		mtag.Set("namespace-delimiter", "")
+	}
+
+	if prefix, _ := mtag.Get("envprefix"); prefix != "" {
+		mtag.Set("env-namespace", prefix)
+		mtag.Set("env-namespace-delimiter", "")
+	}
+}
+
+// kongFlag converts the tags of an option. Options with a short name keep their
+// long one (named after the field if not set), and empty boolean tags are set.
+func kongFlag(field reflect.StructField, mtag *tag.MultiTag, name string, named bool) {
+	if _, hasShort := mtag.Get("short"); named || hasShort {
+		mtag.Set("long", name)
+	}
+
+	// Only structs are groups of options: those of kong are only shown in help.
+	if field.Type.Kind() != reflect.Struct {
+		mtag.Delete("group")
+	}
+
+	for _, key := range []string{"required", "hidden"} {
+		if value, isSet := mtag.Get(key); isSet && value == "" {
+			mtag.Set(key, "yes")
+		}
+	}
+
+	if _, isSet := mtag.Get("negatable"); isSet {
+		mtag.Set("negatable", "")
+	}
+}
+
+// kongOptions returns the key/value pairs of a `kong` tag, like `cmd,help='Run it.',name=run`.
+// Values may be quoted with single quotes (to include commas), and keys without value are empty.
+func kongOptions(combined string) [][2]string {
+	var options [][2]string
+
+	for combined != "" {
+		var option string
+
+		option, combined = kongOption(combined)
+		key, value, _ := strings.Cut(option, "=")
+
+		if len(value) > 1 && strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'") {
+			value = strings.ReplaceAll(value[1:len(value)-1], `\'`, "'")
+		}
+
+		if key = strings.TrimSpace(key); key != "" {
+			options = append(options, [2]string{key, value})
+		}
+	}
+
+	return options
+}
+
+// kongOption returns the first option of a `kong` tag, and the remaining ones.
+func kongOption(combined string) (string, string) {
+	quoted := false
+
+	for i := 0; i < len(combined); i++ {
+		switch {
+		case combined[i] == '\\' && quoted:
+			i++
+		case combined[i] == '\'':
+			quoted = !quoted
+		case combined[i] == ',' && !quoted:
+			return combined[:i], combined[i+1:]
+		}
+	}
+
+	return combined, ""
+}
@@ -0,0 +1,115 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type kongLogging struct {
+	Level string `help:"log level" enum:"debug,info" default:"info"`
+}
+
+type kongCommand struct {
+	Verbose bool     `short:"v" help:"verbose output" negatable:""`
+	Output  string   `name:"out" help:"output file" env:"KONG_OUTPUT" required:""`
+	Tags    []string `help:"tags" default:"a,b"`
+	Secret  string   `kong:"hidden,help='secret, or not'"`
+	Ignored string   `kong:"-"`
+	Set     string   `long:"set" description:"native option"`
+
+	Logging kongLogging `embed:"" prefix:"log-"`
+
+	Deploy struct {
+		Service string   `arg:"" help:"service to deploy"`
+		Hosts   []string `arg:"" optional:""`
+		Force   bool     `help:"force deployment"`
+	} `cmd:"" aliases:"d" help:"deploy a service"`
+}
+
+// TestKongTags checks that kong tags are converted into options with the same semantics.
+func TestKongTags(t *testing.T) {
+	t.Parallel()
+
+	data := &kongCommand{}
+
+	flagSet, err := ParseStruct(data, WithKongTags())
+	require.NoError(t, err)
+
+	options := map[string]*Flag{}
+	for _, flag := range flagSet {
+		options[flag.Name] = flag
+	}
+
+	require.Contains(t, options, "verbose")
+	assert.Equal(t, "v", options["verbose"].Short)
+	assert.False(t, options["verbose"].ShortOnly)
+	assert.Equal(t, "no-verbose", options["verbose"].Negation)
+	assert.Equal(t, "verbose output", options["verbose"].Usage)
+
+	require.Contains(t, options, "out")
+	assert.True(t, options["out"].Required)
+
+	isSet, err := options["out"].SetEnv(WithEnviron([]string{"KONG_OUTPUT=out.txt"}))
+	require.NoError(t, err)
+	assert.True(t, isSet)
+	assert.Equal(t, "out.txt", data.Output)
+
+	assert.Equal(t, []string{"a", "b"}, data.Tags)
+	require.NoError(t, options["tags"].Value.Set("c"))
+	assert.Equal(t, []string{"c"}, data.Tags, "values of the command-line should replace defaults")
+
+	require.Contains(t, options, "secret")
+	assert.True(t, options["secret"].Hidden)
+	assert.Equal(t, "secret, or not", options["secret"].Usage)
+	assert.NotContains(t, options, "ignored")
+	assert.Contains(t, options, "set")
+
+	require.Contains(t, options, "log-level")
+	assert.Equal(t, []string{"debug", "info"}, options["log-level"].Choices)
+	assert.Equal(t, "info", data.Logging.Level)
+
+	flagSet, err = ParseStruct(&kongCommand{})
+	require.NoError(t, err)
+
+	for _, flag := range flagSet {
+		assert.NotEqual(t, "out", flag.Name, "kong tags should only be converted when enabled")
+	}
+}
+
+// TestKongCommands checks that kong commands and arguments are part of the command model.
+func TestKongCommands(t *testing.T) {
+	t.Parallel()
+
+	root, err := Scan(&kongCommand{}, WithKongTags())
+	require.NoError(t, err)
+	require.Len(t, root.Subcommands, 1)
+
+	deploy := root.Subcommands[0]
+	assert.Equal(t, "deploy", deploy.Name)
+	assert.Equal(t, []string{"d"}, deploy.Aliases)
+	assert.Equal(t, "deploy a service", deploy.Description)
+
+	require.Len(t, deploy.Flags, 1)
+	assert.Equal(t, "force", deploy.Flags[0].Name)
+
+	require.Len(t, deploy.Positionals, 2)
+	assert.Equal(t, "service", deploy.Positionals[0].Name)
+	assert.Equal(t, "service to deploy", deploy.Positionals[0].Usage)
+	assert.Equal(t, 1, deploy.Positionals[0].Minimum)
+	assert.Equal(t, "hosts", deploy.Positionals[1].Name)
+	assert.Equal(t, 0, deploy.Positionals[1].Minimum)
+}
+
+// TestKongOptions checks the parsing of combined kong tags.
+func TestKongOptions(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, [][2]string{
+		{"cmd", ""},
+		{"help", "Run it, now."},
+		{"name", "run"},
+		{"placeholder", "it's"},
+	}, kongOptions(`cmd,help='Run it, now.',name=run,placeholder='it\'s'`))
+}
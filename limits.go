@@ -0,0 +1,177 @@
+package flags
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/reeflective/flags/internal/convert"
+	"github.com/reeflective/flags/internal/scan"
+	"github.com/reeflective/flags/internal/tag"
+)
+
+// CheckArgs checks that a command-line does not exceed the number of words and the
+// length of each word allowed by the MaxArgs and MaxArgLength options, if any.
+// Parsing entrypoints call it before parsing: it only needs to be called by
+// applications handing their command-lines to other parsers.
+func CheckArgs(args []string, optFuncs ...OptFunc) error {
+	return checkArgs(args, ScanOptions(optFuncs...))
+}
+
+func checkArgs(args []string, opts scan.Opts) error {
+	if opts.MaxArgs > 0 && len(args) > opts.MaxArgs {
+		return fmt.Errorf("%w: %d words (max %d)", ErrTooManyArgs, len(args), opts.MaxArgs)
+	}
+
+	if opts.MaxArgLength == 0 {
+		return nil
+	}
+
+	for i, arg := range args {
+		if len(arg) > opts.MaxArgLength {
+			return fmt.Errorf("%w: word %d is %d bytes long (max %d)", ErrArgTooLong, i+1, len(arg), opts.MaxArgLength)
+		}
+	}
+
+	return nil
+}
+
+// limitedValue rejects values longer than allowed, and values
+// making its list field accumulate more elements than allowed.
+type limitedValue struct {
+	Value
+	field       reflect.Value
+	mtag        tag.MultiTag
+	maxLength   int
+	maxElements int
+	changed     bool
+}
+
+// newLimitedValue wraps a flag value with the limits set in options, if any applies.
+func newLimitedValue(val Value, field reflect.Value, mtag tag.MultiTag, opts scan.Opts) Value {
+	field = reflect.Indirect(field)
+
+	maxElements := opts.MaxElements
+	if field.Kind() != reflect.Slice && field.Kind() != reflect.Map {
+		maxElements = 0
+	}
+
+	if opts.MaxArgLength == 0 && maxElements == 0 {
+		return val
+	}
+
+	return &limitedValue{
+		Value:       val,
+		field:       field,
+		mtag:        mtag,
+		maxLength:   opts.MaxArgLength,
+		maxElements: maxElements,
+	}
+}
+
+func (v *limitedValue) IsBoolFlag() bool {
+	if boolFlag, casted := v.Value.(BoolFlag); casted {
+		return boolFlag.IsBoolFlag()
+	}
+
+	return false
+}
+
+func (v *limitedValue) IsCumulative() bool {
+	if cumulativeFlag, casted := v.Value.(RepeatableFlag); casted {
+		return cumulativeFlag.IsCumulative()
+	}
+
+	return false
+}
+
+func (v *limitedValue) Reset() {
+	v.changed = false
+
+	if resetter, casted := v.Value.(Resetter); casted {
+		resetter.Reset()
+	}
+}
+
+func (v *limitedValue) Get() interface{} {
+	if getter, casted := v.Value.(Getter); casted {
+		return getter.Get()
+	}
+
+	return nil
+}
+
+func (v *limitedValue) Set(val string) error {
+	if v.maxLength > 0 && len(val) > v.maxLength {
+		return fmt.Errorf("%w: value is %d bytes long (max %d)", ErrArgTooLong, len(val), v.maxLength)
+	}
+
+	if v.maxElements == 0 {
+		return v.Value.Set(val)
+	}
+
+	count, counted, err := v.count(val)
+	if err != nil {
+		return err
+	}
+
+	if counted && count > v.maxElements {
+		return fmt.Errorf("%w: %d elements (max %d)", ErrTooManyElements, count, v.maxElements)
+	}
+
+	// Maps and slices are updated in place: when the elements of the value could
+	// not be counted beforehand, we keep a copy of their elements to restore them.
+	var old reflect.Value
+	if !counted {
+		old = convert.Copy(v.field)
+	}
+
+	if err := v.Value.Set(val); err != nil {
+		return err
+	}
+
+	v.changed = true
+
+	if count := v.field.Len(); !counted && count > v.maxElements {
+		v.field.Set(old)
+
+		return fmt.Errorf("%w: %d elements (max %d)", ErrTooManyElements, count, v.maxElements)
+	}
+
+	return nil
+}
+
+// count returns the number of elements the list field would have once the value set,
+// by parsing it apart with the builtin value of the field type, and false if it has none.
+// Slices are replaced by the first value set, and maps keep the keys they already have.
+func (v *limitedValue) count(val string) (int, bool, error) {
+	elems := reflect.New(v.field.Type())
+
+	parsed, err := NewValue(elems.Interface())
+	if err != nil {
+		return 0, false, nil
+	}
+
+	withLayout(parsed, v.mtag)
+
+	if err := parsed.Set(val); err != nil {
+		return 0, false, err
+	}
+
+	elems = elems.Elem()
+
+	switch {
+	case v.field.Kind() == reflect.Map:
+		count := v.field.Len()
+		for _, key := range elems.MapKeys() {
+			if !v.field.MapIndex(key).IsValid() {
+				count++
+			}
+		}
+
+		return count, true, nil
+	case v.changed:
+		return v.field.Len() + elems.Len(), true, nil
+	default:
+		return elems.Len(), true, nil
+	}
+}
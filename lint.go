@@ -0,0 +1,220 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Issue is a problem found in a command tree by Lint.
+type Issue struct {
+	Command []string // Path of the command (root excluded)
+	Element string   // The option (--name) or positional (<name>), empty for the command itself
+	Message string   // What is wrong
+}
+
+// String returns the issue as a single line, prefixed with its command and element.
+func (i Issue) String() string {
+	where := commandLabel(i.Command)
+
+	if i.Element != "" {
+		where += ": " + i.Element
+	}
+
+	return where + ": " + i.Message
+}
+
+// secretWords are the words identifying options holding secrets, in their names.
+var secretWords = []string{"password", "passwd", "secret", "token", "api-key", "private-key", "credential"}
+
+// Lint performs static checks on a whole command tree, beyond those needed to generate it,
+// and returns the issues found, in declaration order (those involving several commands last).
+// It is meant to run in the tests of the application itself, eg. as in
+// `assert.Empty(t, flags.Lint(&rootData))`. Lint reports:
+//   - Unreachable commands: those whose name (or alias) is already used by a sibling command,
+//     and those which cannot run (not implementing Commander, CommanderContext, Runner or RunnerE)
+//     and have no subcommands.
+//   - Aliases generated with `alias-styles` tags which were dropped, because they are already
+//     used by sibling commands, or are reserved (see SubcommandAliases).
+//   - Options shadowing a persistent option (name or short name) of one of their parents.
+//   - Positionals with impossible ranges: a minimum greater than their maximum, or optional
+//     ones after a positional taking all remaining words (they never receive any).
+//   - Options holding secrets (passwords, tokens, keys) with default values, shown in help.
+//   - Visible commands, options and positionals without descriptions.
+//
+// Structs which cannot be walked return a single issue with the error.
+func Lint(data interface{}, optFuncs ...OptFunc) []Issue {
+	lint := &linter{
+		flags:      map[*Command][]*Flag{},
+		persistent: map[*Command][]*Flag{},
+		names:      map[*Command]map[string]string{},
+		children:   map[*Command]int{},
+	}
+
+	visitor := VisitorFuncs{
+		Command:    lint.command,
+		Flag:       lint.flag,
+		Positional: lint.positional,
+	}
+
+	if err := Walk(data, visitor, optFuncs...); err != nil {
+		return []Issue{{Message: err.Error()}}
+	}
+
+	lint.shadowed()
+	lint.unrunnable()
+
+	return lint.issues
+}
+
+// linter holds the elements of a command tree needed by checks spanning several commands.
+type linter struct {
+	issues     []Issue
+	commands   []*Command
+	flags      map[*Command][]*Flag
+	persistent map[*Command][]*Flag
+	names      map[*Command]map[string]string // Subcommand names and aliases, by parent
+	children   map[*Command]int
+	unbounded  *Positional // The last positional taking all remaining words, if any
+}
+
+func (l *linter) report(cmd *Command, element, format string, args ...interface{}) {
+	l.issues = append(l.issues, Issue{
+		Command: cmd.Path,
+		Element: element,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) command(cmd *Command) error {
+	l.commands = append(l.commands, cmd)
+	l.unbounded = nil
+
+	for _, conflict := range cmd.conflicts {
+		path := append(append([]string{}, cmd.Path...), conflict.Command)
+		l.issues = append(l.issues, Issue{Command: path, Message: conflict.String()})
+	}
+
+	if cmd.Parent == nil {
+		return nil
+	}
+
+	l.children[cmd.Parent]++
+
+	if l.names[cmd.Parent] == nil {
+		l.names[cmd.Parent] = map[string]string{}
+	}
+
+	for _, name := range append([]string{cmd.Name}, cmd.Aliases...) {
+		if other, used := l.names[cmd.Parent][name]; used {
+			l.report(cmd, "", "unreachable: %q is already used by command %q", name, other)
+		} else {
+			l.names[cmd.Parent][name] = cmd.Name
+		}
+	}
+
+	if cmd.Description == "" && !cmd.Hidden {
+		l.report(cmd, "", "no description")
+	}
+
+	return nil
+}
+
+func (l *linter) flag(cmd *Command, grp *Group, flag *Flag) error {
+	// Options only set from the environment are not on command-lines.
+	if flag.EnvOnly {
+		return nil
+	}
+
+	l.flags[cmd] = append(l.flags[cmd], flag)
+
+	if grp != nil && grp.Persistent {
+		l.persistent[cmd] = append(l.persistent[cmd], flag)
+	}
+
+	element := "--" + flag.Name
+
+	if flag.Usage == "" && !flag.Hidden {
+		l.report(cmd, element, "no description")
+	}
+
+	if isSecret(flag) && len(flag.DefValue) > 0 {
+		l.report(cmd, element, "secret option with a default value, shown in help usages")
+	}
+
+	return nil
+}
+
+func (l *linter) positional(cmd *Command, arg *Positional) error {
+	element := "<" + arg.Name + ">"
+
+	if arg.Maximum != -1 && arg.Minimum > arg.Maximum {
+		l.report(cmd, element, "impossible range: minimum %d is greater than maximum %d", arg.Minimum, arg.Maximum)
+	}
+
+	if l.unbounded != nil && arg.Minimum == 0 {
+		l.report(cmd, element, "never receives words: <%s> takes all remaining ones", l.unbounded.Name)
+	}
+
+	if arg.Maximum == -1 {
+		l.unbounded = arg
+	}
+
+	if arg.Usage == "" {
+		l.report(cmd, element, "no description")
+	}
+
+	return nil
+}
+
+// shadowed reports the options having the name or short name of a persistent option of a parent.
+func (l *linter) shadowed() {
+	for _, cmd := range l.commands {
+		for _, flag := range l.flags[cmd] {
+			for parent := cmd.Parent; parent != nil; parent = parent.Parent {
+				for _, inherited := range l.persistent[parent] {
+					if inherited.Name == flag.Name || (flag.Short != "" && inherited.Short == flag.Short) {
+						l.report(cmd, "--"+flag.Name, "shadows persistent option --%s of %s",
+							inherited.Name, commandLabel(parent.Path))
+					}
+				}
+			}
+		}
+	}
+}
+
+// unrunnable reports the commands without subcommands which cannot be executed.
+func (l *linter) unrunnable() {
+	for _, cmd := range l.commands {
+		if cmd.Parent == nil || l.children[cmd] > 0 {
+			continue
+		}
+
+		switch cmd.Data.(type) {
+		case Commander, CommanderContext, Runner, RunnerE:
+		default:
+			l.report(cmd, "", "unreachable: no subcommands, and not implementing Commander, CommanderContext, Runner or RunnerE")
+		}
+	}
+}
+
+// commandLabel returns how a command is designated in issues.
+func commandLabel(path []string) string {
+	if len(path) == 0 {
+		return "root command"
+	}
+
+	return "command " + strings.Join(path, " ")
+}
+
+// isSecret returns true if the name of an option designates a secret.
+func isSecret(flag *Flag) bool {
+	name := strings.ToLower(flag.Name)
+
+	for _, word := range secretWords {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+
+	return false
+}
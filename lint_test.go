@@ -0,0 +1,62 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// lintCommand is a runnable command for lint tests.
+type lintCommand struct {
+	Force bool `long:"force" short:"f" description:"force"`
+}
+
+func (c *lintCommand) Execute(args []string) error { return nil }
+
+type lintedRoot struct {
+	Global struct {
+		Verbose bool `long:"verbose" short:"v" description:"verbose output"`
+	} `group:"global" persistent:"yes"`
+
+	Token string `long:"api-token" description:"API token"`
+
+	Add lintCommand `command:"add" description:"add an item"`
+	Set struct {
+		Verbose bool   `long:"loud" short:"v" description:"loud output"`
+		Value   string `long:"value"`
+		Args    struct {
+			Files []string `description:"files"`
+			Last  string   `description:"last"`
+			Range []string `description:"range" required:"3-2"`
+		} `positional-args:"yes"`
+	} `command:"set" alias:"add"`
+	Empty struct{} `command:"empty" description:"does nothing"`
+}
+
+// TestLint checks that all kinds of issues are found in a command tree.
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	root := lintedRoot{Token: "secret"}
+
+	var found []string
+	for _, issue := range Lint(&root) {
+		found = append(found, issue.String())
+	}
+
+	assert.Equal(t, []string{
+		"root command: --api-token: secret option with a default value, shown in help usages",
+		`command set: unreachable: "add" is already used by command "add"`,
+		"command set: no description",
+		"command set: --value: no description",
+		"command set: <Last>: never receives words: <Files> takes all remaining ones",
+		"command set: <Range>: impossible range: minimum 3 is greater than maximum 2",
+		"command set: --loud: shadows persistent option --verbose of root command",
+		"command set: unreachable: no subcommands, and not implementing Commander, CommanderContext, Runner or RunnerE",
+		"command empty: unreachable: no subcommands, and not implementing Commander, CommanderContext, Runner or RunnerE",
+	}, found)
+
+	assert.Empty(t, Lint(&struct {
+		Add lintCommand `command:"add" description:"add an item"`
+	}{}))
+}
@@ -1,7 +1,32 @@
 package flags
 
 import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/reeflective/flags/internal/color"
 	"github.com/reeflective/flags/internal/scan"
+	"github.com/reeflective/flags/internal/tag"
+	"github.com/reeflective/flags/internal/terminal"
+	"github.com/reeflective/flags/internal/validation"
+	"golang.org/x/text/language"
+)
+
+const (
+	// ModeCLI is the mode of a one-shot, command-line application.
+	ModeCLI = "cli"
+
+	// ModeREPL is the mode of an interactive console application,
+	// where the command tree is executed in a closed loop.
+	ModeREPL = "repl"
+)
+
+// Color modes, for WithColors.
+const (
+	ColorAuto   = color.Auto   // Colors depend on the environment of the user
+	ColorAlways = color.Always // Colors are always used
+	ColorNever  = color.Never  // Colors are never used
 )
 
 // ValidateFunc describes a validation func, that takes string val for flag from command line,
@@ -20,6 +45,22 @@ type OptFunc scan.OptFunc
 
 type opts scan.Opts
 
+// ScanOptions returns the scan options resulting from a list of option functions.
+// Generators use it to scan their structs like the other ones of this library.
+func ScanOptions(optFuncs ...OptFunc) scan.Opts {
+	return scan.DefOpts().Apply(ScanOptFuncs(optFuncs...)...)
+}
+
+// ScanOptFuncs converts option functions into those used by the scanning packages.
+func ScanOptFuncs(optFuncs ...OptFunc) []scan.OptFunc {
+	scanOpts := make([]scan.OptFunc, len(optFuncs))
+	for i, optFunc := range optFuncs {
+		scanOpts[i] = scan.OptFunc(optFunc)
+	}
+
+	return scanOpts
+}
+
 // DescTag sets custom description tag. It is "desc" by default.
 func DescTag(val string) OptFunc { return func(opt *scan.Opts) { opt.DescTag = val } }
 
@@ -47,14 +88,281 @@ func Flatten(val bool) OptFunc { return func(opt *scan.Opts) { opt.Flatten = val
 // even if there isn't a struct tag attached to them.
 func ParseAll() OptFunc { return func(opt *scan.Opts) { opt.ParseAll = true } }
 
+// ChoiceCaseInsensitive makes all flags/positionals with choices to match them regardless
+// of case, normalizing the value to the spelling of the choice. This can also be set per
+// field with the `choice-case:"insensitive"` tag (or `choice-case:"sensitive"` to opt out).
+func ChoiceCaseInsensitive() OptFunc {
+	return func(opt *scan.Opts) { opt.ChoiceCaseInsensitive = true }
+}
+
+// WithLocale makes number (int, uint and float) and time.Duration flags/positionals to also
+// accept values written in the conventions of the given language, like `1.234,56` for a float
+// or `1,5 Stunden` for a duration in German. Slices and maps are not affected, since their own
+// comma separator would conflict with decimal ones. This is meant for end-user-facing tools.
+func WithLocale(lang language.Tag) OptFunc {
+	return func(opt *scan.Opts) { opt.Locale = lang }
+}
+
+// WithEnviron makes env-tagged fields to be read from a snapshot of environment variables,
+// in the "key=value" format of os.Environ(), instead of the process environment. This is
+// useful for deterministic tests, or to evaluate the environment of a remote client.
+// The snapshot is indexed once, and its lookups are shared by all options of the parser.
+func WithEnviron(env []string) OptFunc {
+	environ := scan.NewEnviron(env)
+
+	return func(opt *scan.Opts) { opt.Environ, opt.EnvLookup = environ, nil }
+}
+
+// WithEnvLookup makes env-tagged fields to be read with a lookup function (with the same
+// semantics as os.LookupEnv) instead of from the process environment, so that their values
+// can come from a secrets manager, a configuration service or a test map. Like WithEnviron,
+// this applies to all options of the parser: if both are given, the last one is used.
+func WithEnvLookup(lookup func(key string) (string, bool)) OptFunc {
+	return func(opt *scan.Opts) { opt.Environ, opt.EnvLookup = nil, lookup }
+}
+
+// PlusToggles makes words made of a `+` followed by the short names of boolean flags
+// (eg. `+x` or `+xv`) to set these flags to false, as the opposite of `-x`/`-xv`.
+// This is the convention used by tools like `set` or `xterm`. Such words are only
+// recognized among the arguments of a command, not before the name of a subcommand,
+// and they are also completed when the word being completed starts with a `+`.
+func PlusToggles() OptFunc {
+	return func(opt *scan.Opts) { opt.PlusToggles = true }
+}
+
+// IsToggle returns true if the word is made of a `+` followed only by short names
+// of boolean flags, as reported by isBool, and can thus be used with PlusToggles.
+func IsToggle(word string, isBool func(short string) bool) bool {
+	if len(word) < 2 || !strings.HasPrefix(word, "+") {
+		return false
+	}
+
+	for _, short := range word[1:] {
+		if len(string(short)) != 1 || !isBool(string(short)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ShowFlagAliases makes the aliases of options (their other long names, given with
+// the `alias` tag) to be shown in help usages and completions. By default, only the
+// canonical name of an option is shown, while its aliases are accepted but hidden.
+func ShowFlagAliases() OptFunc {
+	return func(opt *scan.Opts) { opt.ShowAliases = true }
+}
+
+// WithColors overrides whether the output of generators (completions, help usages) may be
+// colored: with ColorAlways or ColorNever, regardless of the environment of the user, or
+// with ColorAuto (the default) from its NO_COLOR, CLICOLOR, CLICOLOR_FORCE and TERM variables.
+func WithColors(mode string) OptFunc {
+	return func(opt *scan.Opts) { opt.Colors = mode }
+}
+
+// ColorsEnabled returns true if output may be colored with the given options, either
+// forced with WithColors, or depending on the environment of the user (as read by
+// the options, eg. WithEnviron) otherwise. Generators consult it for all their output.
+func ColorsEnabled(optFuncs ...OptFunc) bool {
+	opts := ScanOptions(optFuncs...)
+
+	return color.Enabled(opts.Colors, opts.LookupEnv)
+}
+
+// WithHelpWidth overrides the width (in columns) to which generators wrap the descriptions of
+// options and positionals in help usages, aligned in their column. By default, it is the one
+// of the COLUMNS variable if set, or of the terminal of the standard output if any (otherwise
+// descriptions are not wrapped). A negative width disables wrapping.
+func WithHelpWidth(columns int) OptFunc {
+	return func(opt *scan.Opts) { opt.HelpWidth = columns }
+}
+
+// HelpWidth returns the width to which help usages are wrapped with the given options,
+// either set with WithHelpWidth, or detected otherwise, or 0 if they are not wrapped.
+func HelpWidth(optFuncs ...OptFunc) int {
+	opts := ScanOptions(optFuncs...)
+
+	return terminal.Width(opts.HelpWidth, opts.LookupEnv)
+}
+
+// CollectUnknownFlags makes unknown `--key value` flags given to a command not to be
+// errors, but to be collected into the `map[string]string` field of the command struct
+// tagged with `unknown:""`. This is useful for proxy/wrapper programs forwarding some
+// options downstream. Commands without such a field still reject unknown flags.
+func CollectUnknownFlags() OptFunc {
+	return func(opt *scan.Opts) { opt.CollectUnknownFlags = true }
+}
+
+// WithCompletionInstall makes the completions generator add a hidden `install` subcommand
+// to the completion command of cobra, which writes the completion script of the shell of
+// the user (or of the one given as argument) where the shell loads it from, and prints how
+// to activate it if needed. Bash, zsh and fish are supported.
+func WithCompletionInstall() OptFunc {
+	return func(opt *scan.Opts) { opt.CompletionInstall = true }
+}
+
+// WithPlugins makes generators add a subcommand for each executable named after the root
+// command and a subcommand name (eg. `app-deploy` for `app deploy`), found in directories
+// (by default those of PATH). Its arguments are passed to the executable as they are, and
+// it is completed by running the executable with the completion protocol of carapace (the
+// `_carapace export` hidden command, which applications generated with this library have).
+// Commands declared in structs, and reserved names, take precedence over plugins.
+func WithPlugins(dirs ...string) OptFunc {
+	return func(opt *scan.Opts) {
+		opt.Plugins = true
+		opt.PluginDirs = dirs
+	}
+}
+
+// WithSignalCancel makes generated commands run with a context canceled when the program
+// receives one of the signals (os.Interrupt by default), so that commands implementing
+// CommanderContext can stop gracefully. Once canceled, signals are handled as usual again:
+// another one (eg. a second Ctrl-C) terminates the program. Pre-runners run before it.
+func WithSignalCancel(signals ...os.Signal) OptFunc {
+	return func(opt *scan.Opts) {
+		opt.SignalCancel = true
+		opt.CancelSignals = signals
+	}
+}
+
+// WithShutdown sets, along with WithSignalCancel, the notice printed on the error output
+// of commands when their context is canceled by a signal (none if empty), and how long
+// they have to return once canceled (0: unlimited). If a command does not return in time,
+// an ErrShutdownTimeout error is printed and the program exits with status 1.
+func WithShutdown(notice string, timeout time.Duration) OptFunc {
+	return func(opt *scan.Opts) {
+		opt.ShutdownNotice = notice
+		opt.ShutdownTimeout = timeout
+	}
+}
+
+// WithMode sets the execution frontend for which commands, groups, options and positionals
+// are generated (either ModeCLI or ModeREPL): those tagged with another `mode` are ignored.
+// In ModeREPL, generators also hide their builtin help and completion commands/flags, which
+// are generally not relevant in an embedded console. By default, all fields are generated.
+func WithMode(mode string) OptFunc {
+	return func(opt *scan.Opts) { opt.Mode = mode }
+}
+
+// InMode returns true if a struct field is to be generated for the mode set in options:
+// fields with no `mode` tag are always used, as are all fields when no mode is set.
+func InMode(mtag tag.MultiTag, optFuncs ...OptFunc) bool {
+	mode, isSet := mtag.Get("mode")
+	current := ScanOptions(optFuncs...).Mode
+
+	return !isSet || current == "" || mode == current
+}
+
+// WithReservedNames replaces the list of command names reserved for internal commands, which
+// user commands (and their aliases) cannot use: names ending with a `*` reserve a prefix.
+// By default, these are the shell completion commands of cobra and carapace, and the `__`
+// prefix for future internals: applications needing this prefix for their own commands
+// can reserve only the exact names instead, eg. WithReservedNames("__complete", ...).
+func WithReservedNames(names ...string) OptFunc {
+	return func(opt *scan.Opts) { opt.ReservedNames = names }
+}
+
+// WithKongTags makes the struct tags of alecthomas/kong usable along with those of this
+// library, for projects migrating from it: `cmd`, `arg` (required unless `optional`), `help`,
+// `enum`, `default` (set on empty fields), `env`, `required`, `name`, `short`, `aliases`,
+// `hidden`, `negatable` (with the `no-` prefix), `embed` (with its `prefix` and `envprefix`),
+// and their combined `kong:"..."` form. Fields tagged `kong:"-"` are ignored. As with other
+// tags, fields without any tag are not options, and positionals are all the fields of the
+// command struct tagged `arg` (see the `positional-arg` tag).
+func WithKongTags() OptFunc {
+	return func(opt *scan.Opts) {
+		opt.TagFunc = kongTags
+		opt.TagDefaults = true
+	}
+}
+
+// WithTagDefaults makes the `default` tags of options set their fields when these are empty,
+// as jessevdk/go-flags does, instead of only being displayed in help usages: the values of
+// options given on the command-line replace these defaults, even for slices and maps.
+func WithTagDefaults() OptFunc {
+	return func(opt *scan.Opts) { opt.TagDefaults = true }
+}
+
+// ReservedNames returns the command names (or prefixes, ending with `*`) reserved for
+// internal commands with the given options, which is DefaultReservedNames by default.
+func ReservedNames(optFuncs ...OptFunc) []string {
+	return append([]string{}, ScanOptions(optFuncs...).ReservedNames...)
+}
+
+// IsReserved returns true if a command name is reserved for internal commands.
+func IsReserved(name string, optFuncs ...OptFunc) bool {
+	for _, reserved := range ScanOptions(optFuncs...).ReservedNames {
+		if strings.HasSuffix(reserved, "*") && strings.HasPrefix(name, strings.TrimSuffix(reserved, "*")) {
+			return true
+		}
+
+		if name == reserved {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MaxArgs limits the number of words a command-line can be made of, including the
+// command names and flags. Longer command-lines are rejected with ErrTooManyArgs
+// before being parsed. This protects applications parsing command-lines from
+// untrusted sources (eg. remote execution) from pathological inputs.
+func MaxArgs(max int) OptFunc {
+	return func(opt *scan.Opts) { opt.MaxArgs = max }
+}
+
+// MaxArgLength limits the length (in bytes) of each word of a command-line,
+// and of each value given to a flag. Longer ones are rejected with ErrArgTooLong.
+func MaxArgLength(max int) OptFunc {
+	return func(opt *scan.Opts) { opt.MaxArgLength = max }
+}
+
+// MaxElements limits the number of elements slice and map flags can accumulate,
+// whether given in a single value or repeated: values exceeding this limit are
+// rejected with ErrTooManyElements. Positional lists are bounded by MaxArgs.
+func MaxElements(max int) OptFunc {
+	return func(opt *scan.Opts) { opt.MaxElements = max }
+}
+
 // Validator sets validator function for flags.
 // Check existing validators in flags/validator and flags/validator/govalidator packages.
 func Validator(val ValidateFunc) OptFunc {
 	return func(opt *scan.Opts) { opt.Validator = scan.ValidateFunc(val) }
 }
 
+// RegisterCheck registers a check usable by name in the `check` tags of options and
+// positionals, along with the builtin ones (min, max, regexp, file, dir, exists, url).
+// The check is called with each value given to the field, and with the argument of the
+// check, if any (ex: "3" in `check:"multiple=3"`). Checks must be registered before
+// scanning the structs using them: RegisterCheck panics if the name is already taken.
+func RegisterCheck(name string, check func(value, arg string) error) {
+	validation.RegisterCheck(name, check)
+}
+
 // FlagHandler sets the handler function for flags, in order to perform arbitrary
 // operations on the value of the flag identified by the <flag> name parameter of FlagFunc.
 func FlagHandler(val FlagFunc) OptFunc {
 	return func(opt *scan.Opts) { opt.FlagFunc = scan.FlagFunc(val) }
 }
+
+// OnSet registers a hook to be called each time the struct field pointed
+// to by field is set while parsing the command-line, with the previous and
+// the new value of the field. This is useful for reactive behavior, like a
+// --verbose flag reconfiguring a logger before the command is executed.
+// The hook is only called when the value of the field has actually changed.
+func OnSet[T any](field *T, hook func(old, new T)) OptFunc {
+	return func(opt *scan.Opts) {
+		if field == nil || hook == nil {
+			return
+		}
+
+		if opt.Hooks == nil {
+			opt.Hooks = map[interface{}]scan.SetHook{}
+		}
+
+		opt.Hooks[field] = func(old, new interface{}) {
+			hook(old.(T), new.(T))
+		}
+	}
+}
@@ -3,6 +3,7 @@ package flags
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/reeflective/flags/internal/scan"
@@ -52,7 +53,7 @@ func ParseField(value reflect.Value, field reflect.StructField, optFuncs ...OptF
 	options := OptFunc(scan.CopyOpts(scanOpts))
 
 	// We might have to scan for an arbitrarily nested structure of flags
-	flagSet, val, err := parseVal(value, options)
+	flagSet, val, err := parseTagged(value, *tag, options)
 	if err != nil {
 		return flagSet, true, err
 	}
@@ -72,23 +73,78 @@ func ParseField(value reflect.Value, field reflect.StructField, optFuncs ...OptF
 		return flagSet, true, nil
 	}
 
+	withLayout(val, *tag)
+
+	if val, err = withCounterTags(val, *tag); err != nil {
+		return flagSet, true, err
+	}
+
+	// Tags converted from other libraries might set empty fields to their default values.
+	defaulted := scanOpts.TagDefaults && value.IsZero() && len(flag.DefValue) > 0
+	if defaulted {
+		if err := setTagDefaults(value, *tag, options); err != nil {
+			return flagSet, true, fmt.Errorf("%w: default value of %s: %s", ErrInvalidTag, flag.Name, err.Error())
+		}
+	}
+
+	if boolFlag, isBool := val.(BoolFlag); flag.Negation != "" && (!isBool || !boolFlag.IsBoolFlag()) {
+		return flagSet, true, fmt.Errorf("%w: negatable flag %s is not a boolean", ErrInvalidTag, flag.Name)
+	}
+
+	if flag.Interpolate && !isStringType(value.Type()) {
+		return flagSet, true, fmt.Errorf("%w: interpolated flag %s is not a string", ErrInvalidTag, flag.Name)
+	}
+
 	// Set validators if any, user-defined or builtin
-	if validator := validation.Bind(value, field, flag.Choices, scanOpts); validator != nil {
+	validator, err := validation.Bind(value, field, flag.Choices, scanOpts)
+	if err != nil {
+		return flagSet, true, fmt.Errorf("%w: %s", ErrInvalidTag, err.Error())
+	}
+
+	normalizer := validation.Normalizer(field, flag.Choices, scanOpts)
+	flag.Choices = validation.NormalizedChoices(flag.Choices, validation.ChoiceInsensitive(field, scanOpts))
+
+	if validator != nil || normalizer != nil {
 		val = &validateValue{
 			Value:        val,
 			validateFunc: validator,
+			normalize:    normalizer,
 		}
 	}
 
+	// Reject values exceeding the limits of the command-line, if any.
+	val = newLimitedValue(val, value, *tag, scanOpts)
+
+	// Notify any hook registered for this field when its value changes.
+	if hook := scanOpts.Hook(value); hook != nil {
+		val = &hookedValue{
+			Value: val,
+			field: value,
+			hook:  hook,
+		}
+	}
+
+	// Keep the errors of the value for generators (pflag discards them).
+	val = newErroredValue(val, flag.Name)
+
 	flag.Value = val
+
+	if value.CanAddr() && value.Addr().CanInterface() {
+		flag.Field = value.Addr().Interface()
+	}
+
 	flagSet = append(flagSet, flag)
 
 	// The default value, if set through tags, is always
 	// overridden by the current value of the field.
-	if val.String() != "" {
+	if val.String() != "" && !defaulted {
 		flag.DefValue = append(flag.DefValue, val.String())
 	}
 
+	if err := parseSource(flag, *tag, scanOpts); err != nil {
+		return flagSet, true, err
+	}
+
 	// If the user provided some custom flag
 	// value handlers/scanners, run on it.
 	if scanOpts.FlagFunc != nil {
@@ -108,12 +164,18 @@ func ParseField(value reflect.Value, field reflect.StructField, optFuncs ...OptF
 	return flagSet, true, nil
 }
 
+// isStringType returns true if the type is a string, or a pointer to one.
+func isStringType(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	return typ.Kind() == reflect.String
+}
+
 // parseInfo parses the struct field tag, adapts for any scan options that would have been modified by tags.
 func parseInfo(fld reflect.StructField, optFuncs ...OptFunc) (*Flag, *tag.MultiTag, scan.Opts, error) {
-	var scanOpts []scan.OptFunc
-	for _, optFunc := range optFuncs {
-		scanOpts = append(scanOpts, scan.OptFunc(optFunc))
-	}
+	scanOpts := ScanOptFuncs(optFuncs...)
 	scanOptions := scan.DefOpts().Apply(scanOpts...)
 	options := opts(scanOptions)
 
@@ -128,15 +190,39 @@ func parseInfo(fld reflect.StructField, optFuncs ...OptFunc) (*Flag, *tag.MultiT
 		return flag, tag, scanOptions, err
 	}
 
-	// Various prefixing checks and steps
-	flag.EnvName = parseEnvTag(flag.Name, fld, options)
-	prefix := flag.Name + options.FlagDivider
+	// Fields used in another execution mode are ignored, as well as those
+	// collecting unknown flags or raw arguments of a command, or positionals.
+	_, unknown := tag.Get("unknown")
+	_, argv := tag.Get("argv")
+	_, arg := tag.Get("positional-arg")
 
-	if fld.Anonymous && options.Flatten {
-		prefix = options.Prefix
+	if unknown || argv || arg || !InMode(*tag, optFuncs...) {
+		return nil, tag, scanOptions, nil
 	}
 
-	scanOpts = append(scanOpts, scan.OptFunc(Prefix(prefix)))
+	// Various prefixing checks and steps
+	flag.EnvName = parseEnvTag(flag.Name, tag, options)
+	_, flag.Env = tag.Get("env")
+	flag.Env = flag.Env && flag.EnvName != ""
+	flag.EnvDelim, _ = tag.Get("env-delim")
+	flag.EnvOnly = flag.Env && isEnvOnly(*tag)
+	flag.DefaultFrom, _ = tag.Get("default-from")
+	flag.Requires = tagNames(tag, "requires")
+	flag.ConflictsWith = tagNames(tag, "conflicts-with")
+	flag.OneRequired, _ = tag.Get("one-required")
+	flag.RequiredIf = tag.GetMany("required-if")
+	flag.RequiredUnless = tagNames(tag, "required-unless")
+	_, flag.Interpolate = tag.Get("interpolate")
+
+	switch {
+	case isGroup(*tag):
+		// Nested groups only prefix their options with their namespaces.
+		scanOpts = ScanOptFuncs(GroupOptions(*tag, optFuncs...)...)
+	case fld.Anonymous && options.Flatten:
+		scanOpts = append(scanOpts, scan.OptFunc(Prefix(options.Prefix)))
+	default:
+		scanOpts = append(scanOpts, scan.OptFunc(Prefix(flag.Name+options.FlagDivider)))
+	}
 
 	// Return an update list of scan options,
 	// which might have been influenced by the tags.
@@ -145,6 +231,23 @@ func parseInfo(fld reflect.StructField, optFuncs ...OptFunc) (*Flag, *tag.MultiT
 	return flag, tag, scanOptions, err
 }
 
+// setTagDefaults sets an empty field to the values of its `default` tags, with a value of its
+// own: the value of the option thus replaces these defaults, instead of appending to them.
+func setTagDefaults(value reflect.Value, mtag tag.MultiTag, options OptFunc) error {
+	_, defaults, err := parseTagged(value, mtag, options)
+	if err != nil || defaults == nil {
+		return err
+	}
+
+	for _, defValue := range mtag.GetMany("default") {
+		if err := defaults.Set(defValue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func parseVal(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, Value, error) {
 	// value is addressable, let's check if we can parse it
 	if value.CanAddr() && value.Addr().CanInterface() {
@@ -158,6 +261,11 @@ func parseVal(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, Value, error)
 		if val, casted := valueInterface.(Value); casted {
 			return nil, val, nil
 		}
+
+		// or if it can be unmarshaled from text.
+		if val := parseText(value); val != nil {
+			return nil, val, nil
+		}
 	}
 
 	switch value.Kind() {
@@ -188,6 +296,46 @@ func parseVal(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, Value, error)
 	return nil, nil, nil
 }
 
+// SetEnv sets the value of an env-tagged option from its environment variable, as looked up
+// with the options (see WithEnviron and WithEnvLookup), and returns true if the variable is set.
+// Scanning structs never reads the environment: generators call SetEnv once command-lines are
+// parsed, for the options not given on them (and for all options only set from the environment).
+func (f *Flag) SetEnv(optFuncs ...OptFunc) (bool, error) {
+	if !f.Env {
+		return false, nil
+	}
+
+	values, found := EnvValues(f.EnvName, f.EnvDelim, optFuncs...)
+
+	for _, value := range values {
+		if err := f.Value.Set(value); err != nil {
+			return true, fmt.Errorf("%w: invalid value for env %s: %s", ErrParse, f.EnvName, err.Error())
+		}
+	}
+
+	return found, nil
+}
+
+// EnvValues returns the values of an environment variable, as looked up with the options,
+// split with a delimiter if not empty, and true if the variable is set: generators having
+// only the values of options (eg. pflag ones), and not their Flag, use it like SetEnv.
+func EnvValues(name, delim string, optFuncs ...OptFunc) ([]string, bool) {
+	if name == "" {
+		return nil, false
+	}
+
+	envValue, found := ScanOptions(optFuncs...).LookupEnv(name)
+	if !found {
+		return nil, false
+	}
+
+	if delim == "" {
+		return []string{envValue}, true
+	}
+
+	return strings.Split(envValue, delim), true
+}
+
 func parseStruct(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, error) {
 	flags := []*Flag{}
 
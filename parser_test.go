@@ -3,9 +3,12 @@ package flags
 import (
 	"errors"
 	"net"
+	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/reeflective/flags/internal/scan"
 	"github.com/stretchr/testify/assert"
@@ -501,3 +504,366 @@ func TestFlatten(t *testing.T) {
 	Flatten(false)(&opt)
 	assert.Equal(t, false, opt.Flatten)
 }
+
+func TestParseStructGroupNamespaces(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Server struct {
+			Host string `long:"host"`
+			Port int    `long:"port" env:"PORT"`
+			TLS  struct {
+				Cert string `long:"cert"`
+			} `group:"tls" namespace:"tls" namespace-delimiter:"." env-namespace:"TLS"`
+		} `group:"server" namespace:"server" namespace-delimiter:"." env-namespace:"SRV" env-namespace-delimiter:"__"`
+	}{}
+
+	flagSet, err := ParseStruct(cfg, EnvPrefix("APP_"))
+	require.NoError(t, err)
+	require.Len(t, flagSet, 3)
+
+	assert.Equal(t, "server.host", flagSet[0].Name)
+	assert.Equal(t, "APP_SRV__HOST", flagSet[0].EnvName)
+	assert.Equal(t, "server.port", flagSet[1].Name)
+	assert.Equal(t, "APP_SRV__PORT", flagSet[1].EnvName)
+	assert.Equal(t, "server.tls.cert", flagSet[2].Name)
+	assert.Equal(t, "APP_SRV__TLS_CERT", flagSet[2].EnvName)
+}
+
+// TestParseStructPrefixedEnv checks that env names include the flags
+// prefix and group namespaces, like the names of their flags.
+func TestParseStructPrefixedEnv(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Foo    string `long:"foo" env:""`
+		Bar    string `long:"bar" env:"BAR"`
+		Nested struct {
+			Baz string `long:"baz" env:""`
+		}
+		DB struct {
+			Host string `long:"host" env:""`
+		} `group:"db" namespace:"db" namespace-delimiter:"-"`
+	}{}
+
+	flagSet, err := ParseStruct(cfg, Prefix("app-"), EnvPrefix("X_"), ParseAll())
+	require.NoError(t, err)
+	require.Len(t, flagSet, 4)
+
+	assert.Equal(t, "X_APP_FOO", flagSet[0].EnvName)
+	assert.Equal(t, "X_APP_BAR", flagSet[1].EnvName)
+	assert.Equal(t, "app-nested-baz", flagSet[2].Name)
+	assert.Equal(t, "X_APP_NESTED_BAZ", flagSet[2].EnvName)
+	assert.Equal(t, "app-db-host", flagSet[3].Name)
+	assert.Equal(t, "X_APP_DB_HOST", flagSet[3].EnvName)
+}
+
+func TestParseStructWithEnviron(t *testing.T) {
+	t.Setenv("APP_PORT", "80")
+
+	cfg := &struct {
+		Host  string   `long:"host" env:"HOST"`
+		Port  int      `long:"port" env:"PORT"`
+		Hosts []string `long:"hosts" env:"HOSTS" env-delim:","`
+	}{Hosts: []string{"default"}}
+
+	environ := []string{"APP_HOST=localhost", "APP_HOSTS=a,b", "APP_HOST=remote", "INVALID"}
+	opts := []OptFunc{EnvPrefix("APP_"), WithEnviron(environ)}
+
+	flagSet, err := ParseStruct(cfg, opts...)
+	require.NoError(t, err)
+
+	assert.Empty(t, cfg.Host, "the environment should not be read when scanning")
+	assert.Equal(t, ",", flagSet[2].EnvDelim)
+
+	for _, flag := range flagSet {
+		_, err := flag.SetEnv(opts...)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, "remote", cfg.Host, "later snapshot values should override earlier ones")
+	assert.Equal(t, 0, cfg.Port, "the process environment should not be used")
+	assert.Equal(t, []string{"a", "b"}, cfg.Hosts, "env values should replace default ones")
+
+	values, found := EnvValues("APP_HOSTS", ",", opts...)
+	assert.True(t, found)
+	assert.Equal(t, []string{"a", "b"}, values)
+
+	_, found = EnvValues("APP_PORT", "", opts...)
+	assert.False(t, found)
+}
+
+func TestParseStructWithEnvLookup(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Host string `long:"host" env:"HOST"`
+		Port int    `long:"port" env:"PORT"`
+	}{}
+
+	var looked []string
+
+	lookup := func(key string) (string, bool) {
+		looked = append(looked, key)
+
+		return "remote", key == "APP_HOST"
+	}
+
+	opts := []OptFunc{EnvPrefix("APP_"), WithEnviron([]string{"APP_PORT=80"}), WithEnvLookup(lookup)}
+
+	flagSet, err := ParseStruct(cfg, opts...)
+	require.NoError(t, err)
+	assert.Empty(t, looked, "the environment should not be read when scanning")
+
+	for _, flag := range flagSet {
+		_, err := flag.SetEnv(opts...)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, "remote", cfg.Host)
+	assert.Equal(t, 0, cfg.Port, "the last environment source given should be used")
+	assert.Equal(t, []string{"APP_HOST", "APP_PORT"}, looked)
+}
+
+func TestColorsEnabled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		environ []string
+		mode    string
+		enabled bool
+	}{
+		{name: "default", enabled: true},
+		{name: "no color", environ: []string{"NO_COLOR=1"}, enabled: false},
+		{name: "empty no color", environ: []string{"NO_COLOR="}, enabled: true},
+		{name: "clicolor off", environ: []string{"CLICOLOR=0"}, enabled: false},
+		{name: "dumb terminal", environ: []string{"TERM=dumb"}, enabled: false},
+		{name: "forced", environ: []string{"TERM=dumb", "CLICOLOR_FORCE=1"}, enabled: true},
+		{name: "no color over forced", environ: []string{"NO_COLOR=1", "CLICOLOR_FORCE=1"}, enabled: false},
+		{name: "always", environ: []string{"NO_COLOR=1"}, mode: ColorAlways, enabled: true},
+		{name: "never", mode: ColorNever, enabled: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.enabled, ColorsEnabled(WithEnviron(test.environ), WithColors(test.mode)))
+		})
+	}
+}
+
+func TestHelpWidth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		environ []string
+		width   int
+		columns int
+	}{
+		{name: "columns", environ: []string{"COLUMNS=100"}, columns: 100},
+		{name: "override", environ: []string{"COLUMNS=100"}, width: 60, columns: 60},
+		{name: "disabled", environ: []string{"COLUMNS=100"}, width: -1, columns: 0},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.columns, HelpWidth(WithEnviron(test.environ), WithHelpWidth(test.width)))
+		})
+	}
+}
+
+func TestParseStructEnvOnly(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Host    string `long:"host"`
+		Token   string `env:"API_TOKEN" no-flag:"true"`
+		Timeout int    `env:"TIMEOUT" no-flag:"true"`
+		Ignored string `long:"ignored" no-flag:"true"`
+	}{Timeout: 10}
+
+	environ := WithEnviron([]string{"API_TOKEN=secret"})
+
+	flagSet, err := ParseStruct(cfg, environ)
+	require.NoError(t, err)
+
+	require.Len(t, flagSet, 3, "options without flags should only be listed if set from the environment")
+	assert.False(t, flagSet[0].EnvOnly)
+	assert.True(t, flagSet[1].EnvOnly)
+	assert.True(t, flagSet[2].EnvOnly)
+
+	for _, flag := range flagSet {
+		_, err := flag.SetEnv(environ)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, "secret", cfg.Token)
+	assert.Equal(t, 10, cfg.Timeout, "unset variables should keep default values")
+
+	isSet, err := flagSet[2].SetEnv(WithEnviron([]string{"TIMEOUT=ten"}))
+	assert.True(t, isSet)
+	assert.ErrorIs(t, err, ErrParse)
+}
+
+func TestParseStructWithLimits(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Name   string            `long:"name"`
+		Hosts  []string          `long:"hosts"`
+		Labels map[string]string `long:"labels"`
+	}{Hosts: []string{"x", "y", "z"}}
+
+	var validated []string
+
+	validator := Validator(func(val string, _ reflect.StructField, _ interface{}) error {
+		validated = append(validated, val)
+		return nil
+	})
+
+	flagSet, err := ParseStruct(cfg, MaxArgLength(8), MaxElements(2), validator)
+	require.NoError(t, err)
+	require.Len(t, flagSet, 3)
+
+	assert.NoError(t, flagSet[0].Value.Set("short"))
+	assert.ErrorIs(t, flagSet[0].Value.Set("much too long"), ErrArgTooLong)
+	assert.Equal(t, "short", cfg.Name)
+
+	assert.NoError(t, flagSet[1].Value.Set("a,b"), "default elements should be replaced")
+	assert.ErrorIs(t, flagSet[1].Value.Set("c"), ErrTooManyElements)
+	assert.Equal(t, []string{"a", "b"}, cfg.Hosts, "rejected elements should not be kept")
+	assert.NotContains(t, validated, "c", "values with too many elements should not be set")
+
+	assert.NoError(t, flagSet[2].Value.Set("a:1,b:2"))
+	err = flagSet[2].Value.Set("c:3")
+	assert.ErrorIs(t, err, ErrTooManyElements)
+	assert.ErrorIs(t, err, ErrLimit)
+	assert.NoError(t, flagSet[2].Value.Set("b:3"), "existing keys should be replaced")
+	assert.Equal(t, map[string]string{"a": "1", "b": "3"}, cfg.Labels)
+
+	assert.NoError(t, CheckArgs([]string{"a", "b"}, MaxArgs(2)))
+	assert.ErrorIs(t, CheckArgs([]string{"a", "b", "c"}, MaxArgs(2)), ErrTooManyArgs)
+	assert.ErrorIs(t, CheckArgs([]string{"a", "abcdef"}, MaxArgLength(4)), ErrArgTooLong)
+}
+
+func TestParseStructWithChecks(t *testing.T) {
+	t.Parallel()
+
+	RegisterCheck("multiple", func(value, arg string) error {
+		if len(value)%len(arg) != 0 {
+			return errors.New("bad multiple")
+		}
+
+		return nil
+	})
+
+	cfg := &struct {
+		Port    int           `long:"port" check:"min=1,max=65535"`
+		Name    string        `long:"name" check:"max=4" check:"regexp=^[a-z]{1,}$"`
+		Timeout time.Duration `long:"timeout" check:"max=1m"`
+		Ratios  []float64     `long:"ratios" check:"min=0,max=1"`
+		Config  string        `long:"config" check:"file"`
+		Dir     string        `long:"dir" check:"dir"`
+		Remote  string        `long:"remote" check:"url"`
+		Key     string        `long:"key" check:"multiple=abc"`
+	}{}
+
+	flagSet, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flagSet, 8)
+
+	assert.NoError(t, flagSet[0].Value.Set("8080"))
+	assert.ErrorIs(t, flagSet[0].Value.Set("0"), ErrCheck)
+	assert.ErrorIs(t, flagSet[0].Value.Set("70000"), ErrCheck)
+	assert.Equal(t, 8080, cfg.Port, "rejected values should not be kept")
+
+	assert.NoError(t, flagSet[1].Value.Set("abc"))
+	assert.ErrorIs(t, flagSet[1].Value.Set("abcde"), ErrCheck)
+	assert.ErrorIs(t, flagSet[1].Value.Set("AB"), ErrCheck)
+
+	assert.NoError(t, flagSet[2].Value.Set("30s"))
+	assert.ErrorIs(t, flagSet[2].Value.Set("2m"), ErrCheck)
+
+	assert.NoError(t, flagSet[3].Value.Set("0.5,1"))
+	assert.ErrorIs(t, flagSet[3].Value.Set("0.5,1.5"), ErrCheck)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yml")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+	assert.NoError(t, flagSet[4].Value.Set(file))
+	assert.ErrorIs(t, flagSet[4].Value.Set(dir), ErrCheck)
+	assert.ErrorIs(t, flagSet[4].Value.Set(filepath.Join(dir, "none")), ErrCheck)
+	assert.NoError(t, flagSet[5].Value.Set(dir))
+	assert.ErrorIs(t, flagSet[5].Value.Set(file), ErrCheck)
+
+	assert.NoError(t, flagSet[6].Value.Set("https://example.com/repo"))
+	assert.ErrorIs(t, flagSet[6].Value.Set("example.com"), ErrCheck)
+
+	assert.NoError(t, flagSet[7].Value.Set("abcdef"))
+	assert.EqualError(t, flagSet[7].Value.Set("abcd"), "bad multiple")
+
+	_, err = ParseStruct(&struct {
+		Name string `long:"name" check:"unknown"`
+	}{})
+	assert.ErrorIs(t, err, ErrInvalidTag)
+
+	_, err = ParseStruct(&struct {
+		Port int `long:"port" check:"min=one"`
+	}{})
+	assert.ErrorIs(t, err, ErrInvalidTag)
+
+	assert.Panics(t, func() { RegisterCheck("url", func(value, arg string) error { return nil }) })
+}
+
+// mapValueSource is a value source backed by a map.
+type mapValueSource map[string]string
+
+func (m mapValueSource) Name() string { return "vault" }
+
+func (m mapValueSource) Resolve(key string) (string, bool) {
+	value, found := m[key]
+
+	return value, found
+}
+
+func TestParseStructWithSource(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Token   string `long:"token" source:"vault:app/token"`
+		User    string `long:"user" source:"vault:app/user" env:"USER"`
+		Timeout int    `long:"timeout" source:"vault:app/timeout"`
+	}{Timeout: 10}
+
+	vault := mapValueSource{"app/token": "secret", "app/user": "vault"}
+
+	flagSet, err := ParseStruct(cfg, WithSource(vault), WithEnviron([]string{"USER=env"}))
+	require.NoError(t, err)
+
+	assert.Empty(t, cfg.Token, "sources should not be queried when scanning")
+	assert.Empty(t, cfg.User)
+	assert.Equal(t, 10, cfg.Timeout)
+	assert.Empty(t, flagSet[0].DefValue)
+	assert.Equal(t, "vault:app/token", flagSet[0].Source)
+	assert.Equal(t, "vault:app/user", flagSet[1].Source)
+	assert.Equal(t, "vault:app/timeout", flagSet[2].Source)
+	assert.Equal(t, &cfg.Timeout, flagSet[2].Field)
+
+	unbound := &struct {
+		Token string `long:"token" source:"remote:token"`
+	}{}
+
+	_, err = ParseStruct(unbound, WithSource(vault))
+	require.ErrorIs(t, err, ErrInvalidTag)
+
+	_, err = ParseStruct(&struct {
+		Token string `long:"token" source:"vault"`
+	}{}, WithSource(vault))
+	require.ErrorIs(t, err, ErrInvalidTag)
+}
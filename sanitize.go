@@ -0,0 +1,65 @@
+package flags
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Sanitize returns a text safe to be written to a terminal or given to a shell: its control
+// characters (like the ESC introducing terminal escape sequences), bidirectional formatting
+// characters and invalid UTF-8 bytes are escaped like in Go strings (eg. `\x1b`), except for
+// newlines and tabs. Generators sanitize the descriptions of commands and options (from struct
+// tags or catalogs), the candidates they build themselves, and the messages of errors.
+func Sanitize(text string) string {
+	return sanitize(text, false)
+}
+
+// SanitizeLine is like Sanitize, but also escapes newlines and tabs, for texts that must
+// hold on a single line, like completion candidates (in which shells use tabs as separators).
+// Completer implementations can use it on the values and descriptions they get from untrusted
+// sources (eg. remote APIs or process outputs), which generators cannot sanitize for them.
+func SanitizeLine(text string) string {
+	return sanitize(text, true)
+}
+
+// sanitize escapes the unsafe characters of a text, if it has any.
+func sanitize(text string, line bool) string {
+	unsafe := func(char rune) bool { return isUnsafe(char, line) }
+
+	if utf8.ValidString(text) && strings.IndexFunc(text, unsafe) == -1 {
+		return text
+	}
+
+	var sanitized strings.Builder
+
+	for len(text) > 0 {
+		char, size := utf8.DecodeRuneInString(text)
+
+		switch {
+		case char == utf8.RuneError && size == 1:
+			fmt.Fprintf(&sanitized, `\x%02x`, text[0])
+		case unsafe(char):
+			quoted := strconv.QuoteRune(char)
+			sanitized.WriteString(quoted[1 : len(quoted)-1])
+		default:
+			sanitized.WriteRune(char)
+		}
+
+		text = text[size:]
+	}
+
+	return sanitized.String()
+}
+
+// isUnsafe returns true if a character must be escaped, newlines
+// and tabs being only escaped in texts holding on a single line.
+func isUnsafe(char rune, line bool) bool {
+	if char == '\n' || char == '\t' {
+		return line
+	}
+
+	return unicode.IsControl(char) || unicode.Is(unicode.Bidi_Control, char)
+}
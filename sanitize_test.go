@@ -0,0 +1,52 @@
+package flags
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		src  string
+		exp  string
+		line string
+	}{
+		{"", "", ""},
+		{"plain text", "plain text", "plain text"},
+		{"Böse Überraschung", "Böse Überraschung", "Böse Überraschung"},
+		{"two\nlines\tand tab", "two\nlines\tand tab", `two\nlines\tand tab`},
+		{"\x1b[31mred\x1b[0m", `\x1b[31mred\x1b[0m`, `\x1b[31mred\x1b[0m`},
+		{"bell\a and return\r", `bell\a and return\r`, `bell\a and return\r`},
+		{"csi \u009b1m", `csi \u009b1m`, `csi \u009b1m`},
+		{"bidi \u202eoverride", `bidi \u202eoverride`, `bidi \u202eoverride`},
+		{"BadUTF8\xe2\xe2\xa1", `BadUTF8\xe2\xe2\xa1`, `BadUTF8\xe2\xe2\xa1`},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.exp, Sanitize(test.src), test.src)
+		assert.Equal(t, test.line, SanitizeLine(test.src), test.src)
+	}
+}
+
+// TestSanitizeDescriptions checks that descriptions from struct
+// tags and messages of errors are sanitized.
+func TestSanitizeDescriptions(t *testing.T) {
+	t.Parallel()
+
+	cfg := struct {
+		Name string `desc:"the \x1b]0;pwned\x07 name"`
+	}{}
+
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	assert.Equal(t, `the \x1b]0;pwned\a name`, flags[0].Usage)
+
+	typed := &Error{Kind: ErrInvalidValue, Err: errors.New("invalid value \x1b[2J")}
+	assert.EqualError(t, typed, `invalid value \x1b[2J`)
+}
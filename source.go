@@ -0,0 +1,63 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/reeflective/flags/internal/scan"
+	"github.com/reeflective/flags/internal/tag"
+)
+
+// ValueSource is a source of default values for options, like a secrets manager
+// or a remote configuration service, registered with WithSource(). An option is
+// bound to a key of a source with the `source:"name:key"` tag, where name is the
+// one returned by the source (ex: `source:"vault:path/to/secret"`).
+type ValueSource interface {
+	// Name is the name by which options refer to the source in their tags.
+	Name() string
+
+	// Resolve returns the value of a key, and true if the source has one.
+	Resolve(key string) (string, bool)
+}
+
+// WithSource registers sources of default values for the options tagged with `source`.
+// Their values are only queried once the command-line is parsed, by a Resolver bound to the
+// commands (and given the same options), and override the default values of the struct fields,
+// but not those of registered sources, of the environment, or of the command-line. Registering
+// a source with the name of an already registered one replaces the latter.
+func WithSource(sources ...ValueSource) OptFunc {
+	return func(opt *scan.Opts) {
+		if opt.Sources == nil {
+			opt.Sources = map[string]scan.ValueSource{}
+		}
+
+		for _, source := range sources {
+			if source != nil {
+				opt.Sources[source.Name()] = source
+			}
+		}
+	}
+}
+
+// parseSource binds an option to the source and key of its `source` tag, if any, which
+// must be registered: the source is not queried when scanning (see WithSource).
+func parseSource(flag *Flag, mtag tag.MultiTag, scanOpts scan.Opts) error {
+	binding, isSet := mtag.Get("source")
+	if !isSet {
+		return nil
+	}
+
+	name, key, found := strings.Cut(binding, ":")
+	if !found || name == "" || key == "" {
+		return fmt.Errorf("%w: source tag of flag %s should be in the name:key format: %q",
+			ErrInvalidTag, flag.Name, binding)
+	}
+
+	if scanOpts.Sources[name] == nil {
+		return fmt.Errorf("%w: flag %s is bound to unknown source %q", ErrInvalidTag, flag.Name, name)
+	}
+
+	flag.Source = binding
+
+	return nil
+}
@@ -9,6 +9,10 @@ import (
 	"github.com/reeflective/flags/internal/tag"
 )
 
+// DefaultNegationPrefix is the prefix of the negative flags
+// of options tagged with an empty `negatable` tag.
+const DefaultNegationPrefix = "no-"
+
 // parseFlagTag now also handles some of the tags used in jessevdk/go-flags.
 func parseFlagTag(field reflect.StructField, options opts) (*Flag, *tag.MultiTag, error) {
 	flag := &Flag{}
@@ -35,8 +39,38 @@ func parseFlagTag(field reflect.StructField, options opts) (*Flag, *tag.MultiTag
 	setFlagChoices(flag, flagTags.GetMany("choice"))
 	setFlagOptionalValues(flag, flagTags.GetMany("optional-value"))
 
+	if message, isSet := flagTags.Get("deprecated"); isSet {
+		flag.Deprecated, flag.Deprecation = true, message
+	}
+
+	flag.RenamedFrom = flagTags.GetMany("renamed-from")
+	flag.Completers = flagTags.GetMany("complete")
+	flag.ShowAliases = options.ShowAliases
+
+	flag.Aliases = tagNames(flagTags, "alias")
+
+	if prefix, isSet := flagTags.Get("negatable"); isSet {
+		if prefix == "" {
+			prefix = DefaultNegationPrefix
+		}
+
+		flag.Negation = prefix + flag.Name
+	}
+
 	if options.Prefix != "" && !ignorePrefix {
 		flag.Name = options.Prefix + flag.Name
+
+		if flag.Negation != "" {
+			flag.Negation = options.Prefix + flag.Negation
+		}
+
+		for i, name := range flag.RenamedFrom {
+			flag.RenamedFrom[i] = options.Prefix + name
+		}
+
+		for i, alias := range flag.Aliases {
+			flag.Aliases[i] = options.Prefix + alias
+		}
 	}
 
 	hidden, _ := flagTags.Get("hidden")
@@ -45,10 +79,25 @@ func parseFlagTag(field reflect.StructField, options opts) (*Flag, *tag.MultiTag
 	return flag, flagTags, nil
 }
 
+// tagNames returns the names given to a repeatable tag, each being a comma-separated list.
+func tagNames(flagTags *tag.MultiTag, key string) []string {
+	var names []string
+
+	for _, values := range flagTags.GetMany(key) {
+		for _, name := range strings.Split(values, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
+
 // getFlagTags tries to parse any struct tag we need, and tells the caller if
 // we should actually build a flag object out of the struct field, or skip it.
 func getFlagTags(field reflect.StructField, options opts) (*tag.MultiTag, bool, error) {
-	flagTags, none, err := tag.GetFieldTag(field)
+	flagTags, none, err := scan.Opts(options).FieldTag(field)
 	if err != nil {
 		return nil, true, fmt.Errorf("%w: %s", ErrTag, err.Error())
 	}
@@ -64,8 +113,8 @@ func getFlagTags(field reflect.StructField, options opts) (*tag.MultiTag, bool,
 		return &flagTags, true, nil
 	}
 
-	// Or if there is a "no-flag" tag
-	if noFlag, _ := flagTags.Get("no-flag"); noFlag != "" {
+	// Or if there is a "no-flag" tag, without any "env" one.
+	if noFlag, _ := flagTags.Get("no-flag"); noFlag != "" && !tag.IsEnvOnly(flagTags) {
 		return &flagTags, true, nil
 	}
 
@@ -91,9 +140,9 @@ func parseBaseAttributes(flagTags *tag.MultiTag, flag *Flag, options opts) (skip
 
 	// Descriptions
 	if desc, isSet := flagTags.Get("desc"); isSet && desc != "" {
-		flag.Usage = desc
+		flag.Usage = Sanitize(desc)
 	} else if desc, isSet := flagTags.Get("description"); isSet && desc != "" {
-		flag.Usage = desc
+		flag.Usage = Sanitize(desc)
 	}
 
 	// Requirements
@@ -143,6 +192,8 @@ func parseGoFlagsTag(flagTags *tag.MultiTag, flag *Flag) {
 		}
 		if long, found := flagTags.Get("long"); found && long != "" {
 			flag.Name, _ = flagTags.Get("long")
+		} else {
+			flag.ShortOnly = flag.Short != ""
 		}
 	} else if long, found := flagTags.Get("long"); found && long != "" {
 		// Or we have only a short tag being specified.
@@ -150,11 +201,21 @@ func parseGoFlagsTag(flagTags *tag.MultiTag, flag *Flag) {
 	}
 }
 
-func parseEnvTag(flagName string, field reflect.StructField, options opts) string {
+// isEnvOnly returns true if the option has no flag, and is only set from the environment.
+func isEnvOnly(flagTags tag.MultiTag) bool {
+	return tag.IsEnvOnly(flagTags)
+}
+
+// parseEnvTag returns the name of the environment variable of a flag, given its name
+// (with its prefix). The part of the prefix coming from groups with an env-namespace
+// is not repeated, since the env prefix already includes these env namespaces.
+func parseEnvTag(flagName string, flagTags *tag.MultiTag, options opts) string {
 	ignoreEnvPrefix := false
-	envVar := flagToEnv(flagName, options.FlagDivider, options.EnvDivider)
+	prefix := strings.TrimPrefix(options.Prefix, options.EnvScope)
+	envVar := flagToEnv(strings.TrimPrefix(flagName, options.EnvScope), options.FlagDivider, options.EnvDivider)
+	envTag, _ := flagTags.Get(scan.DefaultEnvTag)
 
-	if envTags := strings.Split(field.Tag.Get(scan.DefaultEnvTag), ","); len(envTags) > 0 {
+	if envTags := strings.Split(envTag, ","); len(envTags) > 0 {
 		switch envName := envTags[0]; envName {
 		case "-":
 			// if tag is `env:"-"` then won't fill flag from environment
@@ -168,13 +229,7 @@ func parseEnvTag(flagName string, field reflect.StructField, options opts) strin
 				envVar = envName[1:]
 				ignoreEnvPrefix = true
 			} else {
-				envVar = envName
-				if options.Prefix != "" {
-					envVar = flagToEnv(
-						options.Prefix,
-						options.FlagDivider,
-						options.EnvDivider) + envVar
-				}
+				envVar = flagToEnv(prefix, options.FlagDivider, options.EnvDivider) + envName
 			}
 		}
 	}
@@ -0,0 +1,182 @@
+package flags
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	textUnmarshalerType   = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	binaryUnmarshalerType = reflect.TypeOf((*encoding.BinaryUnmarshaler)(nil)).Elem()
+)
+
+// parseText returns a value for fields whose type implements encoding.TextUnmarshaler
+// or encoding.BinaryUnmarshaler (with a pointer receiver), and for slices of them.
+func parseText(value reflect.Value) Value {
+	if !value.CanAddr() {
+		return nil
+	}
+
+	if isUnmarshaler(value.Type()) {
+		return &textValue{value: value}
+	}
+
+	if value.Kind() == reflect.Slice && isUnmarshaler(value.Type().Elem()) {
+		return &textSliceValue{value: value}
+	}
+
+	return nil
+}
+
+// isUnmarshaler returns true if pointers to the type implement one of the unmarshaler interfaces.
+func isUnmarshaler(typ reflect.Type) bool {
+	ptr := reflect.PtrTo(typ)
+
+	return ptr.Implements(textUnmarshalerType) || ptr.Implements(binaryUnmarshalerType)
+}
+
+// unmarshalText unmarshals a string onto an addressable value,
+// preferring its text unmarshaler over its binary one.
+func unmarshalText(value reflect.Value, s string) error {
+	switch unm := value.Addr().Interface().(type) {
+	case encoding.TextUnmarshaler:
+		return unm.UnmarshalText([]byte(s))
+	case encoding.BinaryUnmarshaler:
+		return unm.UnmarshalBinary([]byte(s))
+	default:
+		return nil
+	}
+}
+
+// marshalText formats a value with its text or binary marshaler, or as a
+// Stringer. Unset (zero) values are formatted as an empty string.
+func marshalText(value reflect.Value) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	val := value.Interface()
+	if value.CanAddr() {
+		val = value.Addr().Interface()
+	}
+
+	switch marshaler := val.(type) {
+	case encoding.TextMarshaler:
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text)
+		}
+	case encoding.BinaryMarshaler:
+		if data, err := marshaler.MarshalBinary(); err == nil {
+			return string(data)
+		}
+	case fmt.Stringer:
+		return marshaler.String()
+	}
+
+	return fmt.Sprintf("%v", value.Interface())
+}
+
+// -- encoding.TextUnmarshaler Value.
+type textValue struct {
+	value reflect.Value
+}
+
+var (
+	_ Value  = (*textValue)(nil)
+	_ Getter = (*textValue)(nil)
+)
+
+func (v *textValue) Set(s string) error {
+	parsed := reflect.New(v.value.Type()).Elem()
+
+	if err := unmarshalText(parsed, strings.TrimSpace(s)); err != nil {
+		return err
+	}
+
+	v.value.Set(parsed)
+
+	return nil
+}
+
+func (v *textValue) Get() interface{} {
+	if v != nil && v.value.IsValid() {
+		return v.value.Interface()
+	}
+
+	return nil
+}
+
+func (v *textValue) String() string {
+	if v != nil && v.value.IsValid() {
+		return marshalText(v.value)
+	}
+
+	return ""
+}
+
+func (v *textValue) Type() string { return v.value.Type().String() }
+
+// -- encoding.TextUnmarshalerSlice Value
+type textSliceValue struct {
+	value   reflect.Value
+	changed bool
+}
+
+var (
+	_ RepeatableFlag = (*textSliceValue)(nil)
+	_ Value          = (*textSliceValue)(nil)
+	_ Getter         = (*textSliceValue)(nil)
+	_ Resetter       = (*textSliceValue)(nil)
+)
+
+func (v *textSliceValue) Set(raw string) error {
+	ss := strings.Split(raw, ",")
+
+	out := reflect.MakeSlice(v.value.Type(), len(ss), len(ss))
+	for i, s := range ss {
+		if err := unmarshalText(out.Index(i), strings.TrimSpace(s)); err != nil {
+			return newElementError(i, s, err)
+		}
+	}
+
+	if !v.changed {
+		v.value.Set(out)
+	} else {
+		v.value.Set(reflect.AppendSlice(v.value, out))
+	}
+
+	v.changed = true
+
+	return nil
+}
+
+func (v *textSliceValue) Get() interface{} {
+	if v != nil && v.value.IsValid() {
+		return v.value.Interface()
+	}
+
+	return nil
+}
+
+func (v *textSliceValue) String() string {
+	if v == nil || !v.value.IsValid() {
+		return "[]"
+	}
+
+	out := make([]string, 0, v.value.Len())
+	for i := 0; i < v.value.Len(); i++ {
+		out = append(out, marshalText(v.value.Index(i)))
+	}
+
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *textSliceValue) Type() string { return v.value.Type().Elem().String() + "Slice" }
+
+func (v *textSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *textSliceValue) Reset() { v.changed = false }
@@ -0,0 +1,33 @@
+package flags
+
+import (
+	"time"
+
+	"github.com/reeflective/flags/internal/tag"
+)
+
+// DefaultTimeLayout is the layout used to parse and format time.Time
+// values, when their struct field has no `layout` tag.
+var DefaultTimeLayout = time.RFC3339
+
+// layoutValue is implemented by values parsed and formatted with a time layout.
+type layoutValue interface {
+	setLayout(layout string)
+}
+
+// withLayout sets the layout of a time value from its field `layout` tag, if any.
+func withLayout(val Value, mtag tag.MultiTag) {
+	layout, _ := mtag.Get("layout")
+	if timeVal, isTime := val.(layoutValue); isTime && layout != "" {
+		timeVal.setLayout(layout)
+	}
+}
+
+// formatTime formats a time, or returns an empty string if it is not set.
+func formatTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(layout)
+}
@@ -5,53 +5,84 @@ package flags
 import (
 	"fmt"
 	"net"
+	"reflect"
 	"strconv"
 	"strings"
+
+	"github.com/reeflective/flags/internal/convert"
+	"github.com/reeflective/flags/internal/scan"
 )
 
-// Value is the interface to the dynamic value stored in v flag.
-// (The default value is represented as v string.)
+// Value is the interface to the dynamic value stored in an option or positional, set
+// from the words of the command-line: this package implements it for all builtin types
+// (see NewValue), and struct fields whose type implements it are used as is.
 //
-// If v Value has an IsBoolFlag() bool method returning true, the command-line
-// parser makes --name equivalent to -name=true rather than using the next
-// command-line argument, and adds v --no-name counterpart for negating the
-// flag.
+// If a Value has an IsBoolFlag() bool method returning true (see BoolFlag), the command-line
+// parser makes --name equivalent to --name=true rather than using the next word as its value.
 type Value interface {
-	String() string
-	Set(string) error
-
-	// pflag.Flag require this
-	Type() string
+	String() string   // The current value, as text (also used for default values in help)
+	Set(string) error // Sets (or adds, for repeatable values) a value from a word
+	Type() string     // The name of the type of the value, shown in help usages
 }
 
-// Getter is an interface that allows the contents of v Value to be retrieved.
-// It wraps the Value interface, rather than being part of it, because it
-// appeared after Go 1 and its compatibility rules. All Value types provided
-// by this package satisfy the Getter interface.
+// Getter is a Value whose contents can be retrieved as they are typed: all Value
+// types provided by this package satisfy it, returning the value of their variable.
 type Getter interface {
 	Value
 	Get() interface{}
 }
 
-// BoolFlag is an optional interface to indicate boolean flags
-// that don't accept v value, and implicitly have v --no-<x> negation counterpart.
+// BoolFlag is an optional interface of values which do not require any word
+// when given on the command-line (eg. --verbose), like booleans and counters.
 type BoolFlag interface {
 	Value
 	IsBoolFlag() bool
 }
 
-// RepeatableFlag is an optional interface for flags that can be repeated.
-// required by kingpin.
+// RepeatableFlag is an optional interface of values which can be given several times
+// on the command-line, each word adding to the value (eg. slices, maps and counters)
+// instead of replacing it: generators use it to document, complete and merge them.
 type RepeatableFlag interface {
 	Value
 	IsCumulative() bool
 }
 
+// Resetter is an optional interface of values keeping a state between the words setting them
+// (eg. slices, whose first word replaces their default contents, and the next ones add to it):
+// Reset forgets this state, so that the next word sets the value as if it were the first one.
+type Resetter interface {
+	Value
+	Reset()
+}
+
+// NewValue returns the Value used by this package for the variable pointed to: either the
+// builtin one of its type (numbers, strings, booleans, durations, times, IPs, regexps, etc,
+// and their slices and maps), its own implementation of Value, or one using its text
+// (un)marshaling methods. This allows custom types and frontends to reuse the same parsing
+// and formatting as the generated options, without copying them. Nil pointers to pointer
+// variables are allocated. An error wrapping ErrNotValue is returned for unsupported types.
+func NewValue(ptr interface{}) (Value, error) {
+	value := reflect.ValueOf(ptr)
+	if ptr == nil || value.Kind() != reflect.Ptr || value.IsNil() {
+		return nil, ErrObjectIsNil
+	}
+
+	// Structs which are not values are groups of options, not returned.
+	if _, val, err := parseVal(value.Elem()); val != nil {
+		return val, nil
+	} else if err != nil && value.Elem().Kind() != reflect.Struct {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("%w: unsupported type %s", ErrNotValue, value.Elem().Type())
+}
+
 // === Custom values
 
 type validateValue struct {
 	Value
 	validateFunc func(val string) error
+	normalize    func(val string) (string, error)
 }
 
 func (v *validateValue) IsBoolFlag() bool {
@@ -70,6 +101,20 @@ func (v *validateValue) IsCumulative() bool {
 	return false
 }
 
+func (v *validateValue) Reset() {
+	if resetter, casted := v.Value.(Resetter); casted {
+		resetter.Reset()
+	}
+}
+
+func (v *validateValue) Get() interface{} {
+	if getter, casted := v.Value.(Getter); casted {
+		return getter.Get()
+	}
+
+	return nil
+}
+
 func (v *validateValue) String() string {
 	if v == nil || v.Value == nil {
 		return ""
@@ -79,6 +124,14 @@ func (v *validateValue) String() string {
 }
 
 func (v *validateValue) Set(val string) error {
+	// Values might be normalized to their canonical spelling.
+	if v.normalize != nil {
+		var err error
+		if val, err = v.normalize(val); err != nil {
+			return err
+		}
+	}
+
 	// Validation don't happen on the type itself.
 	if v.validateFunc != nil {
 		err := v.validateFunc(val)
@@ -103,6 +156,120 @@ func (v *validateValue) SetOld(val string) error {
 	return v.Value.Set(val)
 }
 
+// hookedValue calls a hook with the old and new values
+// of the struct field it wraps, each time it is set.
+type hookedValue struct {
+	Value
+	field reflect.Value
+	hook  scan.SetHook
+}
+
+func (v *hookedValue) IsBoolFlag() bool {
+	if boolFlag, casted := v.Value.(BoolFlag); casted {
+		return boolFlag.IsBoolFlag()
+	}
+
+	return false
+}
+
+func (v *hookedValue) IsCumulative() bool {
+	if cumulativeFlag, casted := v.Value.(RepeatableFlag); casted {
+		return cumulativeFlag.IsCumulative()
+	}
+
+	return false
+}
+
+func (v *hookedValue) Reset() {
+	if resetter, casted := v.Value.(Resetter); casted {
+		resetter.Reset()
+	}
+}
+
+func (v *hookedValue) Get() interface{} {
+	if getter, casted := v.Value.(Getter); casted {
+		return getter.Get()
+	}
+
+	return nil
+}
+
+func (v *hookedValue) Set(val string) error {
+	// Maps and slices are updated in place, so we keep a copy of their elements.
+	old := convert.Copy(v.field)
+
+	if err := v.Value.Set(val); err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(old.Interface(), v.field.Interface()) {
+		v.hook(old.Interface(), v.field.Interface())
+	}
+
+	return nil
+}
+
+// erroredValue records the last error returned when setting the value
+// of an option, which pflag does not wrap in the errors it returns.
+type erroredValue struct {
+	Value
+	name string
+	err  *Error
+}
+
+// erroredBoolValue is an erroredValue for boolean options: other values do not
+// implement BoolFlag, since pflag would otherwise consider them as booleans.
+type erroredBoolValue struct {
+	*erroredValue
+}
+
+func (v *erroredBoolValue) IsBoolFlag() bool { return true }
+
+// newErroredValue returns a value recording the errors of the value of an option.
+func newErroredValue(val Value, name string) Value {
+	errored := &erroredValue{Value: val, name: name}
+
+	if boolFlag, casted := val.(BoolFlag); casted && boolFlag.IsBoolFlag() {
+		return &erroredBoolValue{errored}
+	}
+
+	return errored
+}
+
+func (v *erroredValue) IsCumulative() bool {
+	if cumulativeFlag, casted := v.Value.(RepeatableFlag); casted {
+		return cumulativeFlag.IsCumulative()
+	}
+
+	return false
+}
+
+func (v *erroredValue) Reset() {
+	if resetter, casted := v.Value.(Resetter); casted {
+		resetter.Reset()
+	}
+}
+
+func (v *erroredValue) Get() interface{} {
+	if getter, casted := v.Value.(Getter); casted {
+		return getter.Get()
+	}
+
+	return nil
+}
+
+func (v *erroredValue) Set(val string) error {
+	v.err = nil
+
+	if err := v.Value.Set(val); err != nil {
+		v.err = &Error{Kind: ErrInvalidValue, Flag: v.name, Value: val, Err: err}
+
+		return err
+	}
+
+	return nil
+}
+
 // HexBytes might be used if you want to parse slice of bytes as hex string.
 // Original `[]byte` or `[]uint8` parsed as a list of `uint8`.
 type HexBytes []byte
@@ -113,6 +280,7 @@ type HexBytes []byte
 // If you use `struct{count Counter}
 // and parse it with `-count=10 ... -count .. -count`,
 // then final value of `count` will be 12.
+// The `max` and `step` tags of its field cap its count and set its increment.
 // Implements Value, Getter, BoolFlag, RepeatableFlag interfaces.
 type Counter int
 
@@ -161,6 +329,15 @@ func (v Counter) Type() string { return "count" }
 // IsBoolFlag returns true. boolValue implements BoolFlag interface.
 func (v *boolValue) IsBoolFlag() bool { return true }
 
+// === Errors for generated flags
+
+// newElementError wraps an error returned while parsing one of the elements of
+// a comma-separated slice/map flag value, so that the failing element is named
+// by its position (starting at 1) and text, eg. `element 3 "abc": <parse error>`.
+func newElementError(index int, text string, err error) error {
+	return fmt.Errorf("element %d %q: %w", index+1, text, err)
+}
+
 // === Custom parsers
 
 func parseIP(s string) (net.IP, error) {
@@ -12,6 +12,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"golang.org/x/text/language"
 )
 
 // MapAllowedKinds stores list of kinds allowed for map keys.
@@ -61,6 +63,8 @@ func parseGenerated(value interface{}) Value {
 		return newFloat32Value(value.(*float32))
 	case *time.Duration:
 		return newDurationValue(value.(*time.Duration))
+	case *time.Time:
+		return newTimeValue(value.(*time.Time))
 	case *net.IP:
 		return newIPValue(value.(*net.IP))
 	case *HexBytes:
@@ -69,6 +73,10 @@ func parseGenerated(value interface{}) Value {
 		return newTCPAddrValue(value.(*net.TCPAddr))
 	case *net.IPNet:
 		return newIPNetValue(value.(*net.IPNet))
+	case *language.Tag:
+		return newLanguageTagValue(value.(*language.Tag))
+	case *Charset:
+		return newCharsetValue(value.(*Charset))
 	case *[]string:
 		return newStringSliceValue(value.(*[]string))
 	case *[]bool:
@@ -99,6 +107,8 @@ func parseGenerated(value interface{}) Value {
 		return newFloat32SliceValue(value.(*[]float32))
 	case *[]time.Duration:
 		return newDurationSliceValue(value.(*[]time.Duration))
+	case *[]time.Time:
+		return newTimeSliceValue(value.(*[]time.Time))
 	case *[]net.IP:
 		return newIPSliceValue(value.(*[]net.IP))
 	case *[]HexBytes:
@@ -109,6 +119,12 @@ func parseGenerated(value interface{}) Value {
 		return newTCPAddrSliceValue(value.(*[]net.TCPAddr))
 	case *[]net.IPNet:
 		return newIPNetSliceValue(value.(*[]net.IPNet))
+	case *[]*time.Location:
+		return newLocationSliceValue(value.(*[]*time.Location))
+	case *[]language.Tag:
+		return newLanguageTagSliceValue(value.(*[]language.Tag))
+	case *[]Charset:
+		return newCharsetSliceValue(value.(*[]Charset))
 	default:
 		return nil
 	}
@@ -118,6 +134,8 @@ func parseGeneratedPtrs(value interface{}) Value {
 	switch value.(type) {
 	case **regexp.Regexp:
 		return newRegexpValue(value.(**regexp.Regexp))
+	case **time.Location:
+		return newLocationValue(value.(**time.Location))
 	default:
 		return nil
 	}
@@ -455,6 +473,28 @@ func parseGeneratedMap(value interface{}) Value {
 		return newUint32DurationMapValue(value.(*map[uint32]time.Duration))
 	case *map[uint64]time.Duration:
 		return newUint64DurationMapValue(value.(*map[uint64]time.Duration))
+	case *map[string]time.Time:
+		return newStringTimeMapValue(value.(*map[string]time.Time))
+	case *map[int]time.Time:
+		return newIntTimeMapValue(value.(*map[int]time.Time))
+	case *map[int8]time.Time:
+		return newInt8TimeMapValue(value.(*map[int8]time.Time))
+	case *map[int16]time.Time:
+		return newInt16TimeMapValue(value.(*map[int16]time.Time))
+	case *map[int32]time.Time:
+		return newInt32TimeMapValue(value.(*map[int32]time.Time))
+	case *map[int64]time.Time:
+		return newInt64TimeMapValue(value.(*map[int64]time.Time))
+	case *map[uint]time.Time:
+		return newUintTimeMapValue(value.(*map[uint]time.Time))
+	case *map[uint8]time.Time:
+		return newUint8TimeMapValue(value.(*map[uint8]time.Time))
+	case *map[uint16]time.Time:
+		return newUint16TimeMapValue(value.(*map[uint16]time.Time))
+	case *map[uint32]time.Time:
+		return newUint32TimeMapValue(value.(*map[uint32]time.Time))
+	case *map[uint64]time.Time:
+		return newUint64TimeMapValue(value.(*map[uint64]time.Time))
 	case *map[string]net.IP:
 		return newStringIPMapValue(value.(*map[string]net.IP))
 	case *map[int]net.IP:
@@ -594,6 +634,7 @@ var (
 	_ RepeatableFlag = (*stringSliceValue)(nil)
 	_ Value          = (*stringSliceValue)(nil)
 	_ Getter         = (*stringSliceValue)(nil)
+	_ Resetter       = (*stringSliceValue)(nil)
 )
 
 func newStringSliceValue(slice *[]string) *stringSliceValue {
@@ -638,6 +679,8 @@ func (v *stringSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *stringSliceValue) Reset() { v.changed = false }
+
 // -- stringStringMapValue.
 type stringStringMapValue struct {
 	value *map[string]string
@@ -658,13 +701,13 @@ func newStringStringMapValue(m *map[string]string) *stringStringMapValue {
 func (v *stringStringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -718,17 +761,17 @@ func newIntStringMapValue(m *map[int]string) *intStringMapValue {
 func (v *intStringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -783,17 +826,17 @@ func newInt8StringMapValue(m *map[int8]string) *int8StringMapValue {
 func (v *int8StringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -848,17 +891,17 @@ func newInt16StringMapValue(m *map[int16]string) *int16StringMapValue {
 func (v *int16StringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -913,17 +956,17 @@ func newInt32StringMapValue(m *map[int32]string) *int32StringMapValue {
 func (v *int32StringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -978,17 +1021,17 @@ func newInt64StringMapValue(m *map[int64]string) *int64StringMapValue {
 func (v *int64StringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -1043,17 +1086,17 @@ func newUintStringMapValue(m *map[uint]string) *uintStringMapValue {
 func (v *uintStringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -1108,17 +1151,17 @@ func newUint8StringMapValue(m *map[uint8]string) *uint8StringMapValue {
 func (v *uint8StringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -1173,17 +1216,17 @@ func newUint16StringMapValue(m *map[uint16]string) *uint16StringMapValue {
 func (v *uint16StringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -1238,17 +1281,17 @@ func newUint32StringMapValue(m *map[uint32]string) *uint32StringMapValue {
 func (v *uint32StringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -1303,17 +1346,17 @@ func newUint64StringMapValue(m *map[uint64]string) *uint64StringMapValue {
 func (v *uint64StringMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -1398,6 +1441,7 @@ var (
 	_ RepeatableFlag = (*boolSliceValue)(nil)
 	_ Value          = (*boolSliceValue)(nil)
 	_ Getter         = (*boolSliceValue)(nil)
+	_ Resetter       = (*boolSliceValue)(nil)
 )
 
 func newBoolSliceValue(slice *[]bool) *boolSliceValue {
@@ -1413,7 +1457,7 @@ func (v *boolSliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = parsed
 	}
@@ -1451,6 +1495,8 @@ func (v *boolSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *boolSliceValue) Reset() { v.changed = false }
+
 // -- stringBoolMapValue.
 type stringBoolMapValue struct {
 	value *map[string]bool
@@ -1471,13 +1517,13 @@ func newStringBoolMapValue(m *map[string]bool) *stringBoolMapValue {
 func (v *stringBoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -1485,7 +1531,7 @@ func (v *stringBoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -1536,17 +1582,17 @@ func newIntBoolMapValue(m *map[int]bool) *intBoolMapValue {
 func (v *intBoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -1555,7 +1601,7 @@ func (v *intBoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -1606,17 +1652,17 @@ func newInt8BoolMapValue(m *map[int8]bool) *int8BoolMapValue {
 func (v *int8BoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -1625,7 +1671,7 @@ func (v *int8BoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -1676,17 +1722,17 @@ func newInt16BoolMapValue(m *map[int16]bool) *int16BoolMapValue {
 func (v *int16BoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -1695,7 +1741,7 @@ func (v *int16BoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -1746,17 +1792,17 @@ func newInt32BoolMapValue(m *map[int32]bool) *int32BoolMapValue {
 func (v *int32BoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -1765,7 +1811,7 @@ func (v *int32BoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -1816,17 +1862,17 @@ func newInt64BoolMapValue(m *map[int64]bool) *int64BoolMapValue {
 func (v *int64BoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -1835,7 +1881,7 @@ func (v *int64BoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -1886,17 +1932,17 @@ func newUintBoolMapValue(m *map[uint]bool) *uintBoolMapValue {
 func (v *uintBoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -1905,7 +1951,7 @@ func (v *uintBoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -1956,17 +2002,17 @@ func newUint8BoolMapValue(m *map[uint8]bool) *uint8BoolMapValue {
 func (v *uint8BoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -1975,7 +2021,7 @@ func (v *uint8BoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -2026,17 +2072,17 @@ func newUint16BoolMapValue(m *map[uint16]bool) *uint16BoolMapValue {
 func (v *uint16BoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -2045,7 +2091,7 @@ func (v *uint16BoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -2096,17 +2142,17 @@ func newUint32BoolMapValue(m *map[uint32]bool) *uint32BoolMapValue {
 func (v *uint32BoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -2115,7 +2161,7 @@ func (v *uint32BoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -2166,17 +2212,17 @@ func newUint64BoolMapValue(m *map[uint64]bool) *uint64BoolMapValue {
 func (v *uint64BoolMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -2185,7 +2231,7 @@ func (v *uint64BoolMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseBool(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -2266,6 +2312,7 @@ var (
 	_ RepeatableFlag = (*uintSliceValue)(nil)
 	_ Value          = (*uintSliceValue)(nil)
 	_ Getter         = (*uintSliceValue)(nil)
+	_ Resetter       = (*uintSliceValue)(nil)
 )
 
 func newUintSliceValue(slice *[]uint) *uintSliceValue {
@@ -2281,7 +2328,7 @@ func (v *uintSliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = (uint)(parsed)
 	}
@@ -2319,6 +2366,8 @@ func (v *uintSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *uintSliceValue) Reset() { v.changed = false }
+
 // -- stringUintMapValue.
 type stringUintMapValue struct {
 	value *map[string]uint
@@ -2339,13 +2388,13 @@ func newStringUintMapValue(m *map[string]uint) *stringUintMapValue {
 func (v *stringUintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -2353,7 +2402,7 @@ func (v *stringUintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -2404,17 +2453,17 @@ func newIntUintMapValue(m *map[int]uint) *intUintMapValue {
 func (v *intUintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -2423,7 +2472,7 @@ func (v *intUintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -2474,17 +2523,17 @@ func newInt8UintMapValue(m *map[int8]uint) *int8UintMapValue {
 func (v *int8UintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -2493,7 +2542,7 @@ func (v *int8UintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -2544,17 +2593,17 @@ func newInt16UintMapValue(m *map[int16]uint) *int16UintMapValue {
 func (v *int16UintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -2563,7 +2612,7 @@ func (v *int16UintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -2614,17 +2663,17 @@ func newInt32UintMapValue(m *map[int32]uint) *int32UintMapValue {
 func (v *int32UintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -2633,7 +2682,7 @@ func (v *int32UintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -2684,17 +2733,17 @@ func newInt64UintMapValue(m *map[int64]uint) *int64UintMapValue {
 func (v *int64UintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -2703,7 +2752,7 @@ func (v *int64UintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -2754,17 +2803,17 @@ func newUintUintMapValue(m *map[uint]uint) *uintUintMapValue {
 func (v *uintUintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -2773,7 +2822,7 @@ func (v *uintUintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -2824,17 +2873,17 @@ func newUint8UintMapValue(m *map[uint8]uint) *uint8UintMapValue {
 func (v *uint8UintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -2843,7 +2892,7 @@ func (v *uint8UintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -2894,17 +2943,17 @@ func newUint16UintMapValue(m *map[uint16]uint) *uint16UintMapValue {
 func (v *uint16UintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -2913,7 +2962,7 @@ func (v *uint16UintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -2964,17 +3013,17 @@ func newUint32UintMapValue(m *map[uint32]uint) *uint32UintMapValue {
 func (v *uint32UintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -2983,7 +3032,7 @@ func (v *uint32UintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -3034,17 +3083,17 @@ func newUint64UintMapValue(m *map[uint64]uint) *uint64UintMapValue {
 func (v *uint64UintMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -3053,7 +3102,7 @@ func (v *uint64UintMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint)(parsedVal)
@@ -3134,6 +3183,7 @@ var (
 	_ RepeatableFlag = (*uint8SliceValue)(nil)
 	_ Value          = (*uint8SliceValue)(nil)
 	_ Getter         = (*uint8SliceValue)(nil)
+	_ Resetter       = (*uint8SliceValue)(nil)
 )
 
 func newUint8SliceValue(slice *[]uint8) *uint8SliceValue {
@@ -3149,7 +3199,7 @@ func (v *uint8SliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = (uint8)(parsed)
 	}
@@ -3187,6 +3237,8 @@ func (v *uint8SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *uint8SliceValue) Reset() { v.changed = false }
+
 // -- stringUint8MapValue.
 type stringUint8MapValue struct {
 	value *map[string]uint8
@@ -3207,13 +3259,13 @@ func newStringUint8MapValue(m *map[string]uint8) *stringUint8MapValue {
 func (v *stringUint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -3221,7 +3273,7 @@ func (v *stringUint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -3272,17 +3324,17 @@ func newIntUint8MapValue(m *map[int]uint8) *intUint8MapValue {
 func (v *intUint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -3291,7 +3343,7 @@ func (v *intUint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -3342,17 +3394,17 @@ func newInt8Uint8MapValue(m *map[int8]uint8) *int8Uint8MapValue {
 func (v *int8Uint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -3361,7 +3413,7 @@ func (v *int8Uint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -3412,17 +3464,17 @@ func newInt16Uint8MapValue(m *map[int16]uint8) *int16Uint8MapValue {
 func (v *int16Uint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -3431,7 +3483,7 @@ func (v *int16Uint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -3482,17 +3534,17 @@ func newInt32Uint8MapValue(m *map[int32]uint8) *int32Uint8MapValue {
 func (v *int32Uint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -3501,7 +3553,7 @@ func (v *int32Uint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -3552,17 +3604,17 @@ func newInt64Uint8MapValue(m *map[int64]uint8) *int64Uint8MapValue {
 func (v *int64Uint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -3571,7 +3623,7 @@ func (v *int64Uint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -3622,17 +3674,17 @@ func newUintUint8MapValue(m *map[uint]uint8) *uintUint8MapValue {
 func (v *uintUint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -3641,7 +3693,7 @@ func (v *uintUint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -3692,17 +3744,17 @@ func newUint8Uint8MapValue(m *map[uint8]uint8) *uint8Uint8MapValue {
 func (v *uint8Uint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -3711,7 +3763,7 @@ func (v *uint8Uint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -3762,17 +3814,17 @@ func newUint16Uint8MapValue(m *map[uint16]uint8) *uint16Uint8MapValue {
 func (v *uint16Uint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -3781,7 +3833,7 @@ func (v *uint16Uint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -3832,17 +3884,17 @@ func newUint32Uint8MapValue(m *map[uint32]uint8) *uint32Uint8MapValue {
 func (v *uint32Uint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -3851,7 +3903,7 @@ func (v *uint32Uint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -3902,17 +3954,17 @@ func newUint64Uint8MapValue(m *map[uint64]uint8) *uint64Uint8MapValue {
 func (v *uint64Uint8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -3921,7 +3973,7 @@ func (v *uint64Uint8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint8)(parsedVal)
@@ -4002,6 +4054,7 @@ var (
 	_ RepeatableFlag = (*uint16SliceValue)(nil)
 	_ Value          = (*uint16SliceValue)(nil)
 	_ Getter         = (*uint16SliceValue)(nil)
+	_ Resetter       = (*uint16SliceValue)(nil)
 )
 
 func newUint16SliceValue(slice *[]uint16) *uint16SliceValue {
@@ -4017,7 +4070,7 @@ func (v *uint16SliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = (uint16)(parsed)
 	}
@@ -4055,6 +4108,8 @@ func (v *uint16SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *uint16SliceValue) Reset() { v.changed = false }
+
 // -- stringUint16MapValue.
 type stringUint16MapValue struct {
 	value *map[string]uint16
@@ -4075,13 +4130,13 @@ func newStringUint16MapValue(m *map[string]uint16) *stringUint16MapValue {
 func (v *stringUint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -4089,7 +4144,7 @@ func (v *stringUint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4140,17 +4195,17 @@ func newIntUint16MapValue(m *map[int]uint16) *intUint16MapValue {
 func (v *intUint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -4159,7 +4214,7 @@ func (v *intUint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4210,17 +4265,17 @@ func newInt8Uint16MapValue(m *map[int8]uint16) *int8Uint16MapValue {
 func (v *int8Uint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -4229,7 +4284,7 @@ func (v *int8Uint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4280,17 +4335,17 @@ func newInt16Uint16MapValue(m *map[int16]uint16) *int16Uint16MapValue {
 func (v *int16Uint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -4299,7 +4354,7 @@ func (v *int16Uint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4350,17 +4405,17 @@ func newInt32Uint16MapValue(m *map[int32]uint16) *int32Uint16MapValue {
 func (v *int32Uint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -4369,7 +4424,7 @@ func (v *int32Uint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4420,17 +4475,17 @@ func newInt64Uint16MapValue(m *map[int64]uint16) *int64Uint16MapValue {
 func (v *int64Uint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -4439,7 +4494,7 @@ func (v *int64Uint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4490,17 +4545,17 @@ func newUintUint16MapValue(m *map[uint]uint16) *uintUint16MapValue {
 func (v *uintUint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -4509,7 +4564,7 @@ func (v *uintUint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4560,17 +4615,17 @@ func newUint8Uint16MapValue(m *map[uint8]uint16) *uint8Uint16MapValue {
 func (v *uint8Uint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -4579,7 +4634,7 @@ func (v *uint8Uint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4630,17 +4685,17 @@ func newUint16Uint16MapValue(m *map[uint16]uint16) *uint16Uint16MapValue {
 func (v *uint16Uint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -4649,7 +4704,7 @@ func (v *uint16Uint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4700,17 +4755,17 @@ func newUint32Uint16MapValue(m *map[uint32]uint16) *uint32Uint16MapValue {
 func (v *uint32Uint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -4719,7 +4774,7 @@ func (v *uint32Uint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4770,17 +4825,17 @@ func newUint64Uint16MapValue(m *map[uint64]uint16) *uint64Uint16MapValue {
 func (v *uint64Uint16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -4789,7 +4844,7 @@ func (v *uint64Uint16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint16)(parsedVal)
@@ -4870,6 +4925,7 @@ var (
 	_ RepeatableFlag = (*uint32SliceValue)(nil)
 	_ Value          = (*uint32SliceValue)(nil)
 	_ Getter         = (*uint32SliceValue)(nil)
+	_ Resetter       = (*uint32SliceValue)(nil)
 )
 
 func newUint32SliceValue(slice *[]uint32) *uint32SliceValue {
@@ -4885,7 +4941,7 @@ func (v *uint32SliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = (uint32)(parsed)
 	}
@@ -4923,6 +4979,8 @@ func (v *uint32SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *uint32SliceValue) Reset() { v.changed = false }
+
 // -- stringUint32MapValue.
 type stringUint32MapValue struct {
 	value *map[string]uint32
@@ -4943,13 +5001,13 @@ func newStringUint32MapValue(m *map[string]uint32) *stringUint32MapValue {
 func (v *stringUint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -4957,7 +5015,7 @@ func (v *stringUint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5008,17 +5066,17 @@ func newIntUint32MapValue(m *map[int]uint32) *intUint32MapValue {
 func (v *intUint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -5027,7 +5085,7 @@ func (v *intUint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5078,17 +5136,17 @@ func newInt8Uint32MapValue(m *map[int8]uint32) *int8Uint32MapValue {
 func (v *int8Uint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -5097,7 +5155,7 @@ func (v *int8Uint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5148,17 +5206,17 @@ func newInt16Uint32MapValue(m *map[int16]uint32) *int16Uint32MapValue {
 func (v *int16Uint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -5167,7 +5225,7 @@ func (v *int16Uint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5218,17 +5276,17 @@ func newInt32Uint32MapValue(m *map[int32]uint32) *int32Uint32MapValue {
 func (v *int32Uint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -5237,7 +5295,7 @@ func (v *int32Uint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5288,17 +5346,17 @@ func newInt64Uint32MapValue(m *map[int64]uint32) *int64Uint32MapValue {
 func (v *int64Uint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -5307,7 +5365,7 @@ func (v *int64Uint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5358,17 +5416,17 @@ func newUintUint32MapValue(m *map[uint]uint32) *uintUint32MapValue {
 func (v *uintUint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -5377,7 +5435,7 @@ func (v *uintUint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5428,17 +5486,17 @@ func newUint8Uint32MapValue(m *map[uint8]uint32) *uint8Uint32MapValue {
 func (v *uint8Uint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -5447,7 +5505,7 @@ func (v *uint8Uint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5498,17 +5556,17 @@ func newUint16Uint32MapValue(m *map[uint16]uint32) *uint16Uint32MapValue {
 func (v *uint16Uint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -5517,7 +5575,7 @@ func (v *uint16Uint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5568,17 +5626,17 @@ func newUint32Uint32MapValue(m *map[uint32]uint32) *uint32Uint32MapValue {
 func (v *uint32Uint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -5587,7 +5645,7 @@ func (v *uint32Uint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5638,17 +5696,17 @@ func newUint64Uint32MapValue(m *map[uint64]uint32) *uint64Uint32MapValue {
 func (v *uint64Uint32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -5657,7 +5715,7 @@ func (v *uint64Uint32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (uint32)(parsedVal)
@@ -5738,6 +5796,7 @@ var (
 	_ RepeatableFlag = (*uint64SliceValue)(nil)
 	_ Value          = (*uint64SliceValue)(nil)
 	_ Getter         = (*uint64SliceValue)(nil)
+	_ Resetter       = (*uint64SliceValue)(nil)
 )
 
 func newUint64SliceValue(slice *[]uint64) *uint64SliceValue {
@@ -5753,7 +5812,7 @@ func (v *uint64SliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = parsed
 	}
@@ -5791,6 +5850,8 @@ func (v *uint64SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *uint64SliceValue) Reset() { v.changed = false }
+
 // -- stringUint64MapValue.
 type stringUint64MapValue struct {
 	value *map[string]uint64
@@ -5811,13 +5872,13 @@ func newStringUint64MapValue(m *map[string]uint64) *stringUint64MapValue {
 func (v *stringUint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -5825,7 +5886,7 @@ func (v *stringUint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -5876,17 +5937,17 @@ func newIntUint64MapValue(m *map[int]uint64) *intUint64MapValue {
 func (v *intUint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -5895,7 +5956,7 @@ func (v *intUint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -5946,17 +6007,17 @@ func newInt8Uint64MapValue(m *map[int8]uint64) *int8Uint64MapValue {
 func (v *int8Uint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -5965,7 +6026,7 @@ func (v *int8Uint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -6016,17 +6077,17 @@ func newInt16Uint64MapValue(m *map[int16]uint64) *int16Uint64MapValue {
 func (v *int16Uint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -6035,7 +6096,7 @@ func (v *int16Uint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -6086,17 +6147,17 @@ func newInt32Uint64MapValue(m *map[int32]uint64) *int32Uint64MapValue {
 func (v *int32Uint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -6105,7 +6166,7 @@ func (v *int32Uint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -6156,17 +6217,17 @@ func newInt64Uint64MapValue(m *map[int64]uint64) *int64Uint64MapValue {
 func (v *int64Uint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -6175,7 +6236,7 @@ func (v *int64Uint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -6226,17 +6287,17 @@ func newUintUint64MapValue(m *map[uint]uint64) *uintUint64MapValue {
 func (v *uintUint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -6245,7 +6306,7 @@ func (v *uintUint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -6296,17 +6357,17 @@ func newUint8Uint64MapValue(m *map[uint8]uint64) *uint8Uint64MapValue {
 func (v *uint8Uint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -6315,7 +6376,7 @@ func (v *uint8Uint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -6366,17 +6427,17 @@ func newUint16Uint64MapValue(m *map[uint16]uint64) *uint16Uint64MapValue {
 func (v *uint16Uint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -6385,7 +6446,7 @@ func (v *uint16Uint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -6436,17 +6497,17 @@ func newUint32Uint64MapValue(m *map[uint32]uint64) *uint32Uint64MapValue {
 func (v *uint32Uint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -6455,7 +6516,7 @@ func (v *uint32Uint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -6506,17 +6567,17 @@ func newUint64Uint64MapValue(m *map[uint64]uint64) *uint64Uint64MapValue {
 func (v *uint64Uint64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -6525,7 +6586,7 @@ func (v *uint64Uint64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -6606,6 +6667,7 @@ var (
 	_ RepeatableFlag = (*intSliceValue)(nil)
 	_ Value          = (*intSliceValue)(nil)
 	_ Getter         = (*intSliceValue)(nil)
+	_ Resetter       = (*intSliceValue)(nil)
 )
 
 func newIntSliceValue(slice *[]int) *intSliceValue {
@@ -6621,7 +6683,7 @@ func (v *intSliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = (int)(parsed)
 	}
@@ -6659,6 +6721,8 @@ func (v *intSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *intSliceValue) Reset() { v.changed = false }
+
 // -- stringIntMapValue.
 type stringIntMapValue struct {
 	value *map[string]int
@@ -6679,13 +6743,13 @@ func newStringIntMapValue(m *map[string]int) *stringIntMapValue {
 func (v *stringIntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -6693,7 +6757,7 @@ func (v *stringIntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -6744,17 +6808,17 @@ func newIntIntMapValue(m *map[int]int) *intIntMapValue {
 func (v *intIntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -6763,7 +6827,7 @@ func (v *intIntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -6814,17 +6878,17 @@ func newInt8IntMapValue(m *map[int8]int) *int8IntMapValue {
 func (v *int8IntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -6833,7 +6897,7 @@ func (v *int8IntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -6884,17 +6948,17 @@ func newInt16IntMapValue(m *map[int16]int) *int16IntMapValue {
 func (v *int16IntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -6903,7 +6967,7 @@ func (v *int16IntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -6954,17 +7018,17 @@ func newInt32IntMapValue(m *map[int32]int) *int32IntMapValue {
 func (v *int32IntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -6973,7 +7037,7 @@ func (v *int32IntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -7024,17 +7088,17 @@ func newInt64IntMapValue(m *map[int64]int) *int64IntMapValue {
 func (v *int64IntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -7043,7 +7107,7 @@ func (v *int64IntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -7094,17 +7158,17 @@ func newUintIntMapValue(m *map[uint]int) *uintIntMapValue {
 func (v *uintIntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -7113,7 +7177,7 @@ func (v *uintIntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -7164,17 +7228,17 @@ func newUint8IntMapValue(m *map[uint8]int) *uint8IntMapValue {
 func (v *uint8IntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -7183,7 +7247,7 @@ func (v *uint8IntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -7234,17 +7298,17 @@ func newUint16IntMapValue(m *map[uint16]int) *uint16IntMapValue {
 func (v *uint16IntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -7253,7 +7317,7 @@ func (v *uint16IntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -7304,17 +7368,17 @@ func newUint32IntMapValue(m *map[uint32]int) *uint32IntMapValue {
 func (v *uint32IntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -7323,7 +7387,7 @@ func (v *uint32IntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -7374,17 +7438,17 @@ func newUint64IntMapValue(m *map[uint64]int) *uint64IntMapValue {
 func (v *uint64IntMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -7393,7 +7457,7 @@ func (v *uint64IntMapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int)(parsedVal)
@@ -7474,6 +7538,7 @@ var (
 	_ RepeatableFlag = (*int8SliceValue)(nil)
 	_ Value          = (*int8SliceValue)(nil)
 	_ Getter         = (*int8SliceValue)(nil)
+	_ Resetter       = (*int8SliceValue)(nil)
 )
 
 func newInt8SliceValue(slice *[]int8) *int8SliceValue {
@@ -7489,7 +7554,7 @@ func (v *int8SliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = (int8)(parsed)
 	}
@@ -7527,6 +7592,8 @@ func (v *int8SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *int8SliceValue) Reset() { v.changed = false }
+
 // -- stringInt8MapValue.
 type stringInt8MapValue struct {
 	value *map[string]int8
@@ -7547,13 +7614,13 @@ func newStringInt8MapValue(m *map[string]int8) *stringInt8MapValue {
 func (v *stringInt8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -7561,7 +7628,7 @@ func (v *stringInt8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -7612,17 +7679,17 @@ func newIntInt8MapValue(m *map[int]int8) *intInt8MapValue {
 func (v *intInt8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -7631,7 +7698,7 @@ func (v *intInt8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -7682,17 +7749,17 @@ func newInt8Int8MapValue(m *map[int8]int8) *int8Int8MapValue {
 func (v *int8Int8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -7701,7 +7768,7 @@ func (v *int8Int8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -7752,17 +7819,17 @@ func newInt16Int8MapValue(m *map[int16]int8) *int16Int8MapValue {
 func (v *int16Int8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -7771,7 +7838,7 @@ func (v *int16Int8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -7822,17 +7889,17 @@ func newInt32Int8MapValue(m *map[int32]int8) *int32Int8MapValue {
 func (v *int32Int8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -7841,7 +7908,7 @@ func (v *int32Int8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -7892,17 +7959,17 @@ func newInt64Int8MapValue(m *map[int64]int8) *int64Int8MapValue {
 func (v *int64Int8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -7911,7 +7978,7 @@ func (v *int64Int8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -7962,17 +8029,17 @@ func newUintInt8MapValue(m *map[uint]int8) *uintInt8MapValue {
 func (v *uintInt8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -7981,7 +8048,7 @@ func (v *uintInt8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -8032,17 +8099,17 @@ func newUint8Int8MapValue(m *map[uint8]int8) *uint8Int8MapValue {
 func (v *uint8Int8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -8051,7 +8118,7 @@ func (v *uint8Int8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -8102,17 +8169,17 @@ func newUint16Int8MapValue(m *map[uint16]int8) *uint16Int8MapValue {
 func (v *uint16Int8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -8121,7 +8188,7 @@ func (v *uint16Int8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -8172,17 +8239,17 @@ func newUint32Int8MapValue(m *map[uint32]int8) *uint32Int8MapValue {
 func (v *uint32Int8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -8191,7 +8258,7 @@ func (v *uint32Int8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -8242,17 +8309,17 @@ func newUint64Int8MapValue(m *map[uint64]int8) *uint64Int8MapValue {
 func (v *uint64Int8MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -8261,7 +8328,7 @@ func (v *uint64Int8MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int8)(parsedVal)
@@ -8342,6 +8409,7 @@ var (
 	_ RepeatableFlag = (*int16SliceValue)(nil)
 	_ Value          = (*int16SliceValue)(nil)
 	_ Getter         = (*int16SliceValue)(nil)
+	_ Resetter       = (*int16SliceValue)(nil)
 )
 
 func newInt16SliceValue(slice *[]int16) *int16SliceValue {
@@ -8357,7 +8425,7 @@ func (v *int16SliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = (int16)(parsed)
 	}
@@ -8395,6 +8463,8 @@ func (v *int16SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *int16SliceValue) Reset() { v.changed = false }
+
 // -- stringInt16MapValue.
 type stringInt16MapValue struct {
 	value *map[string]int16
@@ -8415,13 +8485,13 @@ func newStringInt16MapValue(m *map[string]int16) *stringInt16MapValue {
 func (v *stringInt16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -8429,7 +8499,7 @@ func (v *stringInt16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -8480,17 +8550,17 @@ func newIntInt16MapValue(m *map[int]int16) *intInt16MapValue {
 func (v *intInt16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -8499,7 +8569,7 @@ func (v *intInt16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -8550,17 +8620,17 @@ func newInt8Int16MapValue(m *map[int8]int16) *int8Int16MapValue {
 func (v *int8Int16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -8569,7 +8639,7 @@ func (v *int8Int16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -8620,17 +8690,17 @@ func newInt16Int16MapValue(m *map[int16]int16) *int16Int16MapValue {
 func (v *int16Int16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -8639,7 +8709,7 @@ func (v *int16Int16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -8690,17 +8760,17 @@ func newInt32Int16MapValue(m *map[int32]int16) *int32Int16MapValue {
 func (v *int32Int16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -8709,7 +8779,7 @@ func (v *int32Int16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -8760,17 +8830,17 @@ func newInt64Int16MapValue(m *map[int64]int16) *int64Int16MapValue {
 func (v *int64Int16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -8779,7 +8849,7 @@ func (v *int64Int16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -8830,17 +8900,17 @@ func newUintInt16MapValue(m *map[uint]int16) *uintInt16MapValue {
 func (v *uintInt16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -8849,7 +8919,7 @@ func (v *uintInt16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -8900,17 +8970,17 @@ func newUint8Int16MapValue(m *map[uint8]int16) *uint8Int16MapValue {
 func (v *uint8Int16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -8919,7 +8989,7 @@ func (v *uint8Int16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -8970,17 +9040,17 @@ func newUint16Int16MapValue(m *map[uint16]int16) *uint16Int16MapValue {
 func (v *uint16Int16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -8989,7 +9059,7 @@ func (v *uint16Int16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -9040,17 +9110,17 @@ func newUint32Int16MapValue(m *map[uint32]int16) *uint32Int16MapValue {
 func (v *uint32Int16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -9059,7 +9129,7 @@ func (v *uint32Int16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -9110,17 +9180,17 @@ func newUint64Int16MapValue(m *map[uint64]int16) *uint64Int16MapValue {
 func (v *uint64Int16MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -9129,7 +9199,7 @@ func (v *uint64Int16MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int16)(parsedVal)
@@ -9210,6 +9280,7 @@ var (
 	_ RepeatableFlag = (*int32SliceValue)(nil)
 	_ Value          = (*int32SliceValue)(nil)
 	_ Getter         = (*int32SliceValue)(nil)
+	_ Resetter       = (*int32SliceValue)(nil)
 )
 
 func newInt32SliceValue(slice *[]int32) *int32SliceValue {
@@ -9225,7 +9296,7 @@ func (v *int32SliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = (int32)(parsed)
 	}
@@ -9263,6 +9334,8 @@ func (v *int32SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *int32SliceValue) Reset() { v.changed = false }
+
 // -- stringInt32MapValue.
 type stringInt32MapValue struct {
 	value *map[string]int32
@@ -9283,13 +9356,13 @@ func newStringInt32MapValue(m *map[string]int32) *stringInt32MapValue {
 func (v *stringInt32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -9297,7 +9370,7 @@ func (v *stringInt32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -9348,17 +9421,17 @@ func newIntInt32MapValue(m *map[int]int32) *intInt32MapValue {
 func (v *intInt32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -9367,7 +9440,7 @@ func (v *intInt32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -9418,17 +9491,17 @@ func newInt8Int32MapValue(m *map[int8]int32) *int8Int32MapValue {
 func (v *int8Int32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -9437,7 +9510,7 @@ func (v *int8Int32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -9488,17 +9561,17 @@ func newInt16Int32MapValue(m *map[int16]int32) *int16Int32MapValue {
 func (v *int16Int32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -9507,7 +9580,7 @@ func (v *int16Int32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -9558,17 +9631,17 @@ func newInt32Int32MapValue(m *map[int32]int32) *int32Int32MapValue {
 func (v *int32Int32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -9577,7 +9650,7 @@ func (v *int32Int32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -9628,17 +9701,17 @@ func newInt64Int32MapValue(m *map[int64]int32) *int64Int32MapValue {
 func (v *int64Int32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -9647,7 +9720,7 @@ func (v *int64Int32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -9698,17 +9771,17 @@ func newUintInt32MapValue(m *map[uint]int32) *uintInt32MapValue {
 func (v *uintInt32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -9717,7 +9790,7 @@ func (v *uintInt32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -9768,17 +9841,17 @@ func newUint8Int32MapValue(m *map[uint8]int32) *uint8Int32MapValue {
 func (v *uint8Int32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -9787,7 +9860,7 @@ func (v *uint8Int32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -9838,17 +9911,17 @@ func newUint16Int32MapValue(m *map[uint16]int32) *uint16Int32MapValue {
 func (v *uint16Int32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -9857,7 +9930,7 @@ func (v *uint16Int32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -9908,17 +9981,17 @@ func newUint32Int32MapValue(m *map[uint32]int32) *uint32Int32MapValue {
 func (v *uint32Int32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -9927,7 +10000,7 @@ func (v *uint32Int32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -9978,17 +10051,17 @@ func newUint64Int32MapValue(m *map[uint64]int32) *uint64Int32MapValue {
 func (v *uint64Int32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -9997,7 +10070,7 @@ func (v *uint64Int32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (int32)(parsedVal)
@@ -10078,6 +10151,7 @@ var (
 	_ RepeatableFlag = (*int64SliceValue)(nil)
 	_ Value          = (*int64SliceValue)(nil)
 	_ Getter         = (*int64SliceValue)(nil)
+	_ Resetter       = (*int64SliceValue)(nil)
 )
 
 func newInt64SliceValue(slice *[]int64) *int64SliceValue {
@@ -10093,7 +10167,7 @@ func (v *int64SliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = parsed
 	}
@@ -10131,6 +10205,8 @@ func (v *int64SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *int64SliceValue) Reset() { v.changed = false }
+
 // -- stringInt64MapValue.
 type stringInt64MapValue struct {
 	value *map[string]int64
@@ -10151,13 +10227,13 @@ func newStringInt64MapValue(m *map[string]int64) *stringInt64MapValue {
 func (v *stringInt64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -10165,7 +10241,7 @@ func (v *stringInt64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10216,17 +10292,17 @@ func newIntInt64MapValue(m *map[int]int64) *intInt64MapValue {
 func (v *intInt64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -10235,7 +10311,7 @@ func (v *intInt64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10286,17 +10362,17 @@ func newInt8Int64MapValue(m *map[int8]int64) *int8Int64MapValue {
 func (v *int8Int64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -10305,7 +10381,7 @@ func (v *int8Int64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10356,17 +10432,17 @@ func newInt16Int64MapValue(m *map[int16]int64) *int16Int64MapValue {
 func (v *int16Int64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -10375,7 +10451,7 @@ func (v *int16Int64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10426,17 +10502,17 @@ func newInt32Int64MapValue(m *map[int32]int64) *int32Int64MapValue {
 func (v *int32Int64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -10445,7 +10521,7 @@ func (v *int32Int64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10496,17 +10572,17 @@ func newInt64Int64MapValue(m *map[int64]int64) *int64Int64MapValue {
 func (v *int64Int64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -10515,7 +10591,7 @@ func (v *int64Int64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10566,17 +10642,17 @@ func newUintInt64MapValue(m *map[uint]int64) *uintInt64MapValue {
 func (v *uintInt64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -10585,7 +10661,7 @@ func (v *uintInt64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10636,17 +10712,17 @@ func newUint8Int64MapValue(m *map[uint8]int64) *uint8Int64MapValue {
 func (v *uint8Int64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -10655,7 +10731,7 @@ func (v *uint8Int64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10706,17 +10782,17 @@ func newUint16Int64MapValue(m *map[uint16]int64) *uint16Int64MapValue {
 func (v *uint16Int64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -10725,7 +10801,7 @@ func (v *uint16Int64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10776,17 +10852,17 @@ func newUint32Int64MapValue(m *map[uint32]int64) *uint32Int64MapValue {
 func (v *uint32Int64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -10795,7 +10871,7 @@ func (v *uint32Int64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10846,17 +10922,17 @@ func newUint64Int64MapValue(m *map[uint64]int64) *uint64Int64MapValue {
 func (v *uint64Int64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -10865,7 +10941,7 @@ func (v *uint64Int64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -10946,6 +11022,7 @@ var (
 	_ RepeatableFlag = (*float64SliceValue)(nil)
 	_ Value          = (*float64SliceValue)(nil)
 	_ Getter         = (*float64SliceValue)(nil)
+	_ Resetter       = (*float64SliceValue)(nil)
 )
 
 func newFloat64SliceValue(slice *[]float64) *float64SliceValue {
@@ -10961,7 +11038,7 @@ func (v *float64SliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = parsed
 	}
@@ -10999,6 +11076,8 @@ func (v *float64SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *float64SliceValue) Reset() { v.changed = false }
+
 // -- stringFloat64MapValue.
 type stringFloat64MapValue struct {
 	value *map[string]float64
@@ -11019,13 +11098,13 @@ func newStringFloat64MapValue(m *map[string]float64) *stringFloat64MapValue {
 func (v *stringFloat64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -11033,7 +11112,7 @@ func (v *stringFloat64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11084,17 +11163,17 @@ func newIntFloat64MapValue(m *map[int]float64) *intFloat64MapValue {
 func (v *intFloat64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -11103,7 +11182,7 @@ func (v *intFloat64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11154,17 +11233,17 @@ func newInt8Float64MapValue(m *map[int8]float64) *int8Float64MapValue {
 func (v *int8Float64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -11173,7 +11252,7 @@ func (v *int8Float64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11224,17 +11303,17 @@ func newInt16Float64MapValue(m *map[int16]float64) *int16Float64MapValue {
 func (v *int16Float64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -11243,7 +11322,7 @@ func (v *int16Float64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11294,17 +11373,17 @@ func newInt32Float64MapValue(m *map[int32]float64) *int32Float64MapValue {
 func (v *int32Float64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -11313,7 +11392,7 @@ func (v *int32Float64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11364,17 +11443,17 @@ func newInt64Float64MapValue(m *map[int64]float64) *int64Float64MapValue {
 func (v *int64Float64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -11383,7 +11462,7 @@ func (v *int64Float64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11434,17 +11513,17 @@ func newUintFloat64MapValue(m *map[uint]float64) *uintFloat64MapValue {
 func (v *uintFloat64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -11453,7 +11532,7 @@ func (v *uintFloat64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11504,17 +11583,17 @@ func newUint8Float64MapValue(m *map[uint8]float64) *uint8Float64MapValue {
 func (v *uint8Float64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -11523,7 +11602,7 @@ func (v *uint8Float64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11574,17 +11653,17 @@ func newUint16Float64MapValue(m *map[uint16]float64) *uint16Float64MapValue {
 func (v *uint16Float64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -11593,7 +11672,7 @@ func (v *uint16Float64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11644,17 +11723,17 @@ func newUint32Float64MapValue(m *map[uint32]float64) *uint32Float64MapValue {
 func (v *uint32Float64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -11663,7 +11742,7 @@ func (v *uint32Float64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11714,17 +11793,17 @@ func newUint64Float64MapValue(m *map[uint64]float64) *uint64Float64MapValue {
 func (v *uint64Float64MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -11733,7 +11812,7 @@ func (v *uint64Float64MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -11814,6 +11893,7 @@ var (
 	_ RepeatableFlag = (*float32SliceValue)(nil)
 	_ Value          = (*float32SliceValue)(nil)
 	_ Getter         = (*float32SliceValue)(nil)
+	_ Resetter       = (*float32SliceValue)(nil)
 )
 
 func newFloat32SliceValue(slice *[]float32) *float32SliceValue {
@@ -11829,7 +11909,7 @@ func (v *float32SliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = (float32)(parsed)
 	}
@@ -11867,6 +11947,8 @@ func (v *float32SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *float32SliceValue) Reset() { v.changed = false }
+
 // -- stringFloat32MapValue.
 type stringFloat32MapValue struct {
 	value *map[string]float32
@@ -11887,13 +11969,13 @@ func newStringFloat32MapValue(m *map[string]float32) *stringFloat32MapValue {
 func (v *stringFloat32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -11901,7 +11983,7 @@ func (v *stringFloat32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -11952,17 +12034,17 @@ func newIntFloat32MapValue(m *map[int]float32) *intFloat32MapValue {
 func (v *intFloat32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -11971,7 +12053,7 @@ func (v *intFloat32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -12022,17 +12104,17 @@ func newInt8Float32MapValue(m *map[int8]float32) *int8Float32MapValue {
 func (v *int8Float32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -12041,7 +12123,7 @@ func (v *int8Float32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -12092,17 +12174,17 @@ func newInt16Float32MapValue(m *map[int16]float32) *int16Float32MapValue {
 func (v *int16Float32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -12111,7 +12193,7 @@ func (v *int16Float32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -12162,17 +12244,17 @@ func newInt32Float32MapValue(m *map[int32]float32) *int32Float32MapValue {
 func (v *int32Float32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -12181,7 +12263,7 @@ func (v *int32Float32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -12232,17 +12314,17 @@ func newInt64Float32MapValue(m *map[int64]float32) *int64Float32MapValue {
 func (v *int64Float32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -12251,7 +12333,7 @@ func (v *int64Float32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -12302,17 +12384,17 @@ func newUintFloat32MapValue(m *map[uint]float32) *uintFloat32MapValue {
 func (v *uintFloat32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -12321,7 +12403,7 @@ func (v *uintFloat32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -12372,17 +12454,17 @@ func newUint8Float32MapValue(m *map[uint8]float32) *uint8Float32MapValue {
 func (v *uint8Float32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -12391,7 +12473,7 @@ func (v *uint8Float32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -12442,17 +12524,17 @@ func newUint16Float32MapValue(m *map[uint16]float32) *uint16Float32MapValue {
 func (v *uint16Float32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -12461,7 +12543,7 @@ func (v *uint16Float32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -12512,17 +12594,17 @@ func newUint32Float32MapValue(m *map[uint32]float32) *uint32Float32MapValue {
 func (v *uint32Float32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -12531,7 +12613,7 @@ func (v *uint32Float32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -12582,17 +12664,17 @@ func newUint64Float32MapValue(m *map[uint64]float32) *uint64Float32MapValue {
 func (v *uint64Float32MapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -12601,7 +12683,7 @@ func (v *uint64Float32MapValue) Set(val string) error {
 
 		parsedVal, err := strconv.ParseFloat(s, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := (float32)(parsedVal)
@@ -12682,6 +12764,7 @@ var (
 	_ RepeatableFlag = (*durationSliceValue)(nil)
 	_ Value          = (*durationSliceValue)(nil)
 	_ Getter         = (*durationSliceValue)(nil)
+	_ Resetter       = (*durationSliceValue)(nil)
 )
 
 func newDurationSliceValue(slice *[]time.Duration) *durationSliceValue {
@@ -12697,7 +12780,7 @@ func (v *durationSliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = parsed
 	}
@@ -12735,6 +12818,8 @@ func (v *durationSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *durationSliceValue) Reset() { v.changed = false }
+
 // -- stringDurationMapValue.
 type stringDurationMapValue struct {
 	value *map[string]time.Duration
@@ -12755,13 +12840,13 @@ func newStringDurationMapValue(m *map[string]time.Duration) *stringDurationMapVa
 func (v *stringDurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -12769,7 +12854,7 @@ func (v *stringDurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -12820,17 +12905,17 @@ func newIntDurationMapValue(m *map[int]time.Duration) *intDurationMapValue {
 func (v *intDurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -12839,7 +12924,7 @@ func (v *intDurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -12890,17 +12975,17 @@ func newInt8DurationMapValue(m *map[int8]time.Duration) *int8DurationMapValue {
 func (v *int8DurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -12909,7 +12994,7 @@ func (v *int8DurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -12960,17 +13045,17 @@ func newInt16DurationMapValue(m *map[int16]time.Duration) *int16DurationMapValue
 func (v *int16DurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -12979,7 +13064,7 @@ func (v *int16DurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13030,17 +13115,17 @@ func newInt32DurationMapValue(m *map[int32]time.Duration) *int32DurationMapValue
 func (v *int32DurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -13049,7 +13134,7 @@ func (v *int32DurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13100,17 +13185,17 @@ func newInt64DurationMapValue(m *map[int64]time.Duration) *int64DurationMapValue
 func (v *int64DurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -13119,7 +13204,7 @@ func (v *int64DurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13170,17 +13255,17 @@ func newUintDurationMapValue(m *map[uint]time.Duration) *uintDurationMapValue {
 func (v *uintDurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -13189,7 +13274,7 @@ func (v *uintDurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13240,17 +13325,17 @@ func newUint8DurationMapValue(m *map[uint8]time.Duration) *uint8DurationMapValue
 func (v *uint8DurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -13259,7 +13344,7 @@ func (v *uint8DurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13310,17 +13395,17 @@ func newUint16DurationMapValue(m *map[uint16]time.Duration) *uint16DurationMapVa
 func (v *uint16DurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -13329,7 +13414,7 @@ func (v *uint16DurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13380,17 +13465,17 @@ func newUint32DurationMapValue(m *map[uint32]time.Duration) *uint32DurationMapVa
 func (v *uint32DurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -13399,7 +13484,7 @@ func (v *uint32DurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13450,17 +13535,17 @@ func newUint64DurationMapValue(m *map[uint64]time.Duration) *uint64DurationMapVa
 func (v *uint64DurationMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -13469,7 +13554,7 @@ func (v *uint64DurationMapValue) Set(val string) error {
 
 		parsedVal, err := time.ParseDuration(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13500,22 +13585,24 @@ func (v *uint64DurationMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- net.IP Value.
-type ipValue struct {
-	value *net.IP
+// -- time.Time Value.
+type timeValue struct {
+	value  *time.Time
+	layout string
 }
 
 var (
-	_ Value  = (*ipValue)(nil)
-	_ Getter = (*ipValue)(nil)
+	_ Value       = (*timeValue)(nil)
+	_ Getter      = (*timeValue)(nil)
+	_ layoutValue = (*timeValue)(nil)
 )
 
-func newIPValue(p *net.IP) *ipValue {
-	return &ipValue{value: p}
+func newTimeValue(p *time.Time) *timeValue {
+	return &timeValue{value: p, layout: DefaultTimeLayout}
 }
 
-func (v *ipValue) Set(s string) error {
-	parsed, err := parseIP(s)
+func (v *timeValue) Set(s string) error {
+	parsed, err := time.Parse(v.layout, strings.TrimSpace(s))
 	if err == nil {
 		*v.value = parsed
 		return nil
@@ -13523,49 +13610,56 @@ func (v *ipValue) Set(s string) error {
 	return err
 }
 
-func (v *ipValue) Get() interface{} {
+func (v *timeValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *ipValue) String() string {
+func (v *timeValue) String() string {
 	if v != nil && v.value != nil {
-		return v.value.String()
+		return formatTime(*v.value, v.layout)
 	}
 	return ""
 }
 
-func (v *ipValue) Type() string { return "ip" }
+func (v *timeValue) Type() string { return "time" }
 
-// -- net.IPSlice Value
+func (v *timeValue) setLayout(layout string) { v.layout = layout }
 
-type ipSliceValue struct {
-	value   *[]net.IP
+// -- time.TimeSlice Value
+
+type timeSliceValue struct {
+	value   *[]time.Time
+	layout  string
 	changed bool
 }
 
 var (
-	_ RepeatableFlag = (*ipSliceValue)(nil)
-	_ Value          = (*ipSliceValue)(nil)
-	_ Getter         = (*ipSliceValue)(nil)
+	_ RepeatableFlag = (*timeSliceValue)(nil)
+	_ Value          = (*timeSliceValue)(nil)
+	_ Getter         = (*timeSliceValue)(nil)
+	_ Resetter       = (*timeSliceValue)(nil)
+	_ layoutValue    = (*timeSliceValue)(nil)
 )
 
-func newIPSliceValue(slice *[]net.IP) *ipSliceValue {
-	return &ipSliceValue{
-		value: slice,
+func newTimeSliceValue(slice *[]time.Time) *timeSliceValue {
+	return &timeSliceValue{
+		value:  slice,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *ipSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+func (v *timeSliceValue) Set(raw string) error {
+	// Layouts might contain commas: each value is a single element.
+	ss := []string{raw}
 
-	out := make([]net.IP, len(ss))
+	out := make([]time.Time, len(ss))
 	for i, s := range ss {
-		parsed, err := parseIP(s)
+		parsed, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = parsed
 	}
@@ -13579,65 +13673,74 @@ func (v *ipSliceValue) Set(raw string) error {
 	return nil
 }
 
-func (v *ipSliceValue) Get() interface{} {
+func (v *timeSliceValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
-	return ([]net.IP)(nil)
+	return ([]time.Time)(nil)
 }
 
-func (v *ipSliceValue) String() string {
+func (v *timeSliceValue) String() string {
 	if v == nil || v.value == nil {
 		return "[]"
 	}
 	out := make([]string, 0, len(*v.value))
 	for _, elem := range *v.value {
-		out = append(out, newIPValue(&elem).String())
+		out = append(out, (&timeValue{value: &elem, layout: v.layout}).String())
 	}
 	return "[" + strings.Join(out, ",") + "]"
 }
 
-func (v *ipSliceValue) Type() string { return "ipSlice" }
+func (v *timeSliceValue) Type() string { return "timeSlice" }
 
-func (v *ipSliceValue) IsCumulative() bool {
+func (v *timeSliceValue) IsCumulative() bool {
 	return true
 }
 
-// -- stringIPMapValue.
-type stringIPMapValue struct {
-	value *map[string]net.IP
+func (v *timeSliceValue) Reset() { v.changed = false }
+
+func (v *timeSliceValue) setLayout(layout string) { v.layout = layout }
+
+// -- stringTimeMapValue.
+type stringTimeMapValue struct {
+	value  *map[string]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*stringIPMapValue)(nil)
-	_ Value          = (*stringIPMapValue)(nil)
-	_ Getter         = (*stringIPMapValue)(nil)
+	_ RepeatableFlag = (*stringTimeMapValue)(nil)
+	_ Value          = (*stringTimeMapValue)(nil)
+	_ Getter         = (*stringTimeMapValue)(nil)
+	_ layoutValue    = (*stringTimeMapValue)(nil)
 )
 
-func newStringIPMapValue(m *map[string]net.IP) *stringIPMapValue {
-	return &stringIPMapValue{
-		value: m,
+func newStringTimeMapValue(m *map[string]time.Time) *stringTimeMapValue {
+	return &stringTimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *stringIPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *stringTimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13648,66 +13751,77 @@ func (v *stringIPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *stringIPMapValue) Get() interface{} {
+func (v *stringTimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *stringIPMapValue) String() string {
+func (v *stringTimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[string]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
 	}
 	return ""
 }
 
-func (v *stringIPMapValue) Type() string { return "map[string]net.IP" }
+func (v *stringTimeMapValue) Type() string { return "map[string]time.Time" }
 
-func (v *stringIPMapValue) IsCumulative() bool {
+func (v *stringTimeMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- intIPMapValue.
-type intIPMapValue struct {
-	value *map[int]net.IP
+func (v *stringTimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- intTimeMapValue.
+type intTimeMapValue struct {
+	value  *map[int]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*intIPMapValue)(nil)
-	_ Value          = (*intIPMapValue)(nil)
-	_ Getter         = (*intIPMapValue)(nil)
+	_ RepeatableFlag = (*intTimeMapValue)(nil)
+	_ Value          = (*intTimeMapValue)(nil)
+	_ Getter         = (*intTimeMapValue)(nil)
+	_ layoutValue    = (*intTimeMapValue)(nil)
 )
 
-func newIntIPMapValue(m *map[int]net.IP) *intIPMapValue {
-	return &intIPMapValue{
-		value: m,
+func newIntTimeMapValue(m *map[int]time.Time) *intTimeMapValue {
+	return &intTimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *intIPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *intTimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13718,66 +13832,77 @@ func (v *intIPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *intIPMapValue) Get() interface{} {
+func (v *intTimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *intIPMapValue) String() string {
+func (v *intTimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[int]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
 	}
 	return ""
 }
 
-func (v *intIPMapValue) Type() string { return "map[int]net.IP" }
+func (v *intTimeMapValue) Type() string { return "map[int]time.Time" }
 
-func (v *intIPMapValue) IsCumulative() bool {
+func (v *intTimeMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- int8IPMapValue.
-type int8IPMapValue struct {
-	value *map[int8]net.IP
+func (v *intTimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- int8TimeMapValue.
+type int8TimeMapValue struct {
+	value  *map[int8]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*int8IPMapValue)(nil)
-	_ Value          = (*int8IPMapValue)(nil)
-	_ Getter         = (*int8IPMapValue)(nil)
+	_ RepeatableFlag = (*int8TimeMapValue)(nil)
+	_ Value          = (*int8TimeMapValue)(nil)
+	_ Getter         = (*int8TimeMapValue)(nil)
+	_ layoutValue    = (*int8TimeMapValue)(nil)
 )
 
-func newInt8IPMapValue(m *map[int8]net.IP) *int8IPMapValue {
-	return &int8IPMapValue{
-		value: m,
+func newInt8TimeMapValue(m *map[int8]time.Time) *int8TimeMapValue {
+	return &int8TimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *int8IPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *int8TimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13788,66 +13913,77 @@ func (v *int8IPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *int8IPMapValue) Get() interface{} {
+func (v *int8TimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *int8IPMapValue) String() string {
+func (v *int8TimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[int8]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
 	}
 	return ""
 }
 
-func (v *int8IPMapValue) Type() string { return "map[int8]net.IP" }
+func (v *int8TimeMapValue) Type() string { return "map[int8]time.Time" }
 
-func (v *int8IPMapValue) IsCumulative() bool {
+func (v *int8TimeMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- int16IPMapValue.
-type int16IPMapValue struct {
-	value *map[int16]net.IP
+func (v *int8TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- int16TimeMapValue.
+type int16TimeMapValue struct {
+	value  *map[int16]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*int16IPMapValue)(nil)
-	_ Value          = (*int16IPMapValue)(nil)
-	_ Getter         = (*int16IPMapValue)(nil)
+	_ RepeatableFlag = (*int16TimeMapValue)(nil)
+	_ Value          = (*int16TimeMapValue)(nil)
+	_ Getter         = (*int16TimeMapValue)(nil)
+	_ layoutValue    = (*int16TimeMapValue)(nil)
 )
 
-func newInt16IPMapValue(m *map[int16]net.IP) *int16IPMapValue {
-	return &int16IPMapValue{
-		value: m,
+func newInt16TimeMapValue(m *map[int16]time.Time) *int16TimeMapValue {
+	return &int16TimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *int16IPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *int16TimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13858,66 +13994,77 @@ func (v *int16IPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *int16IPMapValue) Get() interface{} {
+func (v *int16TimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *int16IPMapValue) String() string {
+func (v *int16TimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[int16]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
 	}
 	return ""
 }
 
-func (v *int16IPMapValue) Type() string { return "map[int16]net.IP" }
+func (v *int16TimeMapValue) Type() string { return "map[int16]time.Time" }
 
-func (v *int16IPMapValue) IsCumulative() bool {
+func (v *int16TimeMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- int32IPMapValue.
-type int32IPMapValue struct {
-	value *map[int32]net.IP
+func (v *int16TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- int32TimeMapValue.
+type int32TimeMapValue struct {
+	value  *map[int32]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*int32IPMapValue)(nil)
-	_ Value          = (*int32IPMapValue)(nil)
-	_ Getter         = (*int32IPMapValue)(nil)
+	_ RepeatableFlag = (*int32TimeMapValue)(nil)
+	_ Value          = (*int32TimeMapValue)(nil)
+	_ Getter         = (*int32TimeMapValue)(nil)
+	_ layoutValue    = (*int32TimeMapValue)(nil)
 )
 
-func newInt32IPMapValue(m *map[int32]net.IP) *int32IPMapValue {
-	return &int32IPMapValue{
-		value: m,
+func newInt32TimeMapValue(m *map[int32]time.Time) *int32TimeMapValue {
+	return &int32TimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *int32IPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *int32TimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13928,66 +14075,77 @@ func (v *int32IPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *int32IPMapValue) Get() interface{} {
+func (v *int32TimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *int32IPMapValue) String() string {
+func (v *int32TimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[int32]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
 	}
 	return ""
 }
 
-func (v *int32IPMapValue) Type() string { return "map[int32]net.IP" }
+func (v *int32TimeMapValue) Type() string { return "map[int32]time.Time" }
 
-func (v *int32IPMapValue) IsCumulative() bool {
+func (v *int32TimeMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- int64IPMapValue.
-type int64IPMapValue struct {
-	value *map[int64]net.IP
+func (v *int32TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- int64TimeMapValue.
+type int64TimeMapValue struct {
+	value  *map[int64]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*int64IPMapValue)(nil)
-	_ Value          = (*int64IPMapValue)(nil)
-	_ Getter         = (*int64IPMapValue)(nil)
+	_ RepeatableFlag = (*int64TimeMapValue)(nil)
+	_ Value          = (*int64TimeMapValue)(nil)
+	_ Getter         = (*int64TimeMapValue)(nil)
+	_ layoutValue    = (*int64TimeMapValue)(nil)
 )
 
-func newInt64IPMapValue(m *map[int64]net.IP) *int64IPMapValue {
-	return &int64IPMapValue{
-		value: m,
+func newInt64TimeMapValue(m *map[int64]time.Time) *int64TimeMapValue {
+	return &int64TimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *int64IPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *int64TimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -13998,66 +14156,77 @@ func (v *int64IPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *int64IPMapValue) Get() interface{} {
+func (v *int64TimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *int64IPMapValue) String() string {
+func (v *int64TimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[int64]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
 	}
 	return ""
 }
 
-func (v *int64IPMapValue) Type() string { return "map[int64]net.IP" }
+func (v *int64TimeMapValue) Type() string { return "map[int64]time.Time" }
 
-func (v *int64IPMapValue) IsCumulative() bool {
+func (v *int64TimeMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- uintIPMapValue.
-type uintIPMapValue struct {
-	value *map[uint]net.IP
+func (v *int64TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- uintTimeMapValue.
+type uintTimeMapValue struct {
+	value  *map[uint]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*uintIPMapValue)(nil)
-	_ Value          = (*uintIPMapValue)(nil)
-	_ Getter         = (*uintIPMapValue)(nil)
+	_ RepeatableFlag = (*uintTimeMapValue)(nil)
+	_ Value          = (*uintTimeMapValue)(nil)
+	_ Getter         = (*uintTimeMapValue)(nil)
+	_ layoutValue    = (*uintTimeMapValue)(nil)
 )
 
-func newUintIPMapValue(m *map[uint]net.IP) *uintIPMapValue {
-	return &uintIPMapValue{
-		value: m,
+func newUintTimeMapValue(m *map[uint]time.Time) *uintTimeMapValue {
+	return &uintTimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *uintIPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *uintTimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14068,66 +14237,77 @@ func (v *uintIPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *uintIPMapValue) Get() interface{} {
+func (v *uintTimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *uintIPMapValue) String() string {
+func (v *uintTimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[uint]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
 	}
 	return ""
 }
 
-func (v *uintIPMapValue) Type() string { return "map[uint]net.IP" }
+func (v *uintTimeMapValue) Type() string { return "map[uint]time.Time" }
 
-func (v *uintIPMapValue) IsCumulative() bool {
+func (v *uintTimeMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- uint8IPMapValue.
-type uint8IPMapValue struct {
-	value *map[uint8]net.IP
+func (v *uintTimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- uint8TimeMapValue.
+type uint8TimeMapValue struct {
+	value  *map[uint8]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*uint8IPMapValue)(nil)
-	_ Value          = (*uint8IPMapValue)(nil)
-	_ Getter         = (*uint8IPMapValue)(nil)
+	_ RepeatableFlag = (*uint8TimeMapValue)(nil)
+	_ Value          = (*uint8TimeMapValue)(nil)
+	_ Getter         = (*uint8TimeMapValue)(nil)
+	_ layoutValue    = (*uint8TimeMapValue)(nil)
 )
 
-func newUint8IPMapValue(m *map[uint8]net.IP) *uint8IPMapValue {
-	return &uint8IPMapValue{
-		value: m,
+func newUint8TimeMapValue(m *map[uint8]time.Time) *uint8TimeMapValue {
+	return &uint8TimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *uint8IPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *uint8TimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14138,66 +14318,77 @@ func (v *uint8IPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *uint8IPMapValue) Get() interface{} {
+func (v *uint8TimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *uint8IPMapValue) String() string {
+func (v *uint8TimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[uint8]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
 	}
 	return ""
 }
 
-func (v *uint8IPMapValue) Type() string { return "map[uint8]net.IP" }
+func (v *uint8TimeMapValue) Type() string { return "map[uint8]time.Time" }
 
-func (v *uint8IPMapValue) IsCumulative() bool {
+func (v *uint8TimeMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- uint16IPMapValue.
-type uint16IPMapValue struct {
-	value *map[uint16]net.IP
+func (v *uint8TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- uint16TimeMapValue.
+type uint16TimeMapValue struct {
+	value  *map[uint16]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*uint16IPMapValue)(nil)
-	_ Value          = (*uint16IPMapValue)(nil)
-	_ Getter         = (*uint16IPMapValue)(nil)
+	_ RepeatableFlag = (*uint16TimeMapValue)(nil)
+	_ Value          = (*uint16TimeMapValue)(nil)
+	_ Getter         = (*uint16TimeMapValue)(nil)
+	_ layoutValue    = (*uint16TimeMapValue)(nil)
 )
 
-func newUint16IPMapValue(m *map[uint16]net.IP) *uint16IPMapValue {
-	return &uint16IPMapValue{
-		value: m,
+func newUint16TimeMapValue(m *map[uint16]time.Time) *uint16TimeMapValue {
+	return &uint16TimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *uint16IPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *uint16TimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14208,66 +14399,77 @@ func (v *uint16IPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *uint16IPMapValue) Get() interface{} {
+func (v *uint16TimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *uint16IPMapValue) String() string {
+func (v *uint16TimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[uint16]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
 	}
 	return ""
 }
 
-func (v *uint16IPMapValue) Type() string { return "map[uint16]net.IP" }
+func (v *uint16TimeMapValue) Type() string { return "map[uint16]time.Time" }
 
-func (v *uint16IPMapValue) IsCumulative() bool {
+func (v *uint16TimeMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- uint32IPMapValue.
-type uint32IPMapValue struct {
-	value *map[uint32]net.IP
+func (v *uint16TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- uint32TimeMapValue.
+type uint32TimeMapValue struct {
+	value  *map[uint32]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*uint32IPMapValue)(nil)
-	_ Value          = (*uint32IPMapValue)(nil)
-	_ Getter         = (*uint32IPMapValue)(nil)
+	_ RepeatableFlag = (*uint32TimeMapValue)(nil)
+	_ Value          = (*uint32TimeMapValue)(nil)
+	_ Getter         = (*uint32TimeMapValue)(nil)
+	_ layoutValue    = (*uint32TimeMapValue)(nil)
 )
 
-func newUint32IPMapValue(m *map[uint32]net.IP) *uint32IPMapValue {
-	return &uint32IPMapValue{
-		value: m,
+func newUint32TimeMapValue(m *map[uint32]time.Time) *uint32TimeMapValue {
+	return &uint32TimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *uint32IPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *uint32TimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14278,66 +14480,77 @@ func (v *uint32IPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *uint32IPMapValue) Get() interface{} {
+func (v *uint32TimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *uint32IPMapValue) String() string {
+func (v *uint32TimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[uint32]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
 	}
 	return ""
 }
 
-func (v *uint32IPMapValue) Type() string { return "map[uint32]net.IP" }
+func (v *uint32TimeMapValue) Type() string { return "map[uint32]time.Time" }
 
-func (v *uint32IPMapValue) IsCumulative() bool {
+func (v *uint32TimeMapValue) IsCumulative() bool {
 	return true
 }
 
-// -- uint64IPMapValue.
-type uint64IPMapValue struct {
-	value *map[uint64]net.IP
+func (v *uint32TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- uint64TimeMapValue.
+type uint64TimeMapValue struct {
+	value  *map[uint64]time.Time
+	layout string
 }
 
 var (
-	_ RepeatableFlag = (*uint64IPMapValue)(nil)
-	_ Value          = (*uint64IPMapValue)(nil)
-	_ Getter         = (*uint64IPMapValue)(nil)
+	_ RepeatableFlag = (*uint64TimeMapValue)(nil)
+	_ Value          = (*uint64TimeMapValue)(nil)
+	_ Getter         = (*uint64TimeMapValue)(nil)
+	_ layoutValue    = (*uint64TimeMapValue)(nil)
 )
 
-func newUint64IPMapValue(m *map[uint64]net.IP) *uint64IPMapValue {
-	return &uint64IPMapValue{
-		value: m,
+func newUint64TimeMapValue(m *map[uint64]time.Time) *uint64TimeMapValue {
+	return &uint64TimeMapValue{
+		value:  m,
+		layout: DefaultTimeLayout,
 	}
 }
 
-func (v *uint64IPMapValue) Set(val string) error {
-	values := strings.Split(val, ",")
+// Set parses a single key:value entry (layouts might contain commas), whose
+// key is separated by the first colon only, since most layouts contain some.
+func (v *uint64TimeMapValue) Set(val string) error {
+	values := []string{val}
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.SplitN(entry, ":", 2)
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
 
 		s = ss[1]
 
-		parsedVal, err := parseIP(s)
+		parsedVal, err := time.Parse(v.layout, strings.TrimSpace(s))
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14348,16 +14561,893 @@ func (v *uint64IPMapValue) Set(val string) error {
 	return nil
 }
 
-func (v *uint64IPMapValue) Get() interface{} {
+func (v *uint64TimeMapValue) Get() interface{} {
 	if v != nil && v.value != nil {
 		return *v.value
 	}
 	return nil
 }
 
-func (v *uint64IPMapValue) String() string {
+func (v *uint64TimeMapValue) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
-		return fmt.Sprintf("%v", *v.value)
+		out := make(map[uint64]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *uint64TimeMapValue) Type() string { return "map[uint64]time.Time" }
+
+func (v *uint64TimeMapValue) IsCumulative() bool {
+	return true
+}
+
+func (v *uint64TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+// -- net.IP Value.
+type ipValue struct {
+	value *net.IP
+}
+
+var (
+	_ Value  = (*ipValue)(nil)
+	_ Getter = (*ipValue)(nil)
+)
+
+func newIPValue(p *net.IP) *ipValue {
+	return &ipValue{value: p}
+}
+
+func (v *ipValue) Set(s string) error {
+	parsed, err := parseIP(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *ipValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *ipValue) String() string {
+	if v != nil && v.value != nil {
+		return v.value.String()
+	}
+	return ""
+}
+
+func (v *ipValue) Type() string { return "ip" }
+
+// -- net.IPSlice Value
+
+type ipSliceValue struct {
+	value   *[]net.IP
+	changed bool
+}
+
+var (
+	_ RepeatableFlag = (*ipSliceValue)(nil)
+	_ Value          = (*ipSliceValue)(nil)
+	_ Getter         = (*ipSliceValue)(nil)
+	_ Resetter       = (*ipSliceValue)(nil)
+)
+
+func newIPSliceValue(slice *[]net.IP) *ipSliceValue {
+	return &ipSliceValue{
+		value: slice,
+	}
+}
+
+func (v *ipSliceValue) Set(raw string) error {
+	ss := strings.Split(raw, ",")
+
+	out := make([]net.IP, len(ss))
+	for i, s := range ss {
+		parsed, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, s, err)
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *ipSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]net.IP)(nil)
+}
+
+func (v *ipSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newIPValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *ipSliceValue) Type() string { return "ipSlice" }
+
+func (v *ipSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *ipSliceValue) Reset() { v.changed = false }
+
+// -- stringIPMapValue.
+type stringIPMapValue struct {
+	value *map[string]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*stringIPMapValue)(nil)
+	_ Value          = (*stringIPMapValue)(nil)
+	_ Getter         = (*stringIPMapValue)(nil)
+)
+
+func newStringIPMapValue(m *map[string]net.IP) *stringIPMapValue {
+	return &stringIPMapValue{
+		value: m,
+	}
+}
+
+func (v *stringIPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		key := s
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *stringIPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringIPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringIPMapValue) Type() string { return "map[string]net.IP" }
+
+func (v *stringIPMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intIPMapValue.
+type intIPMapValue struct {
+	value *map[int]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*intIPMapValue)(nil)
+	_ Value          = (*intIPMapValue)(nil)
+	_ Getter         = (*intIPMapValue)(nil)
+)
+
+func newIntIPMapValue(m *map[int]net.IP) *intIPMapValue {
+	return &intIPMapValue{
+		value: m,
+	}
+}
+
+func (v *intIPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		parsedKey, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		key := (int)(parsedKey)
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *intIPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intIPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intIPMapValue) Type() string { return "map[int]net.IP" }
+
+func (v *intIPMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8IPMapValue.
+type int8IPMapValue struct {
+	value *map[int8]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*int8IPMapValue)(nil)
+	_ Value          = (*int8IPMapValue)(nil)
+	_ Getter         = (*int8IPMapValue)(nil)
+)
+
+func newInt8IPMapValue(m *map[int8]net.IP) *int8IPMapValue {
+	return &int8IPMapValue{
+		value: m,
+	}
+}
+
+func (v *int8IPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		parsedKey, err := strconv.ParseInt(s, 0, 8)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		key := (int8)(parsedKey)
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *int8IPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8IPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8IPMapValue) Type() string { return "map[int8]net.IP" }
+
+func (v *int8IPMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16IPMapValue.
+type int16IPMapValue struct {
+	value *map[int16]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*int16IPMapValue)(nil)
+	_ Value          = (*int16IPMapValue)(nil)
+	_ Getter         = (*int16IPMapValue)(nil)
+)
+
+func newInt16IPMapValue(m *map[int16]net.IP) *int16IPMapValue {
+	return &int16IPMapValue{
+		value: m,
+	}
+}
+
+func (v *int16IPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		parsedKey, err := strconv.ParseInt(s, 0, 16)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		key := (int16)(parsedKey)
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *int16IPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16IPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16IPMapValue) Type() string { return "map[int16]net.IP" }
+
+func (v *int16IPMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32IPMapValue.
+type int32IPMapValue struct {
+	value *map[int32]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*int32IPMapValue)(nil)
+	_ Value          = (*int32IPMapValue)(nil)
+	_ Getter         = (*int32IPMapValue)(nil)
+)
+
+func newInt32IPMapValue(m *map[int32]net.IP) *int32IPMapValue {
+	return &int32IPMapValue{
+		value: m,
+	}
+}
+
+func (v *int32IPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		parsedKey, err := strconv.ParseInt(s, 0, 32)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		key := (int32)(parsedKey)
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *int32IPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32IPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32IPMapValue) Type() string { return "map[int32]net.IP" }
+
+func (v *int32IPMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64IPMapValue.
+type int64IPMapValue struct {
+	value *map[int64]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*int64IPMapValue)(nil)
+	_ Value          = (*int64IPMapValue)(nil)
+	_ Getter         = (*int64IPMapValue)(nil)
+)
+
+func newInt64IPMapValue(m *map[int64]net.IP) *int64IPMapValue {
+	return &int64IPMapValue{
+		value: m,
+	}
+}
+
+func (v *int64IPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		parsedKey, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		key := parsedKey
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *int64IPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64IPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64IPMapValue) Type() string { return "map[int64]net.IP" }
+
+func (v *int64IPMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintIPMapValue.
+type uintIPMapValue struct {
+	value *map[uint]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*uintIPMapValue)(nil)
+	_ Value          = (*uintIPMapValue)(nil)
+	_ Getter         = (*uintIPMapValue)(nil)
+)
+
+func newUintIPMapValue(m *map[uint]net.IP) *uintIPMapValue {
+	return &uintIPMapValue{
+		value: m,
+	}
+}
+
+func (v *uintIPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		parsedKey, err := strconv.ParseUint(s, 0, 64)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		key := (uint)(parsedKey)
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *uintIPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintIPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintIPMapValue) Type() string { return "map[uint]net.IP" }
+
+func (v *uintIPMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8IPMapValue.
+type uint8IPMapValue struct {
+	value *map[uint8]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*uint8IPMapValue)(nil)
+	_ Value          = (*uint8IPMapValue)(nil)
+	_ Getter         = (*uint8IPMapValue)(nil)
+)
+
+func newUint8IPMapValue(m *map[uint8]net.IP) *uint8IPMapValue {
+	return &uint8IPMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8IPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		parsedKey, err := strconv.ParseUint(s, 0, 8)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		key := (uint8)(parsedKey)
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *uint8IPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8IPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8IPMapValue) Type() string { return "map[uint8]net.IP" }
+
+func (v *uint8IPMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16IPMapValue.
+type uint16IPMapValue struct {
+	value *map[uint16]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*uint16IPMapValue)(nil)
+	_ Value          = (*uint16IPMapValue)(nil)
+	_ Getter         = (*uint16IPMapValue)(nil)
+)
+
+func newUint16IPMapValue(m *map[uint16]net.IP) *uint16IPMapValue {
+	return &uint16IPMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16IPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		parsedKey, err := strconv.ParseUint(s, 0, 16)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		key := (uint16)(parsedKey)
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *uint16IPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16IPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16IPMapValue) Type() string { return "map[uint16]net.IP" }
+
+func (v *uint16IPMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32IPMapValue.
+type uint32IPMapValue struct {
+	value *map[uint32]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*uint32IPMapValue)(nil)
+	_ Value          = (*uint32IPMapValue)(nil)
+	_ Getter         = (*uint32IPMapValue)(nil)
+)
+
+func newUint32IPMapValue(m *map[uint32]net.IP) *uint32IPMapValue {
+	return &uint32IPMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32IPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		parsedKey, err := strconv.ParseUint(s, 0, 32)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		key := (uint32)(parsedKey)
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *uint32IPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32IPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32IPMapValue) Type() string { return "map[uint32]net.IP" }
+
+func (v *uint32IPMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64IPMapValue.
+type uint64IPMapValue struct {
+	value *map[uint64]net.IP
+}
+
+var (
+	_ RepeatableFlag = (*uint64IPMapValue)(nil)
+	_ Value          = (*uint64IPMapValue)(nil)
+	_ Getter         = (*uint64IPMapValue)(nil)
+)
+
+func newUint64IPMapValue(m *map[uint64]net.IP) *uint64IPMapValue {
+	return &uint64IPMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64IPMapValue) Set(val string) error {
+	values := strings.Split(val, ",")
+
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
+		if len(ss) < 2 {
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
+		}
+
+		s := ss[0]
+
+		parsedKey, err := strconv.ParseUint(s, 0, 64)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		key := parsedKey
+
+		s = ss[1]
+
+		parsedVal, err := parseIP(s)
+		if err != nil {
+			return newElementError(i, entry, err)
+		}
+
+		val := parsedVal
+
+		(*v.value)[key] = val
+	}
+
+	return nil
+}
+
+func (v *uint64IPMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64IPMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
 	}
 	return ""
 }
@@ -14418,6 +15508,7 @@ var (
 	_ RepeatableFlag = (*hexBytesSliceValue)(nil)
 	_ Value          = (*hexBytesSliceValue)(nil)
 	_ Getter         = (*hexBytesSliceValue)(nil)
+	_ Resetter       = (*hexBytesSliceValue)(nil)
 )
 
 func newHexBytesSliceValue(slice *[]HexBytes) *hexBytesSliceValue {
@@ -14433,7 +15524,7 @@ func (v *hexBytesSliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = parsed
 	}
@@ -14471,6 +15562,8 @@ func (v *hexBytesSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *hexBytesSliceValue) Reset() { v.changed = false }
+
 // -- stringHexBytesMapValue.
 type stringHexBytesMapValue struct {
 	value *map[string]HexBytes
@@ -14491,13 +15584,13 @@ func newStringHexBytesMapValue(m *map[string]HexBytes) *stringHexBytesMapValue {
 func (v *stringHexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -14505,7 +15598,7 @@ func (v *stringHexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14556,17 +15649,17 @@ func newIntHexBytesMapValue(m *map[int]HexBytes) *intHexBytesMapValue {
 func (v *intHexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -14575,7 +15668,7 @@ func (v *intHexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14626,17 +15719,17 @@ func newInt8HexBytesMapValue(m *map[int8]HexBytes) *int8HexBytesMapValue {
 func (v *int8HexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -14645,7 +15738,7 @@ func (v *int8HexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14696,17 +15789,17 @@ func newInt16HexBytesMapValue(m *map[int16]HexBytes) *int16HexBytesMapValue {
 func (v *int16HexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -14715,7 +15808,7 @@ func (v *int16HexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14766,17 +15859,17 @@ func newInt32HexBytesMapValue(m *map[int32]HexBytes) *int32HexBytesMapValue {
 func (v *int32HexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -14785,7 +15878,7 @@ func (v *int32HexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14836,17 +15929,17 @@ func newInt64HexBytesMapValue(m *map[int64]HexBytes) *int64HexBytesMapValue {
 func (v *int64HexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -14855,7 +15948,7 @@ func (v *int64HexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14906,17 +15999,17 @@ func newUintHexBytesMapValue(m *map[uint]HexBytes) *uintHexBytesMapValue {
 func (v *uintHexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -14925,7 +16018,7 @@ func (v *uintHexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -14976,17 +16069,17 @@ func newUint8HexBytesMapValue(m *map[uint8]HexBytes) *uint8HexBytesMapValue {
 func (v *uint8HexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -14995,7 +16088,7 @@ func (v *uint8HexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15046,17 +16139,17 @@ func newUint16HexBytesMapValue(m *map[uint16]HexBytes) *uint16HexBytesMapValue {
 func (v *uint16HexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -15065,7 +16158,7 @@ func (v *uint16HexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15116,17 +16209,17 @@ func newUint32HexBytesMapValue(m *map[uint32]HexBytes) *uint32HexBytesMapValue {
 func (v *uint32HexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -15135,7 +16228,7 @@ func (v *uint32HexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15186,17 +16279,17 @@ func newUint64HexBytesMapValue(m *map[uint64]HexBytes) *uint64HexBytesMapValue {
 func (v *uint64HexBytesMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -15205,7 +16298,7 @@ func (v *uint64HexBytesMapValue) Set(val string) error {
 
 		parsedVal, err := hex.DecodeString(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15286,6 +16379,7 @@ var (
 	_ RepeatableFlag = (*regexpSliceValue)(nil)
 	_ Value          = (*regexpSliceValue)(nil)
 	_ Getter         = (*regexpSliceValue)(nil)
+	_ Resetter       = (*regexpSliceValue)(nil)
 )
 
 func newRegexpSliceValue(slice *[]*regexp.Regexp) *regexpSliceValue {
@@ -15301,7 +16395,7 @@ func (v *regexpSliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = parsed
 	}
@@ -15339,6 +16433,8 @@ func (v *regexpSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *regexpSliceValue) Reset() { v.changed = false }
+
 // -- stringRegexpMapValue.
 type stringRegexpMapValue struct {
 	value *map[string]*regexp.Regexp
@@ -15359,13 +16455,13 @@ func newStringRegexpMapValue(m *map[string]*regexp.Regexp) *stringRegexpMapValue
 func (v *stringRegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -15373,7 +16469,7 @@ func (v *stringRegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15424,17 +16520,17 @@ func newIntRegexpMapValue(m *map[int]*regexp.Regexp) *intRegexpMapValue {
 func (v *intRegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -15443,7 +16539,7 @@ func (v *intRegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15494,17 +16590,17 @@ func newInt8RegexpMapValue(m *map[int8]*regexp.Regexp) *int8RegexpMapValue {
 func (v *int8RegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -15513,7 +16609,7 @@ func (v *int8RegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15564,17 +16660,17 @@ func newInt16RegexpMapValue(m *map[int16]*regexp.Regexp) *int16RegexpMapValue {
 func (v *int16RegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -15583,7 +16679,7 @@ func (v *int16RegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15634,17 +16730,17 @@ func newInt32RegexpMapValue(m *map[int32]*regexp.Regexp) *int32RegexpMapValue {
 func (v *int32RegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -15653,7 +16749,7 @@ func (v *int32RegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15704,17 +16800,17 @@ func newInt64RegexpMapValue(m *map[int64]*regexp.Regexp) *int64RegexpMapValue {
 func (v *int64RegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -15723,7 +16819,7 @@ func (v *int64RegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15774,17 +16870,17 @@ func newUintRegexpMapValue(m *map[uint]*regexp.Regexp) *uintRegexpMapValue {
 func (v *uintRegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -15793,7 +16889,7 @@ func (v *uintRegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15844,17 +16940,17 @@ func newUint8RegexpMapValue(m *map[uint8]*regexp.Regexp) *uint8RegexpMapValue {
 func (v *uint8RegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -15863,7 +16959,7 @@ func (v *uint8RegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15914,17 +17010,17 @@ func newUint16RegexpMapValue(m *map[uint16]*regexp.Regexp) *uint16RegexpMapValue
 func (v *uint16RegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -15933,7 +17029,7 @@ func (v *uint16RegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -15984,17 +17080,17 @@ func newUint32RegexpMapValue(m *map[uint32]*regexp.Regexp) *uint32RegexpMapValue
 func (v *uint32RegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -16003,7 +17099,7 @@ func (v *uint32RegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16054,17 +17150,17 @@ func newUint64RegexpMapValue(m *map[uint64]*regexp.Regexp) *uint64RegexpMapValue
 func (v *uint64RegexpMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -16073,7 +17169,7 @@ func (v *uint64RegexpMapValue) Set(val string) error {
 
 		parsedVal, err := regexp.Compile(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16154,6 +17250,7 @@ var (
 	_ RepeatableFlag = (*tcpAddrSliceValue)(nil)
 	_ Value          = (*tcpAddrSliceValue)(nil)
 	_ Getter         = (*tcpAddrSliceValue)(nil)
+	_ Resetter       = (*tcpAddrSliceValue)(nil)
 )
 
 func newTCPAddrSliceValue(slice *[]net.TCPAddr) *tcpAddrSliceValue {
@@ -16169,7 +17266,7 @@ func (v *tcpAddrSliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := parseTCPAddr(s)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = parsed
 	}
@@ -16207,6 +17304,8 @@ func (v *tcpAddrSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *tcpAddrSliceValue) Reset() { v.changed = false }
+
 // -- net.IPNet Value.
 type ipNetValue struct {
 	value *net.IPNet
@@ -16257,6 +17356,7 @@ var (
 	_ RepeatableFlag = (*ipNetSliceValue)(nil)
 	_ Value          = (*ipNetSliceValue)(nil)
 	_ Getter         = (*ipNetSliceValue)(nil)
+	_ Resetter       = (*ipNetSliceValue)(nil)
 )
 
 func newIPNetSliceValue(slice *[]net.IPNet) *ipNetSliceValue {
@@ -16272,7 +17372,7 @@ func (v *ipNetSliceValue) Set(raw string) error {
 	for i, s := range ss {
 		parsed, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, s, err)
 		}
 		out[i] = parsed
 	}
@@ -16310,6 +17410,8 @@ func (v *ipNetSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *ipNetSliceValue) Reset() { v.changed = false }
+
 // -- stringIPNetMapValue.
 type stringIPNetMapValue struct {
 	value *map[string]net.IPNet
@@ -16330,13 +17432,13 @@ func newStringIPNetMapValue(m *map[string]net.IPNet) *stringIPNetMapValue {
 func (v *stringIPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		key := s
 
@@ -16344,7 +17446,7 @@ func (v *stringIPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16395,17 +17497,17 @@ func newIntIPNetMapValue(m *map[int]net.IPNet) *intIPNetMapValue {
 func (v *intIPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int)(parsedKey)
@@ -16414,7 +17516,7 @@ func (v *intIPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16465,17 +17567,17 @@ func newInt8IPNetMapValue(m *map[int8]net.IPNet) *int8IPNetMapValue {
 func (v *int8IPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int8)(parsedKey)
@@ -16484,7 +17586,7 @@ func (v *int8IPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16535,17 +17637,17 @@ func newInt16IPNetMapValue(m *map[int16]net.IPNet) *int16IPNetMapValue {
 func (v *int16IPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int16)(parsedKey)
@@ -16554,7 +17656,7 @@ func (v *int16IPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16605,17 +17707,17 @@ func newInt32IPNetMapValue(m *map[int32]net.IPNet) *int32IPNetMapValue {
 func (v *int32IPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (int32)(parsedKey)
@@ -16624,7 +17726,7 @@ func (v *int32IPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16675,17 +17777,17 @@ func newInt64IPNetMapValue(m *map[int64]net.IPNet) *int64IPNetMapValue {
 func (v *int64IPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseInt(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -16694,7 +17796,7 @@ func (v *int64IPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16745,17 +17847,17 @@ func newUintIPNetMapValue(m *map[uint]net.IPNet) *uintIPNetMapValue {
 func (v *uintIPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint)(parsedKey)
@@ -16764,7 +17866,7 @@ func (v *uintIPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16815,17 +17917,17 @@ func newUint8IPNetMapValue(m *map[uint8]net.IPNet) *uint8IPNetMapValue {
 func (v *uint8IPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 8)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint8)(parsedKey)
@@ -16834,7 +17936,7 @@ func (v *uint8IPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16885,17 +17987,17 @@ func newUint16IPNetMapValue(m *map[uint16]net.IPNet) *uint16IPNetMapValue {
 func (v *uint16IPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 16)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint16)(parsedKey)
@@ -16904,7 +18006,7 @@ func (v *uint16IPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -16955,17 +18057,17 @@ func newUint32IPNetMapValue(m *map[uint32]net.IPNet) *uint32IPNetMapValue {
 func (v *uint32IPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 32)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := (uint32)(parsedKey)
@@ -16974,7 +18076,7 @@ func (v *uint32IPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -17025,17 +18127,17 @@ func newUint64IPNetMapValue(m *map[uint64]net.IPNet) *uint64IPNetMapValue {
 func (v *uint64IPNetMapValue) Set(val string) error {
 	values := strings.Split(val, ",")
 
-	for _, s := range values {
-		ss := strings.Split(s, ":")
+	for i, entry := range values {
+		ss := strings.Split(entry, ":")
 		if len(ss) < 2 {
-			return errors.New("invalid map flag syntax, use -map=key1:val1")
+			return newElementError(i, entry, errors.New("invalid map flag syntax, use -map=key1:val1"))
 		}
 
-		s = ss[0]
+		s := ss[0]
 
 		parsedKey, err := strconv.ParseUint(s, 0, 64)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		key := parsedKey
@@ -17044,7 +18146,7 @@ func (v *uint64IPNetMapValue) Set(val string) error {
 
 		parsedVal, err := parseIPNet(s)
 		if err != nil {
-			return err
+			return newElementError(i, entry, err)
 		}
 
 		val := parsedVal
@@ -17074,3 +18176,321 @@ func (v *uint64IPNetMapValue) Type() string { return "map[uint64]net.IPNet" }
 func (v *uint64IPNetMapValue) IsCumulative() bool {
 	return true
 }
+
+// -- *time.Location Value.
+type locationValue struct {
+	value **time.Location
+}
+
+var (
+	_ Value  = (*locationValue)(nil)
+	_ Getter = (*locationValue)(nil)
+)
+
+func newLocationValue(p **time.Location) *locationValue {
+	return &locationValue{value: p}
+}
+
+func (v *locationValue) Set(s string) error {
+	parsed, err := time.LoadLocation(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *locationValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *locationValue) String() string {
+	if v != nil && v.value != nil {
+		return (*v.value).String()
+	}
+	return ""
+}
+
+func (v *locationValue) Type() string { return "location" }
+
+// -- *time.LocationSlice Value
+
+type locationSliceValue struct {
+	value   *[]*time.Location
+	changed bool
+}
+
+var (
+	_ RepeatableFlag = (*locationSliceValue)(nil)
+	_ Value          = (*locationSliceValue)(nil)
+	_ Getter         = (*locationSliceValue)(nil)
+	_ Resetter       = (*locationSliceValue)(nil)
+)
+
+func newLocationSliceValue(slice *[]*time.Location) *locationSliceValue {
+	return &locationSliceValue{
+		value: slice,
+	}
+}
+
+func (v *locationSliceValue) Set(raw string) error {
+	ss := strings.Split(raw, ",")
+
+	out := make([]*time.Location, len(ss))
+	for i, s := range ss {
+		parsed, err := time.LoadLocation(s)
+		if err != nil {
+			return newElementError(i, s, err)
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *locationSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]*time.Location)(nil)
+}
+
+func (v *locationSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newLocationValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *locationSliceValue) Type() string { return "locationSlice" }
+
+func (v *locationSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *locationSliceValue) Reset() { v.changed = false }
+
+// -- language.Tag Value.
+type languageTagValue struct {
+	value *language.Tag
+}
+
+var (
+	_ Value  = (*languageTagValue)(nil)
+	_ Getter = (*languageTagValue)(nil)
+)
+
+func newLanguageTagValue(p *language.Tag) *languageTagValue {
+	return &languageTagValue{value: p}
+}
+
+func (v *languageTagValue) Set(s string) error {
+	parsed, err := language.Parse(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *languageTagValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *languageTagValue) String() string {
+	if v != nil && v.value != nil {
+		return v.value.String()
+	}
+	return ""
+}
+
+func (v *languageTagValue) Type() string { return "languageTag" }
+
+// -- language.TagSlice Value
+
+type languageTagSliceValue struct {
+	value   *[]language.Tag
+	changed bool
+}
+
+var (
+	_ RepeatableFlag = (*languageTagSliceValue)(nil)
+	_ Value          = (*languageTagSliceValue)(nil)
+	_ Getter         = (*languageTagSliceValue)(nil)
+	_ Resetter       = (*languageTagSliceValue)(nil)
+)
+
+func newLanguageTagSliceValue(slice *[]language.Tag) *languageTagSliceValue {
+	return &languageTagSliceValue{
+		value: slice,
+	}
+}
+
+func (v *languageTagSliceValue) Set(raw string) error {
+	ss := strings.Split(raw, ",")
+
+	out := make([]language.Tag, len(ss))
+	for i, s := range ss {
+		parsed, err := language.Parse(s)
+		if err != nil {
+			return newElementError(i, s, err)
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *languageTagSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]language.Tag)(nil)
+}
+
+func (v *languageTagSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newLanguageTagValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *languageTagSliceValue) Type() string { return "languageTagSlice" }
+
+func (v *languageTagSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *languageTagSliceValue) Reset() { v.changed = false }
+
+// -- Charset Value.
+type charsetValue struct {
+	value *Charset
+}
+
+var (
+	_ Value  = (*charsetValue)(nil)
+	_ Getter = (*charsetValue)(nil)
+)
+
+func newCharsetValue(p *Charset) *charsetValue {
+	return &charsetValue{value: p}
+}
+
+func (v *charsetValue) Set(s string) error {
+	parsed, err := parseCharset(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *charsetValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *charsetValue) String() string {
+	if v != nil && v.value != nil {
+		return string(*v.value)
+	}
+	return ""
+}
+
+func (v *charsetValue) Type() string { return "charset" }
+
+// -- CharsetSlice Value
+
+type charsetSliceValue struct {
+	value   *[]Charset
+	changed bool
+}
+
+var (
+	_ RepeatableFlag = (*charsetSliceValue)(nil)
+	_ Value          = (*charsetSliceValue)(nil)
+	_ Getter         = (*charsetSliceValue)(nil)
+	_ Resetter       = (*charsetSliceValue)(nil)
+)
+
+func newCharsetSliceValue(slice *[]Charset) *charsetSliceValue {
+	return &charsetSliceValue{
+		value: slice,
+	}
+}
+
+func (v *charsetSliceValue) Set(raw string) error {
+	ss := strings.Split(raw, ",")
+
+	out := make([]Charset, len(ss))
+	for i, s := range ss {
+		parsed, err := parseCharset(s)
+		if err != nil {
+			return newElementError(i, s, err)
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *charsetSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]Charset)(nil)
+}
+
+func (v *charsetSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newCharsetValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *charsetSliceValue) Type() string { return "charsetSlice" }
+
+func (v *charsetSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *charsetSliceValue) Reset() { v.changed = false }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
 )
 
 func TestStringValue_Zero(t *testing.T) {
@@ -185,11 +186,11 @@ func TestStringStringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("XVlBzval1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"XVlBzval1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("gbaiC:val1")
 		assert.Nil(t, err)
 		err = v.Set("MRAjWval2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"MRAjWval2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("whTHc:val2")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -204,7 +205,7 @@ func TestStringStringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("tcuAx")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"tcuAx\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("hxKQF:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -223,13 +224,13 @@ func TestIntStringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5val1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5val1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("0:val1")
 		assert.Nil(t, err)
 		err = v.Set("3val2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3val2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
 		err = v.Set("7:val2")
@@ -246,7 +247,7 @@ func TestIntStringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
 		err = v.Set("4:")
@@ -267,13 +268,13 @@ func TestInt8StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1val1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1val1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("7:val1")
 		assert.Nil(t, err)
 		err = v.Set("5val2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5val2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
 		err = v.Set("2:val2")
@@ -290,7 +291,7 @@ func TestInt8StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
 		err = v.Set("2:")
@@ -311,13 +312,13 @@ func TestInt16StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2val1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2val1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("3:val1")
 		assert.Nil(t, err)
 		err = v.Set("1val2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1val2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
 		err = v.Set("3:val2")
@@ -334,7 +335,7 @@ func TestInt16StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
 		err = v.Set("4:")
@@ -355,13 +356,13 @@ func TestInt32StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7val1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7val1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("1:val1")
 		assert.Nil(t, err)
 		err = v.Set("5val2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5val2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
 		err = v.Set("1:val2")
@@ -378,7 +379,7 @@ func TestInt32StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
 		err = v.Set("7:")
@@ -399,13 +400,13 @@ func TestInt64StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0val1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0val1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("1:val1")
 		assert.Nil(t, err)
 		err = v.Set("0val2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0val2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
 		err = v.Set("2:val2")
@@ -422,7 +423,7 @@ func TestInt64StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
 		err = v.Set("3:")
@@ -443,13 +444,13 @@ func TestUintStringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3val1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3val1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("6:val1")
 		assert.Nil(t, err)
 		err = v.Set("5val2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5val2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
 		err = v.Set("4:val2")
@@ -466,7 +467,7 @@ func TestUintStringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
 		err = v.Set("4:")
@@ -487,13 +488,13 @@ func TestUint8StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1val1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1val1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("2:val1")
 		assert.Nil(t, err)
 		err = v.Set("7val2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7val2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
 		err = v.Set("2:val2")
@@ -510,7 +511,7 @@ func TestUint8StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
 		err = v.Set("0:")
@@ -531,13 +532,13 @@ func TestUint16StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2val1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2val1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("6:val1")
 		assert.Nil(t, err)
 		err = v.Set("7val2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7val2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
 		err = v.Set("6:val2")
@@ -554,7 +555,7 @@ func TestUint16StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
 		err = v.Set("7:")
@@ -575,13 +576,13 @@ func TestUint32StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4val1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4val1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("4:val1")
 		assert.Nil(t, err)
 		err = v.Set("7val2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7val2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
 		err = v.Set("1:val2")
@@ -598,7 +599,7 @@ func TestUint32StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
 		err = v.Set("5:")
@@ -619,13 +620,13 @@ func TestUint64StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1val1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1val1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("3:val1")
 		assert.Nil(t, err)
 		err = v.Set("1val2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1val2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
 		err = v.Set("3:val2")
@@ -642,7 +643,7 @@ func TestUint64StringMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
 		err = v.Set("2:")
@@ -867,7 +868,7 @@ func TestBoolSliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("true,unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 2 \"unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "boolSlice", v.Type())
@@ -884,11 +885,11 @@ func TestStringBoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("gmotatrue")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"gmotatrue\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("FetHs:true")
 		assert.Nil(t, err)
 		err = v.Set("bZRjxfalse")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"bZRjxfalse\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("Awnwe:false")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -903,9 +904,9 @@ func TestStringBoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("krBEmunexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"krBEmunexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("fdzdc:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"fdzdc:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -922,13 +923,13 @@ func TestIntBoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6true")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6true\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
 		err = v.Set("2:true")
 		assert.Nil(t, err)
 		err = v.Set("5false")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5false\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
 		err = v.Set("7:false")
@@ -945,11 +946,11 @@ func TestIntBoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2unexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2unexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
 		err = v.Set("6:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -966,13 +967,13 @@ func TestInt8BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5true")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5true\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
 		err = v.Set("6:true")
 		assert.Nil(t, err)
 		err = v.Set("3false")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3false\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
 		err = v.Set("5:false")
@@ -989,11 +990,11 @@ func TestInt8BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4unexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4unexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
 		err = v.Set("7:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -1010,13 +1011,13 @@ func TestInt16BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5true")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5true\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
 		err = v.Set("6:true")
 		assert.Nil(t, err)
 		err = v.Set("3false")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3false\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
 		err = v.Set("5:false")
@@ -1033,11 +1034,11 @@ func TestInt16BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0unexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0unexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
 		err = v.Set("2:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -1054,13 +1055,13 @@ func TestInt32BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0true")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0true\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
 		err = v.Set("7:true")
 		assert.Nil(t, err)
 		err = v.Set("0false")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0false\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
 		err = v.Set("3:false")
@@ -1077,11 +1078,11 @@ func TestInt32BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4unexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4unexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
 		err = v.Set("4:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -1098,13 +1099,13 @@ func TestInt64BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0true")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0true\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
 		err = v.Set("7:true")
 		assert.Nil(t, err)
 		err = v.Set("4false")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4false\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
 		err = v.Set("2:false")
@@ -1121,11 +1122,11 @@ func TestInt64BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3unexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3unexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
 		err = v.Set("4:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -1142,13 +1143,13 @@ func TestUintBoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0true")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0true\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
 		err = v.Set("7:true")
 		assert.Nil(t, err)
 		err = v.Set("4false")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4false\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
 		err = v.Set("4:false")
@@ -1165,11 +1166,11 @@ func TestUintBoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1unexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1unexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
 		err = v.Set("7:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -1186,13 +1187,13 @@ func TestUint8BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1true")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1true\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
 		err = v.Set("2:true")
 		assert.Nil(t, err)
 		err = v.Set("2false")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2false\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
 		err = v.Set("2:false")
@@ -1209,11 +1210,11 @@ func TestUint8BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7unexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7unexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
 		err = v.Set("7:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -1230,13 +1231,13 @@ func TestUint16BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2true")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2true\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
 		err = v.Set("2:true")
 		assert.Nil(t, err)
 		err = v.Set("6false")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6false\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
 		err = v.Set("7:false")
@@ -1253,11 +1254,11 @@ func TestUint16BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6unexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6unexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
 		err = v.Set("0:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -1274,13 +1275,13 @@ func TestUint32BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7true")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7true\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
 		err = v.Set("0:true")
 		assert.Nil(t, err)
 		err = v.Set("6false")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6false\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
 		err = v.Set("6:false")
@@ -1297,11 +1298,11 @@ func TestUint32BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7unexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7unexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
 		err = v.Set("0:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -1318,13 +1319,13 @@ func TestUint64BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0true")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0true\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
 		err = v.Set("2:true")
 		assert.Nil(t, err)
 		err = v.Set("1false")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1false\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
 		err = v.Set("7:false")
@@ -1341,11 +1342,11 @@ func TestUint64BoolMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3unexpected")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3unexpected\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
 		err = v.Set("7:unexpected")
-		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:unexpected\": strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]bool", v.Type())
 		assert.Empty(t, v.String())
@@ -1555,7 +1556,7 @@ func TestUintSliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("-1,0")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "uintSlice", v.Type())
@@ -1572,11 +1573,11 @@ func TestStringUintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("sbOJi10")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"sbOJi10\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("FQGZs:10")
 		assert.Nil(t, err)
 		err = v.Set("nwTKS20")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"nwTKS20\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("mVoiG:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -1591,9 +1592,9 @@ func TestStringUintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("LOpbU-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"LOpbU-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("OpEdK:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"OpEdK:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -1610,13 +1611,13 @@ func TestIntUintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("0:20")
@@ -1633,11 +1634,11 @@ func TestIntUintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("4:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -1654,13 +1655,13 @@ func TestInt8UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -1677,11 +1678,11 @@ func TestInt8UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("5:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -1698,13 +1699,13 @@ func TestInt16UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("1:20")
@@ -1721,11 +1722,11 @@ func TestInt16UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("4:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -1742,13 +1743,13 @@ func TestInt32UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("0:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"020\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -1765,11 +1766,11 @@ func TestInt32UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("5:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -1786,13 +1787,13 @@ func TestInt64UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -1809,11 +1810,11 @@ func TestInt64UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("0:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -1830,13 +1831,13 @@ func TestUintUintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -1853,11 +1854,11 @@ func TestUintUintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("0:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -1874,13 +1875,13 @@ func TestUint8UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("5:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("1:20")
@@ -1897,11 +1898,11 @@ func TestUint8UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("5:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -1918,13 +1919,13 @@ func TestUint16UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -1941,11 +1942,11 @@ func TestUint16UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("6:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -1962,13 +1963,13 @@ func TestUint32UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("0:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -1985,11 +1986,11 @@ func TestUint32UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("3:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -2006,13 +2007,13 @@ func TestUint64UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("620")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -2029,11 +2030,11 @@ func TestUint64UintMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("0:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint", v.Type())
 		assert.Empty(t, v.String())
@@ -2243,7 +2244,7 @@ func TestUint8SliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("-1,0")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "uint8Slice", v.Type())
@@ -2260,11 +2261,11 @@ func TestStringUint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("YCOhg10")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"YCOhg10\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("HOvgS:10")
 		assert.Nil(t, err)
 		err = v.Set("eycJP20")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"eycJP20\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("JHYNu:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -2279,9 +2280,9 @@ func TestStringUint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("fNjJh-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"fNjJh-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("hjUVR:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"hjUVR:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2298,13 +2299,13 @@ func TestIntUint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("0:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"020\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -2321,11 +2322,11 @@ func TestIntUint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("4:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2342,13 +2343,13 @@ func TestInt8Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("1:20")
@@ -2365,11 +2366,11 @@ func TestInt8Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("3:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2386,13 +2387,13 @@ func TestInt16Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -2409,11 +2410,11 @@ func TestInt16Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("4:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2430,13 +2431,13 @@ func TestInt32Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("620")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -2453,11 +2454,11 @@ func TestInt32Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("2:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2474,13 +2475,13 @@ func TestInt64Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("0:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -2497,11 +2498,11 @@ func TestInt64Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("4:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2518,13 +2519,13 @@ func TestUintUint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -2541,11 +2542,11 @@ func TestUintUint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("2:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2562,13 +2563,13 @@ func TestUint8Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("0:20")
@@ -2585,11 +2586,11 @@ func TestUint8Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("5:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2606,13 +2607,13 @@ func TestUint16Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -2629,11 +2630,11 @@ func TestUint16Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("0:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2650,13 +2651,13 @@ func TestUint32Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -2673,11 +2674,11 @@ func TestUint32Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("2:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2694,13 +2695,13 @@ func TestUint64Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -2717,11 +2718,11 @@ func TestUint64Uint8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("3:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint8", v.Type())
 		assert.Empty(t, v.String())
@@ -2931,7 +2932,7 @@ func TestUint16SliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("-1,0")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "uint16Slice", v.Type())
@@ -2948,11 +2949,11 @@ func TestStringUint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("sdjSG10")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"sdjSG10\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("pngCw:10")
 		assert.Nil(t, err)
 		err = v.Set("FkDif20")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"FkDif20\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("IBuuf:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -2967,9 +2968,9 @@ func TestStringUint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("FMoWd-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"FMoWd-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("iTskZ:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"iTskZ:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -2986,13 +2987,13 @@ func TestIntUint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -3009,11 +3010,11 @@ func TestIntUint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("5:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -3030,13 +3031,13 @@ func TestInt8Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -3053,11 +3054,11 @@ func TestInt8Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("5:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -3074,13 +3075,13 @@ func TestInt16Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("610")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"610\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("0:20")
@@ -3097,11 +3098,11 @@ func TestInt16Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("4:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -3118,13 +3119,13 @@ func TestInt32Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -3141,11 +3142,11 @@ func TestInt32Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("6:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -3162,13 +3163,13 @@ func TestInt64Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -3185,11 +3186,11 @@ func TestInt64Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("3:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -3206,13 +3207,13 @@ func TestUintUint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("1:20")
@@ -3229,11 +3230,11 @@ func TestUintUint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("3:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -3250,13 +3251,13 @@ func TestUint8Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("3:20")
@@ -3273,11 +3274,11 @@ func TestUint8Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("6:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -3294,13 +3295,13 @@ func TestUint16Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -3317,11 +3318,11 @@ func TestUint16Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("5:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -3338,13 +3339,13 @@ func TestUint32Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("610")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"610\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"020\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("3:20")
@@ -3361,11 +3362,11 @@ func TestUint32Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("2:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -3382,13 +3383,13 @@ func TestUint64Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -3405,11 +3406,11 @@ func TestUint64Uint16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("2:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint16", v.Type())
 		assert.Empty(t, v.String())
@@ -3619,7 +3620,7 @@ func TestUint32SliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("-1,0")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "uint32Slice", v.Type())
@@ -3636,11 +3637,11 @@ func TestStringUint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("VgzHb10")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"VgzHb10\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("lmYYt:10")
 		assert.Nil(t, err)
 		err = v.Set("EjVgw20")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"EjVgw20\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("fFbbG:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -3655,9 +3656,9 @@ func TestStringUint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("Gcnqb-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"Gcnqb-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("aEREu:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"aEREu:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -3674,13 +3675,13 @@ func TestIntUint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -3697,11 +3698,11 @@ func TestIntUint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("1:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -3718,13 +3719,13 @@ func TestInt8Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -3741,11 +3742,11 @@ func TestInt8Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("7:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -3762,13 +3763,13 @@ func TestInt16Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("0:20")
@@ -3785,11 +3786,11 @@ func TestInt16Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("2:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -3806,13 +3807,13 @@ func TestInt32Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -3829,11 +3830,11 @@ func TestInt32Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("2:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -3850,13 +3851,13 @@ func TestInt64Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("5:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -3873,11 +3874,11 @@ func TestInt64Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("6:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -3894,13 +3895,13 @@ func TestUintUint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -3917,11 +3918,11 @@ func TestUintUint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("0:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -3938,13 +3939,13 @@ func TestUint8Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("0:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"020\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -3961,11 +3962,11 @@ func TestUint8Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("4:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -3982,13 +3983,13 @@ func TestUint16Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -4005,11 +4006,11 @@ func TestUint16Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("5:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -4026,13 +4027,13 @@ func TestUint32Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("620")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("0:20")
@@ -4049,11 +4050,11 @@ func TestUint32Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("3:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -4070,13 +4071,13 @@ func TestUint64Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -4093,11 +4094,11 @@ func TestUint64Uint32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("0:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint32", v.Type())
 		assert.Empty(t, v.String())
@@ -4307,7 +4308,7 @@ func TestUint64SliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("-1,0")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "uint64Slice", v.Type())
@@ -4324,11 +4325,11 @@ func TestStringUint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("Lpruc10")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"Lpruc10\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("jiOgj:10")
 		assert.Nil(t, err)
 		err = v.Set("hYeVw20")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"hYeVw20\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("BTCML:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -4343,9 +4344,9 @@ func TestStringUint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("frDGX-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"frDGX-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("qwpzw:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"qwpzw:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -4362,13 +4363,13 @@ func TestIntUint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -4385,11 +4386,11 @@ func TestIntUint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("2:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -4406,13 +4407,13 @@ func TestInt8Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"020\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -4429,11 +4430,11 @@ func TestInt8Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("4:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -4450,13 +4451,13 @@ func TestInt16Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -4473,11 +4474,11 @@ func TestInt16Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("2:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -4494,13 +4495,13 @@ func TestInt32Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("610")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"610\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -4517,11 +4518,11 @@ func TestInt32Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("6:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -4538,13 +4539,13 @@ func TestInt64Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("620")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -4561,11 +4562,11 @@ func TestInt64Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("0:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -4582,13 +4583,13 @@ func TestUintUint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("1:20")
@@ -4605,11 +4606,11 @@ func TestUintUint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("5:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -4626,13 +4627,13 @@ func TestUint8Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -4649,11 +4650,11 @@ func TestUint8Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("2:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -4670,13 +4671,13 @@ func TestUint16Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("610")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"610\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -4693,11 +4694,11 @@ func TestUint16Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("0:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -4714,13 +4715,13 @@ func TestUint32Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("0:20")
@@ -4737,11 +4738,11 @@ func TestUint32Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("1:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -4758,13 +4759,13 @@ func TestUint64Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("3:20")
@@ -4781,11 +4782,11 @@ func TestUint64Uint64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1-1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1-1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
 		err = v.Set("4:-1")
-		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:-1\": strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint64", v.Type())
 		assert.Empty(t, v.String())
@@ -5039,7 +5040,7 @@ func TestIntSliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1,a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 2 \"a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "intSlice", v.Type())
@@ -5056,11 +5057,11 @@ func TestStringIntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("aiIsN10")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"aiIsN10\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("BakqS:10")
 		assert.Nil(t, err)
 		err = v.Set("wQpOQ20")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"wQpOQ20\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("gNczg:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -5075,9 +5076,9 @@ func TestStringIntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("aczAIa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"aczAIa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("nLqLI:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"nLqLI:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5094,13 +5095,13 @@ func TestIntIntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("3:20")
@@ -5117,11 +5118,11 @@ func TestIntIntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5138,13 +5139,13 @@ func TestInt8IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -5161,11 +5162,11 @@ func TestInt8IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("6:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5182,13 +5183,13 @@ func TestInt16IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"020\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -5205,11 +5206,11 @@ func TestInt16IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5226,13 +5227,13 @@ func TestInt32IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -5249,11 +5250,11 @@ func TestInt32IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("5:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5270,13 +5271,13 @@ func TestInt64IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -5293,11 +5294,11 @@ func TestInt64IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("3:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5314,13 +5315,13 @@ func TestUintIntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -5337,11 +5338,11 @@ func TestUintIntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("7:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5358,13 +5359,13 @@ func TestUint8IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("1:20")
@@ -5381,11 +5382,11 @@ func TestUint8IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("0:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5402,13 +5403,13 @@ func TestUint16IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("3:20")
@@ -5425,11 +5426,11 @@ func TestUint16IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("1:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5446,13 +5447,13 @@ func TestUint32IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("0:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -5469,11 +5470,11 @@ func TestUint32IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("3:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5490,13 +5491,13 @@ func TestUint64IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("3:20")
@@ -5513,11 +5514,11 @@ func TestUint64IntMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("1:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int", v.Type())
 		assert.Empty(t, v.String())
@@ -5738,7 +5739,7 @@ func TestInt8SliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1,a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 2 \"a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "int8Slice", v.Type())
@@ -5755,11 +5756,11 @@ func TestStringInt8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("eBZBF10")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"eBZBF10\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("elhXk:10")
 		assert.Nil(t, err)
 		err = v.Set("zzfNa20")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"zzfNa20\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("VtAyy:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -5774,9 +5775,9 @@ func TestStringInt8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("qWzKqa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"qWzKqa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("QFbuc:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"QFbuc:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -5793,13 +5794,13 @@ func TestIntInt8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -5816,11 +5817,11 @@ func TestIntInt8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("3:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -5837,13 +5838,13 @@ func TestInt8Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"020\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("0:20")
@@ -5860,11 +5861,11 @@ func TestInt8Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("5:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -5881,13 +5882,13 @@ func TestInt16Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -5904,11 +5905,11 @@ func TestInt16Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -5925,13 +5926,13 @@ func TestInt32Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -5948,11 +5949,11 @@ func TestInt32Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -5969,13 +5970,13 @@ func TestInt64Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -5992,11 +5993,11 @@ func TestInt64Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("4:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -6013,13 +6014,13 @@ func TestUintInt8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"020\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -6036,11 +6037,11 @@ func TestUintInt8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("0:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -6057,13 +6058,13 @@ func TestUint8Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -6080,11 +6081,11 @@ func TestUint8Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("1:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -6101,13 +6102,13 @@ func TestUint16Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -6124,11 +6125,11 @@ func TestUint16Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("7:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -6145,13 +6146,13 @@ func TestUint32Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("620")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("3:20")
@@ -6168,11 +6169,11 @@ func TestUint32Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("5:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -6189,13 +6190,13 @@ func TestUint64Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -6212,11 +6213,11 @@ func TestUint64Int8MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int8", v.Type())
 		assert.Empty(t, v.String())
@@ -6437,7 +6438,7 @@ func TestInt16SliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1,a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 2 \"a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "int16Slice", v.Type())
@@ -6454,11 +6455,11 @@ func TestStringInt16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("qcnCT10")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"qcnCT10\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("uGZam:10")
 		assert.Nil(t, err)
 		err = v.Set("CToZv20")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"CToZv20\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("PynaE:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -6473,9 +6474,9 @@ func TestStringInt16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("phIdXa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"phIdXa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("aKUaq:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"aKUaq:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -6492,13 +6493,13 @@ func TestIntInt16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -6515,11 +6516,11 @@ func TestIntInt16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("7:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -6536,13 +6537,13 @@ func TestInt8Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("610")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"610\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -6559,11 +6560,11 @@ func TestInt8Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("5:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -6580,13 +6581,13 @@ func TestInt16Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("0:20")
@@ -6603,11 +6604,11 @@ func TestInt16Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("6:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -6624,13 +6625,13 @@ func TestInt32Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -6647,11 +6648,11 @@ func TestInt32Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("3:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -6668,13 +6669,13 @@ func TestInt64Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("0:20")
@@ -6691,11 +6692,11 @@ func TestInt64Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -6712,13 +6713,13 @@ func TestUintInt16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("620")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("1:20")
@@ -6735,11 +6736,11 @@ func TestUintInt16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("1:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -6756,13 +6757,13 @@ func TestUint8Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -6779,11 +6780,11 @@ func TestUint8Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("0:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -6800,13 +6801,13 @@ func TestUint16Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -6823,11 +6824,11 @@ func TestUint16Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("1:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -6844,13 +6845,13 @@ func TestUint32Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("5:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -6867,11 +6868,11 @@ func TestUint32Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("3:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -6888,13 +6889,13 @@ func TestUint64Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("3:20")
@@ -6911,11 +6912,11 @@ func TestUint64Int16MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("7:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int16", v.Type())
 		assert.Empty(t, v.String())
@@ -7136,7 +7137,7 @@ func TestInt32SliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1,a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 2 \"a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "int32Slice", v.Type())
@@ -7153,11 +7154,11 @@ func TestStringInt32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("hGCMd10")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"hGCMd10\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("fLitT:10")
 		assert.Nil(t, err)
 		err = v.Set("qwLUe20")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"qwLUe20\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("cgOcz:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -7172,9 +7173,9 @@ func TestStringInt32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("XTbRMa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"XTbRMa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("GxqPe:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"GxqPe:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7191,13 +7192,13 @@ func TestIntInt32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -7214,11 +7215,11 @@ func TestIntInt32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7235,13 +7236,13 @@ func TestInt8Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -7258,11 +7259,11 @@ func TestInt8Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("1:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7279,13 +7280,13 @@ func TestInt16Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -7302,11 +7303,11 @@ func TestInt16Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("0:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7323,13 +7324,13 @@ func TestInt32Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("1:20")
@@ -7346,11 +7347,11 @@ func TestInt32Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("7:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7367,13 +7368,13 @@ func TestInt64Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("5:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -7390,11 +7391,11 @@ func TestInt64Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("1:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7411,13 +7412,13 @@ func TestUintInt32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -7434,11 +7435,11 @@ func TestUintInt32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("4:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7455,13 +7456,13 @@ func TestUint8Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("5:10")
 		assert.Nil(t, err)
 		err = v.Set("620")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -7478,11 +7479,11 @@ func TestUint8Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("4:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7499,13 +7500,13 @@ func TestUint16Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("0:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -7522,11 +7523,11 @@ func TestUint16Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("1:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7543,13 +7544,13 @@ func TestUint32Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("610")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"610\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -7566,11 +7567,11 @@ func TestUint32Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7587,13 +7588,13 @@ func TestUint64Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("610")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"610\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("5:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -7610,11 +7611,11 @@ func TestUint64Int32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("5:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int32", v.Type())
 		assert.Empty(t, v.String())
@@ -7835,7 +7836,7 @@ func TestInt64SliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1,a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 2 \"a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "int64Slice", v.Type())
@@ -7852,11 +7853,11 @@ func TestStringInt64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("ORvAU10")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"ORvAU10\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("kAwww:10")
 		assert.Nil(t, err)
 		err = v.Set("TndUJ20")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"TndUJ20\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("HiQec:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -7871,9 +7872,9 @@ func TestStringInt64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("bxzvqa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"bxzvqa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("zlPWy:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"zlPWy:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -7890,13 +7891,13 @@ func TestIntInt64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -7913,11 +7914,11 @@ func TestIntInt64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("1:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -7934,13 +7935,13 @@ func TestInt8Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("620")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -7957,11 +7958,11 @@ func TestInt8Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("6:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -7978,13 +7979,13 @@ func TestInt16Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("3:20")
@@ -8001,11 +8002,11 @@ func TestInt16Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("3:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -8022,13 +8023,13 @@ func TestInt32Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("610")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"610\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("7:20")
@@ -8045,11 +8046,11 @@ func TestInt32Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("1:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"1:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -8066,13 +8067,13 @@ func TestInt64Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("420")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("4:20")
@@ -8089,11 +8090,11 @@ func TestInt64Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -8110,13 +8111,13 @@ func TestUintInt64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"320\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("5:20")
@@ -8133,11 +8134,11 @@ func TestUintInt64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("3:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -8154,13 +8155,13 @@ func TestUint8Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("2:20")
@@ -8177,11 +8178,11 @@ func TestUint8Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("7:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -8198,13 +8199,13 @@ func TestUint16Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("6:20")
@@ -8221,11 +8222,11 @@ func TestUint16Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("6:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -8242,13 +8243,13 @@ func TestUint32Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("610")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"610\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("1:20")
@@ -8265,11 +8266,11 @@ func TestUint32Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -8286,13 +8287,13 @@ func TestUint64Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("620")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
 		err = v.Set("3:20")
@@ -8309,11 +8310,11 @@ func TestUint64Int64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("6:a")
-		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:a\": strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int64", v.Type())
 		assert.Empty(t, v.String())
@@ -8512,7 +8513,7 @@ func TestFloat64SliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1,a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 2 \"a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "float64Slice", v.Type())
@@ -8529,11 +8530,11 @@ func TestStringFloat64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("hTtUT10.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"hTtUT10.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("uWJRG:10.2")
 		assert.Nil(t, err)
 		err = v.Set("iQjOT20.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"iQjOT20.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("cVwHj:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -8548,9 +8549,9 @@ func TestStringFloat64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("KVtPoa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"KVtPoa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("VVTYN:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"VVTYN:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -8567,13 +8568,13 @@ func TestIntFloat64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("5:10.2")
 		assert.Nil(t, err)
 		err = v.Set("520.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("5:20.99")
@@ -8590,11 +8591,11 @@ func TestIntFloat64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -8611,13 +8612,13 @@ func TestInt8Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("0:10.2")
 		assert.Nil(t, err)
 		err = v.Set("620.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("4:20.99")
@@ -8634,11 +8635,11 @@ func TestInt8Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("7:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -8655,13 +8656,13 @@ func TestInt16Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("0:10.2")
 		assert.Nil(t, err)
 		err = v.Set("520.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("1:20.99")
@@ -8678,11 +8679,11 @@ func TestInt16Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("0:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -8699,13 +8700,13 @@ func TestInt32Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("2:10.2")
 		assert.Nil(t, err)
 		err = v.Set("220.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("4:20.99")
@@ -8722,11 +8723,11 @@ func TestInt32Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("6:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -8743,13 +8744,13 @@ func TestInt64Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("310.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"310.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("3:10.2")
 		assert.Nil(t, err)
 		err = v.Set("420.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("3:20.99")
@@ -8766,11 +8767,11 @@ func TestInt64Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -8787,13 +8788,13 @@ func TestUintFloat64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("7:10.2")
 		assert.Nil(t, err)
 		err = v.Set("620.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"620.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("3:20.99")
@@ -8810,11 +8811,11 @@ func TestUintFloat64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("6:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -8831,13 +8832,13 @@ func TestUint8Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("5:10.2")
 		assert.Nil(t, err)
 		err = v.Set("120.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("2:20.99")
@@ -8854,11 +8855,11 @@ func TestUint8Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("0:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"0:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -8875,13 +8876,13 @@ func TestUint16Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("2:10.2")
 		assert.Nil(t, err)
 		err = v.Set("420.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("7:20.99")
@@ -8898,11 +8899,11 @@ func TestUint16Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("7:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"7:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -8919,13 +8920,13 @@ func TestUint32Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("6:10.2")
 		assert.Nil(t, err)
 		err = v.Set("420.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("1:20.99")
@@ -8942,11 +8943,11 @@ func TestUint32Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("4:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -8963,13 +8964,13 @@ func TestUint64Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("6:10.2")
 		assert.Nil(t, err)
 		err = v.Set("420.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("4:20.99")
@@ -8986,11 +8987,11 @@ func TestUint64Float64MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("4:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]float64", v.Type())
 		assert.Empty(t, v.String())
@@ -9189,7 +9190,7 @@ func TestFloat32SliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1,a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 2 \"a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "float32Slice", v.Type())
@@ -9206,11 +9207,11 @@ func TestStringFloat32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("aWlfV10.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"aWlfV10.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("cjYMc:10.2")
 		assert.Nil(t, err)
 		err = v.Set("wVxDr20.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"wVxDr20.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("nsfUr:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -9225,9 +9226,9 @@ func TestStringFloat32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("CFsVya")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"CFsVya\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("SzWtb:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"SzWtb:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9244,13 +9245,13 @@ func TestIntFloat32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("6:10.2")
 		assert.Nil(t, err)
 		err = v.Set("520.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("2:20.99")
@@ -9267,11 +9268,11 @@ func TestIntFloat32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("4:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9288,13 +9289,13 @@ func TestInt8Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("0:10.2")
 		assert.Nil(t, err)
 		err = v.Set("520.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("2:20.99")
@@ -9311,11 +9312,11 @@ func TestInt8Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("6:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9332,13 +9333,13 @@ func TestInt16Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("010.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"010.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("7:10.2")
 		assert.Nil(t, err)
 		err = v.Set("020.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"020.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("2:20.99")
@@ -9355,11 +9356,11 @@ func TestInt16Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("6:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9376,13 +9377,13 @@ func TestInt32Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("2:10.2")
 		assert.Nil(t, err)
 		err = v.Set("120.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("4:20.99")
@@ -9399,11 +9400,11 @@ func TestInt32Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("3:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9420,13 +9421,13 @@ func TestInt64Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("6:10.2")
 		assert.Nil(t, err)
 		err = v.Set("720.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"720.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("1:20.99")
@@ -9443,11 +9444,11 @@ func TestInt64Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("3:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"3:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9464,13 +9465,13 @@ func TestUintFloat32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("0:10.2")
 		assert.Nil(t, err)
 		err = v.Set("420.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"420.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("2:20.99")
@@ -9487,11 +9488,11 @@ func TestUintFloat32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("4:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9508,13 +9509,13 @@ func TestUint8Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("1:10.2")
 		assert.Nil(t, err)
 		err = v.Set("120.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"120.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("1:20.99")
@@ -9531,11 +9532,11 @@ func TestUint8Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("5:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"5:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9552,13 +9553,13 @@ func TestUint16Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("5:10.2")
 		assert.Nil(t, err)
 		err = v.Set("220.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("1:20.99")
@@ -9575,11 +9576,11 @@ func TestUint16Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("6:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"6:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9596,13 +9597,13 @@ func TestUint32Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("4:10.2")
 		assert.Nil(t, err)
 		err = v.Set("220.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"220.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("0:20.99")
@@ -9619,11 +9620,11 @@ func TestUint32Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("4:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"4:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9640,13 +9641,13 @@ func TestUint64Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710.2")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710.2\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("0:10.2")
 		assert.Nil(t, err)
 		err = v.Set("520.99")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"520.99\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
 		err = v.Set("2:20.99")
@@ -9663,11 +9664,11 @@ func TestUint64Float32MapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7a")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7a\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
 		err = v.Set("2:a")
-		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+		assert.EqualError(t, err, "element 1 \"2:a\": strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]float32", v.Type())
 		assert.Empty(t, v.String())
@@ -9855,7 +9856,7 @@ func TestDurationSliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1s,3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 2 \"3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "durationSlice", v.Type())
@@ -9872,11 +9873,11 @@ func TestStringDurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("oLEzi10s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"oLEzi10s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("JiWmA:10s")
 		assert.Nil(t, err)
 		err = v.Set("PKvAT30m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"PKvAT30m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("djZyE:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -9891,9 +9892,9 @@ func TestStringDurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("vvmsI3l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"vvmsI3l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("DSJeK:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"DSJeK:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9910,13 +9911,13 @@ func TestIntDurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("210s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"210s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("5:10s")
 		assert.Nil(t, err)
 		err = v.Set("130m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"130m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
 		err = v.Set("7:30m")
@@ -9933,11 +9934,11 @@ func TestIntDurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("63l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"63l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("0:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"0:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9954,13 +9955,13 @@ func TestInt8DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("0:10s")
 		assert.Nil(t, err)
 		err = v.Set("330m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"330m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
 		err = v.Set("0:30m")
@@ -9977,11 +9978,11 @@ func TestInt8DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("53l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"53l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("5:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"5:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9998,13 +9999,13 @@ func TestInt16DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("510s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"510s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("4:10s")
 		assert.Nil(t, err)
 		err = v.Set("230m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"230m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
 		err = v.Set("7:30m")
@@ -10021,11 +10022,11 @@ func TestInt16DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("53l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"53l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("1:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"1:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -10042,13 +10043,13 @@ func TestInt32DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("410s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"410s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("7:10s")
 		assert.Nil(t, err)
 		err = v.Set("530m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"530m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
 		err = v.Set("0:30m")
@@ -10065,11 +10066,11 @@ func TestInt32DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("63l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"63l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("3:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"3:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -10086,13 +10087,13 @@ func TestInt64DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("110s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"110s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("3:10s")
 		assert.Nil(t, err)
 		err = v.Set("130m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"130m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
 		err = v.Set("7:30m")
@@ -10109,11 +10110,11 @@ func TestInt64DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("73l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"73l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("1:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"1:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -10130,13 +10131,13 @@ func TestUintDurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("6:10s")
 		assert.Nil(t, err)
 		err = v.Set("730m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"730m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
 		err = v.Set("4:30m")
@@ -10153,11 +10154,11 @@ func TestUintDurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("03l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"03l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("3:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"3:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -10174,13 +10175,13 @@ func TestUint8DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("2:10s")
 		assert.Nil(t, err)
 		err = v.Set("730m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"730m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
 		err = v.Set("4:30m")
@@ -10197,11 +10198,11 @@ func TestUint8DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("03l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"03l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("6:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"6:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -10218,13 +10219,13 @@ func TestUint16DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("4:10s")
 		assert.Nil(t, err)
 		err = v.Set("230m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"230m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
 		err = v.Set("6:30m")
@@ -10241,11 +10242,11 @@ func TestUint16DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("73l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"73l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("4:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"4:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -10262,13 +10263,13 @@ func TestUint32DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("2:10s")
 		assert.Nil(t, err)
 		err = v.Set("630m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"630m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
 		err = v.Set("6:30m")
@@ -10285,11 +10286,11 @@ func TestUint32DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("23l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"23l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("1:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"1:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -10306,13 +10307,13 @@ func TestUint64DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("710s")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"710s\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("2:10s")
 		assert.Nil(t, err)
 		err = v.Set("330m")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"330m\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
 		err = v.Set("5:30m")
@@ -10329,17 +10330,205 @@ func TestUint64DurationMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("33l")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"33l\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("3:3l")
-		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
+		assert.EqualError(t, err, "element 1 \"3:3l\": time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]time.Duration", v.Type())
 		assert.Empty(t, v.String())
 	})
 }
 
+func TestTimeValue_Zero(t *testing.T) {
+	t.Parallel()
+	nilValue := new(timeValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*timeValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestTimeValue(t *testing.T) {
+	t.Parallel()
+	t.Run("in: 2024-01-02T15:04:05Z", func(t *testing.T) {
+		t.Parallel()
+		a := new(time.Time)
+		v := newTimeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("2024-01-02T15:04:05Z")
+		assert.Nil(t, err)
+		assert.Equal(t, "2024-01-02T15:04:05Z", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "time", v.Type())
+	})
+	t.Run("in: a", func(t *testing.T) {
+		t.Parallel()
+		a := new(time.Time)
+		v := newTimeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("a")
+		assert.EqualError(t, err, "parsing time \"a\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"a\" as \"2006\"")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "time", v.Type())
+	})
+}
+
+func TestTimeSliceValue_Zero(t *testing.T) {
+	t.Parallel()
+	nilValue := new(timeSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*timeSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringTimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue stringTimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringTimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntTimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue intTimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intTimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8TimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue int8TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16TimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue int16TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32TimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue int32TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64TimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue int64TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintTimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue uintTimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintTimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8TimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue uint8TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16TimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue uint16TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32TimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue uint32TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64TimeMapValue_Zero(t *testing.T) {
+	t.Parallel()
+	var nilValue uint64TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestTimeSliceValue(t *testing.T) {
+	t.Parallel()
+	t.Run("in: [2024-01-02T15:04:05Z 2024-01-03T15:04:05Z]", func(t *testing.T) {
+		t.Parallel()
+		var err error
+		a := new([]time.Time)
+		v := newTimeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2024-01-02T15:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("2024-01-03T15:04:05Z")
+		assert.Nil(t, err)
+		assert.Equal(t, "[2024-01-02T15:04:05Z,2024-01-03T15:04:05Z]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "timeSlice", v.Type())
+	})
+	t.Run("in: [a]", func(t *testing.T) {
+		t.Parallel()
+		var err error
+		a := new([]time.Time)
+		v := newTimeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("a")
+		assert.EqualError(t, err, "element 1 \"a\": parsing time \"a\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"a\" as \"2006\"")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "timeSlice", v.Type())
+	})
+}
+
 func TestIPValue_Zero(t *testing.T) {
 	t.Parallel()
 	nilValue := new(ipValue)
@@ -10521,7 +10710,7 @@ func TestIPSliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("127.0.0.3,127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 2 \"127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "ipSlice", v.Type())
@@ -10538,11 +10727,11 @@ func TestStringIPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("iyeoU127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"iyeoU127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("qzMJG:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("HOxYT127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"HOxYT127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("sbfqA:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -10557,9 +10746,9 @@ func TestStringIPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("qtXjk127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"qtXjk127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("VItIB:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"VItIB:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -10576,13 +10765,13 @@ func TestIntIPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("2:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("6127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
 		err = v.Set("5:127.0.0.3")
@@ -10599,11 +10788,11 @@ func TestIntIPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
 		err = v.Set("1:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"1:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -10620,13 +10809,13 @@ func TestInt8IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("4:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("1127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
 		err = v.Set("5:127.0.0.3")
@@ -10643,11 +10832,11 @@ func TestInt8IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
 		err = v.Set("4:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"4:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -10664,13 +10853,13 @@ func TestInt16IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("1:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("2127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
 		err = v.Set("1:127.0.0.3")
@@ -10687,11 +10876,11 @@ func TestInt16IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
 		err = v.Set("6:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"6:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -10708,13 +10897,13 @@ func TestInt32IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("3:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("5127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
 		err = v.Set("2:127.0.0.3")
@@ -10731,11 +10920,11 @@ func TestInt32IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
 		err = v.Set("2:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"2:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -10752,13 +10941,13 @@ func TestInt64IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("7:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("7127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
 		err = v.Set("2:127.0.0.3")
@@ -10775,11 +10964,11 @@ func TestInt64IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
 		err = v.Set("2:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"2:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -10796,13 +10985,13 @@ func TestUintIPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("2:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("7127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
 		err = v.Set("1:127.0.0.3")
@@ -10819,11 +11008,11 @@ func TestUintIPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
 		err = v.Set("6:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"6:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -10840,13 +11029,13 @@ func TestUint8IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("6:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("5127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
 		err = v.Set("4:127.0.0.3")
@@ -10863,11 +11052,11 @@ func TestUint8IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
 		err = v.Set("2:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"2:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -10884,13 +11073,13 @@ func TestUint16IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("0:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("3127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
 		err = v.Set("4:127.0.0.3")
@@ -10907,11 +11096,11 @@ func TestUint16IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
 		err = v.Set("0:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"0:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -10928,13 +11117,13 @@ func TestUint32IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("6:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("2127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
 		err = v.Set("1:127.0.0.3")
@@ -10951,11 +11140,11 @@ func TestUint32IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
 		err = v.Set("5:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"5:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -10972,13 +11161,13 @@ func TestUint64IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7127.0.0.1")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7127.0.0.1\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("1:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("2127.0.0.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2127.0.0.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
 		err = v.Set("7:127.0.0.3")
@@ -10995,11 +11184,11 @@ func TestUint64IPMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5127.0.0.1.3")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5127.0.0.1.3\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
 		err = v.Set("7:127.0.0.1.3")
-		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
+		assert.EqualError(t, err, "element 1 \"7:127.0.0.1.3\": failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]net.IP", v.Type())
 		assert.Empty(t, v.String())
@@ -11209,7 +11398,7 @@ func TestHexBytesSliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("ff,gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 2 \"gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "hexBytesSlice", v.Type())
@@ -11226,11 +11415,11 @@ func TestStringHexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("OxKmRff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"OxKmRff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("NwKDO:ff")
 		assert.Nil(t, err)
 		err = v.Set("UnXzmaa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"UnXzmaa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("DikdJ:aa")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -11245,9 +11434,9 @@ func TestStringHexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("PukQLgg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"PukQLgg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("axAHz:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"axAHz:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11264,13 +11453,13 @@ func TestIntHexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3ff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3ff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("4:ff")
 		assert.Nil(t, err)
 		err = v.Set("5aa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5aa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
 		err = v.Set("6:aa")
@@ -11287,11 +11476,11 @@ func TestIntHexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7gg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7gg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
 		err = v.Set("7:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"7:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11308,13 +11497,13 @@ func TestInt8HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4ff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4ff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("0:ff")
 		assert.Nil(t, err)
 		err = v.Set("3aa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3aa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
 		err = v.Set("6:aa")
@@ -11331,11 +11520,11 @@ func TestInt8HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1gg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1gg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
 		err = v.Set("5:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"5:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11352,13 +11541,13 @@ func TestInt16HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4ff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4ff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("1:ff")
 		assert.Nil(t, err)
 		err = v.Set("2aa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2aa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
 		err = v.Set("6:aa")
@@ -11375,11 +11564,11 @@ func TestInt16HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6gg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6gg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
 		err = v.Set("2:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"2:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11396,13 +11585,13 @@ func TestInt32HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2ff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2ff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("6:ff")
 		assert.Nil(t, err)
 		err = v.Set("4aa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4aa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
 		err = v.Set("4:aa")
@@ -11419,11 +11608,11 @@ func TestInt32HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7gg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7gg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
 		err = v.Set("3:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"3:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11440,13 +11629,13 @@ func TestInt64HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6ff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6ff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("5:ff")
 		assert.Nil(t, err)
 		err = v.Set("0aa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0aa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
 		err = v.Set("5:aa")
@@ -11463,11 +11652,11 @@ func TestInt64HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2gg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2gg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
 		err = v.Set("6:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"6:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11484,13 +11673,13 @@ func TestUintHexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4ff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4ff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("1:ff")
 		assert.Nil(t, err)
 		err = v.Set("7aa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7aa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
 		err = v.Set("6:aa")
@@ -11507,11 +11696,11 @@ func TestUintHexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4gg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4gg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
 		err = v.Set("6:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"6:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11528,13 +11717,13 @@ func TestUint8HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2ff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2ff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("1:ff")
 		assert.Nil(t, err)
 		err = v.Set("5aa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5aa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
 		err = v.Set("1:aa")
@@ -11551,11 +11740,11 @@ func TestUint8HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4gg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4gg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
 		err = v.Set("5:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"5:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11572,13 +11761,13 @@ func TestUint16HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3ff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3ff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("4:ff")
 		assert.Nil(t, err)
 		err = v.Set("6aa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6aa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
 		err = v.Set("5:aa")
@@ -11595,11 +11784,11 @@ func TestUint16HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3gg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3gg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
 		err = v.Set("6:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"6:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11616,13 +11805,13 @@ func TestUint32HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0ff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0ff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("7:ff")
 		assert.Nil(t, err)
 		err = v.Set("5aa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5aa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
 		err = v.Set("6:aa")
@@ -11639,11 +11828,11 @@ func TestUint32HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6gg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6gg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
 		err = v.Set("5:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"5:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11660,13 +11849,13 @@ func TestUint64HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1ff")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1ff\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("2:ff")
 		assert.Nil(t, err)
 		err = v.Set("6aa")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6aa\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
 		err = v.Set("4:aa")
@@ -11683,11 +11872,11 @@ func TestUint64HexBytesMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5gg")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5gg\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
 		err = v.Set("3:gg")
-		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
+		assert.EqualError(t, err, "element 1 \"3:gg\": encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]HexBytes", v.Type())
 		assert.Empty(t, v.String())
@@ -11875,7 +12064,7 @@ func TestRegexpSliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("[abc,def")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "regexpSlice", v.Type())
@@ -11892,11 +12081,11 @@ func TestStringRegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("LLBLbabc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"LLBLbabc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("uEhZF:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("xQuvAxyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"xQuvAxyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("pLIPk:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -11911,9 +12100,9 @@ func TestStringRegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("PzXDP[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"PzXDP[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("izdhp:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"izdhp:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -11930,13 +12119,13 @@ func TestIntRegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2abc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2abc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("3:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("2xyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2xyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
 		err = v.Set("1:xyz.*")
@@ -11953,11 +12142,11 @@ func TestIntRegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
 		err = v.Set("7:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"7:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -11974,13 +12163,13 @@ func TestInt8RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0abc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0abc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("4:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("2xyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2xyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
 		err = v.Set("7:xyz.*")
@@ -11997,11 +12186,11 @@ func TestInt8RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
 		err = v.Set("0:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"0:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -12018,13 +12207,13 @@ func TestInt16RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5abc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5abc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("0:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("3xyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3xyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
 		err = v.Set("3:xyz.*")
@@ -12041,11 +12230,11 @@ func TestInt16RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
 		err = v.Set("4:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"4:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -12062,13 +12251,13 @@ func TestInt32RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3abc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3abc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("6:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("2xyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2xyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
 		err = v.Set("4:xyz.*")
@@ -12085,11 +12274,11 @@ func TestInt32RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
 		err = v.Set("2:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"2:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -12106,13 +12295,13 @@ func TestInt64RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2abc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2abc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("4:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("4xyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4xyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
 		err = v.Set("7:xyz.*")
@@ -12129,11 +12318,11 @@ func TestInt64RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("7[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
 		err = v.Set("0:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"0:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -12150,13 +12339,13 @@ func TestUintRegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1abc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1abc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("5:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("6xyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6xyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
 		err = v.Set("4:xyz.*")
@@ -12173,11 +12362,11 @@ func TestUintRegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
 		err = v.Set("2:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"2:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -12194,13 +12383,13 @@ func TestUint8RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1abc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1abc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("0:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("6xyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6xyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
 		err = v.Set("5:xyz.*")
@@ -12217,11 +12406,11 @@ func TestUint8RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("5[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
 		err = v.Set("5:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"5:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -12238,13 +12427,13 @@ func TestUint16RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("6abc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6abc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("2:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("4xyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4xyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
 		err = v.Set("1:xyz.*")
@@ -12261,11 +12450,11 @@ func TestUint16RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("3[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
 		err = v.Set("3:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"3:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -12282,13 +12471,13 @@ func TestUint32RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4abc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4abc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("2:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("3xyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3xyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
 		err = v.Set("6:xyz.*")
@@ -12305,11 +12494,11 @@ func TestUint32RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("4[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
 		err = v.Set("3:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"3:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -12326,13 +12515,13 @@ func TestUint64RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0abc.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0abc.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("6:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("3xyz.*")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"3xyz.*\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
 		err = v.Set("5:xyz.*")
@@ -12349,11 +12538,11 @@ func TestUint64RegexpMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("2[abc")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2[abc\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
 		err = v.Set("4:[abc")
-		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
+		assert.EqualError(t, err, "element 1 \"4:[abc\": error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]*regexp.Regexp", v.Type())
 		assert.Empty(t, v.String())
@@ -12453,7 +12642,7 @@ func TestTCPAddrSliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("127.0.0.3:8000,127.0.0.1.3:8000")
-		assert.EqualError(t, err, "failed to parse TCPAddr: \"127.0.0.1.3:8000\"")
+		assert.EqualError(t, err, "element 2 \"127.0.0.1.3:8000\": failed to parse TCPAddr: \"127.0.0.1.3:8000\"")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "tcpAddrSlice", v.Type())
@@ -12685,7 +12874,7 @@ func TestIPNetSliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("0.0.0.0/0,0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 2 \"0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "ipNetSlice", v.Type())
@@ -12702,11 +12891,11 @@ func TestStringIPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("EqCuP0.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"EqCuP0.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("DMNeu:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("iKTxR255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"iKTxR255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("INKSz:255.255.255.255/19")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
@@ -12721,9 +12910,9 @@ func TestStringIPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("jAIvx0.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"jAIvx0.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("BRDRL:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"BRDRL:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
@@ -12740,13 +12929,13 @@ func TestIntIPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("00.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"00.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("6:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("0255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"0255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
 		err = v.Set("3:255.255.255.255/19")
@@ -12763,11 +12952,11 @@ func TestIntIPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("60.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"60.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
 		err = v.Set("4:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"4:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
@@ -12784,13 +12973,13 @@ func TestInt8IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("70.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"70.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("6:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("6255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
 		err = v.Set("5:255.255.255.255/19")
@@ -12807,11 +12996,11 @@ func TestInt8IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("10.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"10.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
 		err = v.Set("7:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"7:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
@@ -12828,13 +13017,13 @@ func TestInt16IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("10.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"10.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("0:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("5255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
 		err = v.Set("7:255.255.255.255/19")
@@ -12851,11 +13040,11 @@ func TestInt16IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("40.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"40.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
 		err = v.Set("2:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"2:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
@@ -12872,13 +13061,13 @@ func TestInt32IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("00.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"00.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("2:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("7255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"7255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
 		err = v.Set("1:255.255.255.255/19")
@@ -12895,11 +13084,11 @@ func TestInt32IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("10.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"10.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
 		err = v.Set("2:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"2:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
@@ -12916,13 +13105,13 @@ func TestInt64IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("10.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"10.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("5:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("5255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"5255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
 		err = v.Set("2:255.255.255.255/19")
@@ -12939,11 +13128,11 @@ func TestInt64IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("10.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"10.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
 		err = v.Set("1:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"1:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
@@ -12960,13 +13149,13 @@ func TestUintIPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("40.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"40.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("7:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("1255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
 		err = v.Set("7:255.255.255.255/19")
@@ -12983,11 +13172,11 @@ func TestUintIPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("60.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"60.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
 		err = v.Set("2:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"2:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
@@ -13004,13 +13193,13 @@ func TestUint8IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("70.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"70.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("1:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("4255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"4255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
 		err = v.Set("5:255.255.255.255/19")
@@ -13027,11 +13216,11 @@ func TestUint8IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("10.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"10.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
 		err = v.Set("6:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"6:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
@@ -13048,13 +13237,13 @@ func TestUint16IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("40.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"40.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("7:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("6255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"6255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
 		err = v.Set("2:255.255.255.255/19")
@@ -13071,11 +13260,11 @@ func TestUint16IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("40.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"40.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
 		err = v.Set("4:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"4:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
@@ -13092,13 +13281,13 @@ func TestUint32IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("70.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"70.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("4:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("1255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"1255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
 		err = v.Set("2:255.255.255.255/19")
@@ -13115,11 +13304,11 @@ func TestUint32IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("50.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"50.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
 		err = v.Set("5:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"5:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
@@ -13136,13 +13325,13 @@ func TestUint64IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("30.0.0.0/0")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"30.0.0.0/0\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("1:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("2255.255.255.255/19")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"2255.255.255.255/19\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
 		err = v.Set("7:255.255.255.255/19")
@@ -13159,17 +13348,282 @@ func TestUint64IPNetMapValue(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("50.0.0.256/16")
-		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		assert.EqualError(t, err, "element 1 \"50.0.0.256/16\": invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
 		err = v.Set("1:0.0.0.256/16")
-		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
+		assert.EqualError(t, err, "element 1 \"1:0.0.0.256/16\": invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]net.IPNet", v.Type())
 		assert.Empty(t, v.String())
 	})
 }
 
+func TestLocationValue_Zero(t *testing.T) {
+	t.Parallel()
+	nilValue := new(locationValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*locationValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestLocationValue(t *testing.T) {
+	t.Parallel()
+	t.Run("in: UTC", func(t *testing.T) {
+		t.Parallel()
+		a := new(time.Location)
+		v := newLocationValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("UTC")
+		assert.Nil(t, err)
+		assert.Equal(t, "UTC", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "location", v.Type())
+	})
+	t.Run("in: Local", func(t *testing.T) {
+		t.Parallel()
+		a := new(time.Location)
+		v := newLocationValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("Local")
+		assert.Nil(t, err)
+		assert.Equal(t, "Local", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "location", v.Type())
+	})
+	t.Run("in: Nowhere/City", func(t *testing.T) {
+		t.Parallel()
+		a := new(time.Location)
+		v := newLocationValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("Nowhere/City")
+		assert.EqualError(t, err, "unknown time zone Nowhere/City")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "location", v.Type())
+	})
+}
+
+func TestLocationSliceValue_Zero(t *testing.T) {
+	t.Parallel()
+	nilValue := new(locationSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*locationSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestLocationSliceValue(t *testing.T) {
+	t.Parallel()
+	t.Run("in: [UTC,Local]", func(t *testing.T) {
+		t.Parallel()
+		var err error
+		a := new([]*time.Location)
+		v := newLocationSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("UTC,Local")
+		assert.Nil(t, err)
+		assert.Equal(t, "[UTC,Local]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "locationSlice", v.Type())
+	})
+	t.Run("in: [UTC,Nowhere/City]", func(t *testing.T) {
+		t.Parallel()
+		var err error
+		a := new([]*time.Location)
+		v := newLocationSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("UTC,Nowhere/City")
+		assert.EqualError(t, err, "element 2 \"Nowhere/City\": unknown time zone Nowhere/City")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "locationSlice", v.Type())
+	})
+}
+
+func TestLanguageTagValue_Zero(t *testing.T) {
+	t.Parallel()
+	nilValue := new(languageTagValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*languageTagValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestLanguageTagValue(t *testing.T) {
+	t.Parallel()
+	t.Run("in: en-us", func(t *testing.T) {
+		t.Parallel()
+		a := new(language.Tag)
+		v := newLanguageTagValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("en-us")
+		assert.Nil(t, err)
+		assert.Equal(t, "en-US", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "languageTag", v.Type())
+	})
+	t.Run("in: fr", func(t *testing.T) {
+		t.Parallel()
+		a := new(language.Tag)
+		v := newLanguageTagValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("fr")
+		assert.Nil(t, err)
+		assert.Equal(t, "fr", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "languageTag", v.Type())
+	})
+	t.Run("in: x", func(t *testing.T) {
+		t.Parallel()
+		a := new(language.Tag)
+		v := newLanguageTagValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("x")
+		assert.EqualError(t, err, "language: tag is not well-formed")
+		assert.Equal(t, "und", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "languageTag", v.Type())
+	})
+}
+
+func TestLanguageTagSliceValue_Zero(t *testing.T) {
+	t.Parallel()
+	nilValue := new(languageTagSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*languageTagSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestLanguageTagSliceValue(t *testing.T) {
+	t.Parallel()
+	t.Run("in: [en-us,fr de]", func(t *testing.T) {
+		t.Parallel()
+		var err error
+		a := new([]language.Tag)
+		v := newLanguageTagSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("en-us,fr")
+		assert.Nil(t, err)
+		err = v.Set("de")
+		assert.Nil(t, err)
+		assert.Equal(t, "[en-US,fr,de]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "languageTagSlice", v.Type())
+	})
+	t.Run("in: [en,x]", func(t *testing.T) {
+		t.Parallel()
+		var err error
+		a := new([]language.Tag)
+		v := newLanguageTagSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("en,x")
+		assert.EqualError(t, err, "element 2 \"x\": language: tag is not well-formed")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "languageTagSlice", v.Type())
+	})
+}
+
+func TestCharsetValue_Zero(t *testing.T) {
+	t.Parallel()
+	nilValue := new(charsetValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*charsetValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestCharsetValue(t *testing.T) {
+	t.Parallel()
+	t.Run("in: utf8", func(t *testing.T) {
+		t.Parallel()
+		a := new(Charset)
+		v := newCharsetValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("utf8")
+		assert.Nil(t, err)
+		assert.Equal(t, "UTF-8", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "charset", v.Type())
+	})
+	t.Run("in: Latin1", func(t *testing.T) {
+		t.Parallel()
+		a := new(Charset)
+		v := newCharsetValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("Latin1")
+		assert.Nil(t, err)
+		assert.Equal(t, "ISO-8859-1", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "charset", v.Type())
+	})
+	t.Run("in: utf-9", func(t *testing.T) {
+		t.Parallel()
+		a := new(Charset)
+		v := newCharsetValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("utf-9")
+		assert.EqualError(t, err, "unknown charset: \"utf-9\"")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "charset", v.Type())
+	})
+}
+
+func TestCharsetSliceValue_Zero(t *testing.T) {
+	t.Parallel()
+	nilValue := new(charsetSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*charsetSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestCharsetSliceValue(t *testing.T) {
+	t.Parallel()
+	t.Run("in: [utf8,latin1 cp1252]", func(t *testing.T) {
+		t.Parallel()
+		var err error
+		a := new([]Charset)
+		v := newCharsetSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("utf8,latin1")
+		assert.Nil(t, err)
+		err = v.Set("cp1252")
+		assert.Nil(t, err)
+		assert.Equal(t, "[UTF-8,ISO-8859-1,windows-1252]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "charsetSlice", v.Type())
+	})
+	t.Run("in: [utf8,utf-9]", func(t *testing.T) {
+		t.Parallel()
+		var err error
+		a := new([]Charset)
+		v := newCharsetSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("utf8,utf-9")
+		assert.EqualError(t, err, "element 2 \"utf-9\": unknown charset: \"utf-9\"")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "charsetSlice", v.Type())
+	})
+}
+
 func TestParseGeneratedMap_NilDefault(t *testing.T) {
 	t.Parallel()
 	a := new(bool)
@@ -1,9 +1,15 @@
 package flags
 
 import (
+	"errors"
 	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/reeflective/flags/internal/tag"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -86,3 +92,233 @@ func TestValidateValue_Set(t *testing.T) {
 	}
 	assert.EqualError(t, v.Set("newVal"), "invalid newVal")
 }
+
+func TestSliceValue_SetElementError(t *testing.T) {
+	var ints []int
+	v := newIntSliceValue(&ints)
+
+	err := v.Set("1,2,abc")
+	assert.EqualError(t, err, `element 3 "abc": strconv.ParseInt: parsing "abc": invalid syntax`)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.Empty(t, ints)
+}
+
+func TestMapValue_SetElementError(t *testing.T) {
+	values := map[string]int{}
+	v := newStringIntMapValue(&values)
+
+	err := v.Set("a:1,b")
+	assert.EqualError(t, err, `element 2 "b": invalid map flag syntax, use -map=key1:val1`)
+
+	err = v.Set("a:1,b:x")
+	assert.EqualError(t, err, `element 2 "b:x": strconv.ParseInt: parsing "x": invalid syntax`)
+}
+
+func TestTimeValue_Layout(t *testing.T) {
+	t.Parallel()
+
+	var (
+		date time.Time
+		zero time.Time
+	)
+
+	v := parseGenerated(&date)
+	assert.Equal(t, "", v.String())
+	assert.Equal(t, "time", v.Type())
+
+	assert.NoError(t, v.Set("2023-04-01T10:30:00Z"))
+	assert.Equal(t, time.Date(2023, 4, 1, 10, 30, 0, 0, time.UTC), date)
+	assert.Equal(t, "2023-04-01T10:30:00Z", v.String())
+	assert.Error(t, v.Set("2023-04-01"))
+
+	withLayoutValue := parseGenerated(&zero)
+	withLayout(withLayoutValue, tag.NewMultiTag(`layout:"2006-01-02"`))
+	assert.NoError(t, withLayoutValue.Set("2023-04-01"))
+	assert.Equal(t, "2023-04-01", withLayoutValue.String())
+}
+
+func TestTimeValue_SliceAndMap(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Dates     []time.Time          `long:"dates" layout:"Jan 2, 2006"`
+		Deadlines map[string]time.Time `long:"deadlines" layout:"Jan 2, 2006 15:04"`
+	}{}
+
+	flagSet, err := ParseStruct(cfg)
+	assert.NoError(t, err)
+	assert.Len(t, flagSet, 2)
+
+	// Layouts might contain commas: values are not split on them.
+	assert.NoError(t, flagSet[0].Value.Set("Apr 1, 2023"))
+	assert.NoError(t, flagSet[0].Value.Set("Apr 2, 2023"))
+	assert.Len(t, cfg.Dates, 2)
+	assert.Equal(t, "[Apr 1, 2023,Apr 2, 2023]", flagSet[0].Value.String())
+
+	err = flagSet[0].Value.Set("04/05/2023")
+	assert.ErrorContains(t, err, `element 1 "04/05/2023"`)
+
+	assert.NoError(t, flagSet[1].Value.Set("release:Apr 1, 2023 10:30"))
+	assert.Equal(t, time.Date(2023, 4, 1, 10, 30, 0, 0, time.UTC), cfg.Deadlines["release"])
+	assert.Equal(t, "map[release:Apr 1, 2023 10:30]", flagSet[1].Value.String())
+	assert.EqualError(t, flagSet[1].Value.Set("release"),
+		`element 1 "release": invalid map flag syntax, use -map=key1:val1`)
+}
+
+// binaryName is only unmarshaled from binary data.
+type binaryName string
+
+func (n *binaryName) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("empty name")
+	}
+
+	*n = binaryName(strings.ToUpper(string(data)))
+
+	return nil
+}
+
+func TestTextValue(t *testing.T) {
+	t.Parallel()
+
+	cfg := &struct {
+		Addr  netip.Addr   `long:"addr"`
+		Addrs []netip.Addr `long:"addrs"`
+		Name  binaryName   `long:"name"`
+	}{}
+
+	flagSet, err := ParseStruct(cfg)
+	assert.NoError(t, err)
+	assert.Len(t, flagSet, 3)
+
+	addr := flagSet[0].Value
+	assert.Equal(t, "netip.Addr", addr.Type())
+	assert.Equal(t, "", addr.String(), "Unset values should not be formatted")
+	assert.NoError(t, addr.Set("192.168.1.1"))
+	assert.Equal(t, netip.MustParseAddr("192.168.1.1"), cfg.Addr)
+	assert.Equal(t, "192.168.1.1", addr.String())
+	assert.Error(t, addr.Set("192.168.1"))
+
+	addrs := flagSet[1].Value
+	assert.Equal(t, "netip.AddrSlice", addrs.Type())
+	assert.NoError(t, addrs.Set("::1,10.0.0.1"))
+	assert.NoError(t, addrs.Set("10.0.0.2"))
+	assert.Equal(t, "[::1,10.0.0.1,10.0.0.2]", addrs.String())
+	assert.ErrorContains(t, addrs.Set("10.0.0.3,x"), `element 2 "x"`)
+
+	name := flagSet[2].Value
+	assert.NoError(t, name.Set("alice"))
+	assert.Equal(t, binaryName("ALICE"), cfg.Name)
+	assert.Equal(t, "ALICE", name.String())
+	assert.EqualError(t, name.Set(""), "empty name")
+}
+
+func TestJSONValue(t *testing.T) {
+	t.Parallel()
+
+	type filter struct {
+		Status string         `json:"status"`
+		Age    map[string]int `json:"age"`
+	}
+
+	cfg := &struct {
+		Filter filter            `long:"filter" flagtype:"json"`
+		Labels map[string]string `long:"labels" flagtype:"json"`
+		Limit  *filter           `long:"limit" flagtype:"json"`
+	}{}
+
+	flagSet, err := ParseStruct(cfg)
+	assert.NoError(t, err)
+	assert.Len(t, flagSet, 3)
+
+	filterFlag := flagSet[0].Value
+	assert.Equal(t, "json", filterFlag.Type())
+	assert.Equal(t, "", filterFlag.String(), "Unset values should not be formatted")
+	assert.NoError(t, filterFlag.Set(`{"status":"active","age":{"gt":3}}`))
+	assert.Equal(t, filter{Status: "active", Age: map[string]int{"gt": 3}}, cfg.Filter)
+	assert.Equal(t, `{"status":"active","age":{"gt":3}}`, filterFlag.String())
+	assert.Error(t, filterFlag.Set(`{"status":`))
+
+	labels := flagSet[1].Value
+	assert.NoError(t, labels.Set(`{"a":"1"}`))
+	assert.NoError(t, labels.Set(`{"b":"2"}`))
+	assert.Equal(t, map[string]string{"b": "2"}, cfg.Labels, "Documents should replace previous values")
+
+	limit := flagSet[2].Value
+	assert.NoError(t, limit.Set(`{"status":"done"}`))
+	assert.Equal(t, &filter{Status: "done"}, cfg.Limit)
+}
+
+func TestCounter_MaxAndStep(t *testing.T) {
+	t.Parallel()
+
+	var count Counter
+
+	val, err := withCounterTags(&count, tag.NewMultiTag(`max:"5" step:"2"`))
+	assert.NoError(t, err)
+	assert.Equal(t, "count", val.Type())
+	assert.NoError(t, val.Set(""))
+	assert.NoError(t, val.Set("true"))
+	assert.Equal(t, Counter(4), count)
+	assert.ErrorIs(t, val.Set(""), ErrCounterMax)
+	assert.Equal(t, Counter(4), count)
+	assert.NoError(t, val.Set("5"))
+	assert.ErrorIs(t, val.Set("6"), ErrCounterMax)
+	assert.Equal(t, "5", val.String())
+
+	_, err = withCounterTags(&count, tag.NewMultiTag(`max:"three"`))
+	assert.ErrorIs(t, err, ErrInvalidTag)
+}
+
+// TestNewValue checks that the values of builtin and custom types can be
+// built from pointers to variables, and that other types are rejected.
+func TestNewValue(t *testing.T) {
+	t.Parallel()
+
+	test := assert.New(t)
+
+	var timeout time.Duration
+
+	value, err := NewValue(&timeout)
+	test.NoError(err)
+	test.NoError(value.Set("2s"))
+	test.Equal(2*time.Second, timeout)
+	test.Equal("duration", value.Type())
+
+	var names []string
+
+	value, err = NewValue(&names)
+	test.NoError(err)
+	test.NoError(value.Set("a,b"))
+	test.NoError(value.Set("c"))
+	test.Equal([]string{"a", "b", "c"}, names)
+
+	repeatable, isRepeatable := value.(RepeatableFlag)
+	test.True(isRepeatable && repeatable.IsCumulative())
+
+	labels := map[string]int{}
+
+	value, err = NewValue(&labels)
+	test.NoError(err)
+	test.NoError(value.Set("a:1"))
+	test.Equal(map[string]int{"a": 1}, labels)
+
+	var count Counter
+
+	value, err = NewValue(&count)
+	test.NoError(err)
+	test.Equal(&count, value, "Types implementing Value should be used as is")
+
+	var addr netip.Addr
+
+	value, err = NewValue(&addr)
+	test.NoError(err)
+	test.NoError(value.Set("127.0.0.1"))
+	test.Equal("127.0.0.1", addr.String())
+
+	_, err = NewValue(&struct{ Name string }{})
+	test.ErrorIs(err, ErrNotValue)
+
+	_, err = NewValue(nil)
+	test.ErrorIs(err, ErrObjectIsNil)
+}
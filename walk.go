@@ -0,0 +1,396 @@
+package flags
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/reeflective/flags/internal/positional"
+	"github.com/reeflective/flags/internal/scan"
+	"github.com/reeflective/flags/internal/tag"
+)
+
+// Command describes a command found while walking a struct with Walk.
+type Command struct {
+	Name            string            // Name of the command, empty for the root one
+	Path            []string          // Names of the command and all its parents, root excluded
+	Description     string            // Short description of the command
+	LongDescription string            // Long description of the command
+	Aliases         []string          // Alternative names for the command
+	Examples        []string          // Examples of use of the command
+	Hidden          bool              // The command is not shown in help/completions
+	Annotations     map[string]string // Arbitrary metadata declared with annotation tags
+	Parent          *Command          // The parent command, nil for the root one
+	Data            interface{}       // A pointer to the command struct
+
+	// The contents of the command, only filled by Scan: its subcommands, its groups
+	// of options, its options not declared in a group, and its positional arguments.
+	// Options has all of its options, grouped or not, in declaration order.
+	Subcommands []*Command
+	Groups      []*Group
+	Flags       []*Flag
+	Options     []Option
+	Positionals []*Positional
+
+	aliases   map[string][]string // Aliases of the subcommands, with generated ones
+	conflicts []AliasConflict     // Generated aliases of the subcommands which were dropped
+}
+
+// Group describes a group of options found while walking a struct with Walk.
+type Group struct {
+	Name       string      // Name of the group, as declared in its tag (or its translated title)
+	Persistent bool        // The options are inherited by subcommands
+	Data       interface{} // A pointer to the group struct
+	Flags      []*Flag     // The options of the group, only filled by Scan
+}
+
+// Option is an option of a command scanned with Scan, with its group if any.
+type Option struct {
+	Group *Group // The group declaring the option, nil for ungrouped options
+	Flag  *Flag
+}
+
+// Positional describes a positional argument found while walking a struct with Walk.
+type Positional struct {
+	Name    string        // Name of the argument, either tag name or struct field
+	Index   int           // The position of the argument among those of the command
+	Usage   string        // Description of the argument
+	Minimum int           // Minimum number of words required by the argument
+	Maximum int           // Maximum number of words accepted (-1: infinite)
+	Choices []string      // If not empty, the only values allowed for the argument
+	Value   reflect.Value // A reference to the field value itself
+
+	// The completion directives of the argument (eg. `FilterExt,json`),
+	// as declared in its `complete` tags, for completion backends.
+	Completers []string
+}
+
+// VisitorFuncs holds the functions called by Walk for each element of the
+// command model found in a struct. Any of them can be nil, and any error
+// returned by one of them stops the walk, and is returned by Walk.
+type VisitorFuncs struct {
+	Command    func(cmd *Command) error
+	Group      func(cmd *Command, grp *Group) error
+	Flag       func(cmd *Command, grp *Group, flag *Flag) error // grp is nil for ungrouped options
+	Positional func(cmd *Command, arg *Positional) error
+}
+
+// Walk scans a struct for commands, option groups, options and positional arguments,
+// and calls the visitor functions on each of them, in declaration order. Commands are
+// visited before their contents (the root command first), and groups before their
+// options. Parsing options apply as with other functions (prefixes, mode, etc).
+//
+// This gives access to the same model used by generators, without depending on any
+// of their output (cobra commands, flag annotations, etc): it can be used to export
+// documentation, to check naming conventions, or to filter some commands/options.
+func Walk(root interface{}, visitor VisitorFuncs, optFuncs ...OptFunc) error {
+	if root == nil {
+		return ErrObjectIsNil
+	}
+
+	cmd := &Command{
+		Annotations: map[string]string{},
+		Data:        root,
+	}
+
+	aliases, conflicts, err := SubcommandAliases(root, optFuncs...)
+	if err != nil {
+		return err
+	}
+
+	cmd.aliases, cmd.conflicts = aliases, conflicts
+
+	if visitor.Command != nil {
+		if err := visitor.Command(cmd); err != nil {
+			return err
+		}
+	}
+
+	if err := scan.Type(root, walkCommand(cmd, visitor, optFuncs)); err != nil {
+		return err
+	}
+
+	return walkFieldPositionals(cmd, visitor, optFuncs)
+}
+
+// Scan scans a struct for commands, option groups, options and positional arguments, and
+// returns the command model of the root struct, with all its contents (see Command): this
+// is the model consumed by backends not generating cobra commands (eg. urfave/cli ones,
+// documentation and man pages), and by any program generating its own from the structs.
+// Parsing options apply as with other functions, and contents are in declaration order.
+func Scan(root interface{}, optFuncs ...OptFunc) (*Command, error) {
+	if root == nil {
+		return nil, ErrObjectIsNil
+	}
+
+	val := reflect.ValueOf(root)
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return nil, ErrNotPointerToStruct
+	}
+
+	var model *Command
+
+	visitor := VisitorFuncs{
+		Command: func(cmd *Command) error {
+			if cmd.Parent == nil {
+				model = cmd
+			} else {
+				cmd.Parent.Subcommands = append(cmd.Parent.Subcommands, cmd)
+			}
+
+			return nil
+		},
+		Group: func(cmd *Command, grp *Group) error {
+			cmd.Groups = append(cmd.Groups, grp)
+
+			return nil
+		},
+		Flag: func(cmd *Command, grp *Group, flag *Flag) error {
+			if grp != nil {
+				grp.Flags = append(grp.Flags, flag)
+			} else {
+				cmd.Flags = append(cmd.Flags, flag)
+			}
+
+			cmd.Options = append(cmd.Options, Option{Group: grp, Flag: flag})
+
+			return nil
+		},
+		Positional: func(cmd *Command, arg *Positional) error {
+			cmd.Positionals = append(cmd.Positionals, arg)
+
+			return nil
+		},
+	}
+
+	if err := Walk(root, visitor, optFuncs...); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+// walkCommand returns a scan handler visiting the fields of a command struct.
+func walkCommand(cmd *Command, visitor VisitorFuncs, optFuncs []OptFunc) scan.Handler {
+	handler := func(val reflect.Value, sfield *reflect.StructField) (bool, error) {
+		mtag, _, err := ScanOptions(optFuncs...).FieldTag(*sfield)
+		if err != nil {
+			return true, fmt.Errorf("%w: %s", tag.ErrTag, err.Error())
+		}
+
+		// Fields used in another execution mode are not part of the model.
+		if !InMode(mtag, optFuncs...) {
+			return true, nil
+		}
+
+		if _, isArgs := mtag.Get("positional-args"); isArgs {
+			return true, walkPositionals(cmd, mtag, val, visitor, optFuncs)
+		}
+
+		if name, _ := mtag.Get("command"); name != "" {
+			return true, walkSubcommand(cmd, name, mtag, val, visitor, optFuncs)
+		}
+
+		if found, err := walkGroup(cmd, mtag, val, visitor, optFuncs); found || err != nil {
+			return found, err
+		}
+
+		flagSet, found, err := ParseField(val, *sfield, optFuncs...)
+		if err != nil || !found {
+			return found, err
+		}
+
+		return true, walkFlags(cmd, nil, flagSet, visitor, optFuncs)
+	}
+
+	return handler
+}
+
+// walkSubcommand visits a command and all of its fields.
+func walkSubcommand(parent *Command, name string, mtag tag.MultiTag, val reflect.Value,
+	visitor VisitorFuncs, optFuncs []OptFunc,
+) error {
+	cmd := &Command{
+		Name:        name,
+		Path:        append(append([]string{}, parent.Path...), name),
+		Aliases:     parent.aliases[name],
+		Examples:    mtag.GetMany("example"),
+		Annotations: map[string]string{},
+		Parent:      parent,
+		Data:        initialize(val),
+	}
+
+	if cmd.Description, _ = mtag.Get("description"); cmd.Description == "" {
+		cmd.Description, _ = mtag.Get("desc")
+	}
+
+	cmd.LongDescription, _ = mtag.Get("long-description")
+	cmd.Description, cmd.LongDescription = Sanitize(cmd.Description), Sanitize(cmd.LongDescription)
+	_, cmd.Hidden = mtag.Get("hidden")
+
+	translations := catalog(optFuncs)
+	cmd.Description = translations.Text(CatalogKey(cmd.Path, "", CatalogDescription), cmd.Description)
+	cmd.LongDescription = translations.Text(CatalogKey(cmd.Path, "", CatalogLongDescription), cmd.LongDescription)
+
+	for _, annotation := range mtag.GetMany("annotation") {
+		key, value, _ := strings.Cut(annotation, "=")
+		cmd.Annotations[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	optFuncs = CommandOptions(mtag, optFuncs...)
+
+	aliases, conflicts, err := SubcommandAliases(cmd.Data, optFuncs...)
+	if err != nil {
+		return err
+	}
+
+	cmd.aliases, cmd.conflicts = aliases, conflicts
+
+	if visitor.Command != nil {
+		if err := visitor.Command(cmd); err != nil {
+			return err
+		}
+	}
+
+	if err := scan.Type(cmd.Data, walkCommand(cmd, visitor, optFuncs)); err != nil {
+		return err
+	}
+
+	return walkFieldPositionals(cmd, visitor, optFuncs)
+}
+
+// walkGroup visits a group of options, or the commands of a group of commands.
+func walkGroup(cmd *Command, mtag tag.MultiTag, val reflect.Value, visitor VisitorFuncs, optFuncs []OptFunc) (bool, error) {
+	var data interface{}
+
+	name, isGroup := mtag.Get("group")
+	_, isCommands := mtag.Get("commands")
+
+	switch {
+	case IsProvided(mtag):
+		provided, err := ProvidedGroup(mtag, val)
+		if err != nil || provided == nil {
+			return true, err
+		}
+
+		data = provided
+		if name, _ = mtag.Get("group-provider"); name == "" {
+			name, _ = mtag.Get("use-group")
+		}
+	case isGroup && name != "":
+		data = initialize(val)
+	case isCommands:
+		return true, scan.Type(initialize(val), walkCommand(cmd, visitor, optFuncs))
+	default:
+		return false, nil
+	}
+
+	persistent, _ := mtag.Get("persistent")
+	grp := &Group{
+		Name:       catalog(optFuncs).Text(CatalogKey(cmd.Path, "["+name+"]", CatalogTitle), name),
+		Persistent: persistent != "",
+		Data:       data,
+	}
+
+	if visitor.Group != nil {
+		if err := visitor.Group(cmd, grp); err != nil {
+			return true, err
+		}
+	}
+
+	flagSet, err := ParseStruct(data, GroupOptions(mtag, optFuncs...)...)
+	if err != nil {
+		return true, err
+	}
+
+	return true, walkFlags(cmd, grp, flagSet, visitor, optFuncs)
+}
+
+// walkFlags visits a list of options belonging to a command, and maybe one of its groups.
+func walkFlags(cmd *Command, grp *Group, flagSet []*Flag, visitor VisitorFuncs, optFuncs []OptFunc) error {
+	if visitor.Flag == nil {
+		return nil
+	}
+
+	translations := catalog(optFuncs)
+
+	for _, flag := range flagSet {
+		flag.Usage = translations.Text(CatalogKey(cmd.Path, "--"+flag.Name, CatalogDescription), flag.Usage)
+
+		if err := visitor.Flag(cmd, grp, flag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkPositionals visits the positional arguments of a command.
+func walkPositionals(cmd *Command, mtag tag.MultiTag, val reflect.Value, visitor VisitorFuncs, optFuncs []OptFunc) error {
+	args, err := positional.ScanArgs(val, mtag, ScanOptFuncs(optFuncs...)...)
+	if err != nil {
+		return fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
+	}
+
+	return visitPositionals(cmd, args, visitor, optFuncs)
+}
+
+// walkFieldPositionals visits the fields of a command struct tagged as positional arguments.
+func walkFieldPositionals(cmd *Command, visitor VisitorFuncs, optFuncs []OptFunc) error {
+	args, err := positional.ScanFields(reflect.Indirect(reflect.ValueOf(cmd.Data)), ScanOptFuncs(optFuncs...)...)
+	if err != nil {
+		return fmt.Errorf("%w: %s", scan.ErrScan, err.Error())
+	}
+
+	return visitPositionals(cmd, args, visitor, optFuncs)
+}
+
+// visitPositionals visits the positional arguments of a command, if any.
+func visitPositionals(cmd *Command, args *positional.Args, visitor VisitorFuncs, optFuncs []OptFunc) error {
+	if visitor.Positional == nil || args == nil {
+		return nil
+	}
+
+	translations := catalog(optFuncs)
+
+	for _, arg := range args.Positionals() {
+		usage, _ := arg.Tag.Get("description")
+		element := "<" + arg.Name + ">"
+
+		var choices []string
+		for _, choice := range arg.Tag.GetMany("choice") {
+			choices = append(choices, strings.Split(choice, " ")...)
+		}
+
+		err := visitor.Positional(cmd, &Positional{
+			Name:    translations.Text(CatalogKey(cmd.Path, element, CatalogPlaceholder), arg.Name),
+			Index:   arg.Index,
+			Usage:   translations.Text(CatalogKey(cmd.Path, element, CatalogDescription), Sanitize(usage)),
+			Minimum: arg.Minimum,
+			Maximum: arg.Maximum,
+			Choices: choices,
+			Value:   arg.Value,
+
+			Completers: arg.Tag.GetMany("complete"),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// initialize returns a pointer to a struct field value, allocating it if nil.
+func initialize(val reflect.Value) interface{} {
+	ptrval := val
+	if val.Kind() != reflect.Ptr {
+		ptrval = val.Addr()
+	}
+
+	if ptrval.IsNil() {
+		ptrval.Set(reflect.New(ptrval.Type().Elem()))
+	}
+
+	return ptrval.Interface()
+}
@@ -0,0 +1,139 @@
+package flags
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type walkedCommand struct {
+	Verbose bool `long:"verbose"`
+	Remote  struct {
+		Host string `long:"host" complete:"Hosts"`
+		Port int    `long:"port"`
+	} `group:"remote" persistent:"yes"`
+
+	Add struct {
+		Args struct {
+			Name string   `description:"name of the item"`
+			Tags []string `complete:"Files"`
+		} `positional-args:"yes"`
+		Force bool `long:"force"`
+	} `command:"add" description:"add an item" annotation:"scope=items"`
+}
+
+// TestWalk checks that all elements of a command model are visited, in order.
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	var visited []string
+
+	visitor := VisitorFuncs{
+		Command: func(cmd *Command) error {
+			visited = append(visited, "command:"+strings.Join(cmd.Path, " "))
+			return nil
+		},
+		Group: func(cmd *Command, grp *Group) error {
+			visited = append(visited, "group:"+grp.Name)
+			return nil
+		},
+		Flag: func(cmd *Command, grp *Group, flag *Flag) error {
+			visited = append(visited, "flag:"+flag.Name)
+			return nil
+		},
+		Positional: func(cmd *Command, arg *Positional) error {
+			visited = append(visited, "arg:"+arg.Name)
+			return nil
+		},
+	}
+
+	err := Walk(&walkedCommand{}, visitor)
+
+	test := assert.New(t)
+	test.Nil(err, "The command model should have been walked")
+	test.Equal([]string{
+		"command:",
+		"flag:verbose",
+		"group:remote", "flag:host", "flag:port",
+		"command:add",
+		"arg:Name", "arg:Tags",
+		"flag:force",
+	}, visited)
+}
+
+// TestWalkError checks that visitor errors stop the walk.
+func TestWalkError(t *testing.T) {
+	t.Parallel()
+
+	errStop := errors.New("stop")
+	commands := 0
+
+	visitor := VisitorFuncs{
+		Command: func(cmd *Command) error {
+			commands++
+
+			if cmd.Name == "add" {
+				assert.Equal(t, "add an item", cmd.Description)
+				assert.Equal(t, "items", cmd.Annotations["scope"])
+
+				return errStop
+			}
+
+			return nil
+		},
+	}
+
+	err := Walk(&walkedCommand{}, visitor)
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, 2, commands)
+}
+
+// TestScan checks that the command model has all the contents of its commands.
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	data := &walkedCommand{}
+
+	root, err := Scan(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, data, root.Data)
+	require.Len(t, root.Flags, 1)
+	assert.Equal(t, "verbose", root.Flags[0].Name)
+
+	require.Len(t, root.Groups, 1)
+	assert.True(t, root.Groups[0].Persistent)
+	require.Len(t, root.Groups[0].Flags, 2)
+	assert.Equal(t, []string{"Hosts"}, root.Groups[0].Flags[0].Completers)
+
+	require.Len(t, root.Subcommands, 1)
+
+	add := root.Subcommands[0]
+	assert.Equal(t, root, add.Parent)
+	assert.Equal(t, &data.Add, add.Data)
+	require.Len(t, add.Flags, 1)
+	require.Len(t, add.Positionals, 2)
+	assert.Equal(t, []string{"Files"}, add.Positionals[1].Completers)
+
+	// Options of groups and ungrouped ones are listed in declaration order.
+	ordered := &struct {
+		Remote struct {
+			Host string `long:"host"`
+		} `group:"remote"`
+		Verbose bool `long:"verbose"`
+	}{}
+
+	root, err = Scan(ordered)
+	require.NoError(t, err)
+	require.Len(t, root.Options, 2)
+	assert.Equal(t, "host", root.Options[0].Flag.Name)
+	assert.Equal(t, root.Groups[0], root.Options[0].Group)
+	assert.Equal(t, "verbose", root.Options[1].Flag.Name)
+	assert.Nil(t, root.Options[1].Group)
+
+	_, err = Scan(*data)
+	assert.ErrorIs(t, err, ErrNotPointerToStruct)
+}